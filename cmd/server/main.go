@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -14,11 +15,20 @@ import (
 	"github.com/joho/godotenv"
 	httpSwagger "github.com/swaggo/http-swagger/v2"
 
+	"predictive-analysis-engine/pkg/analysis"
+	"predictive-analysis-engine/pkg/anomaly"
 	"predictive-analysis-engine/pkg/api"
+	"predictive-analysis-engine/pkg/calibration"
 	"predictive-analysis-engine/pkg/clients/graph"
 	"predictive-analysis-engine/pkg/clients/telemetry"
 	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/forecast"
+	"predictive-analysis-engine/pkg/graphqlapi"
+	"predictive-analysis-engine/pkg/grpcapi"
+	"predictive-analysis-engine/pkg/outcome"
+	"predictive-analysis-engine/pkg/retention"
 	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/slo"
 	"predictive-analysis-engine/pkg/storage"
 	"predictive-analysis-engine/pkg/worker"
 )
@@ -36,6 +46,10 @@ import (
 
 func main() {
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(os.Args[2:]))
+	}
+
 	if err := godotenv.Load(); err != nil {
 		log.Println("⚠️  No .env file found, using environment variables")
 	}
@@ -51,9 +65,9 @@ func main() {
 
 	log.Printf("Predictive Analysis Engine started on port %d", cfg.Server.Port)
 	log.Printf("Graph Engine URL: %s", cfg.GraphAPI.BaseURL)
-	log.Printf("Decision Store: %s", cfg.SQLite.DBPath)
+	log.Printf("Decision Store: %s", cfg.DecisionStore.Driver)
 
-	store, err := storage.NewDecisionStore(cfg.SQLite.DBPath)
+	store, err := storage.NewDecisionStore(cfg.DecisionStore.Driver, cfg.DecisionStoreDSN(), cfg.DecisionStore.AutoMigrate)
 	if err != nil {
 		log.Fatalf("Failed to initialize DecisionStore: %v", err)
 	}
@@ -62,15 +76,32 @@ func main() {
 	graphClient := graph.NewClient(cfg.GraphAPI)
 	telemetryClient := telemetry.NewClient(cfg)
 
-	simService := simulation.NewService(cfg, graphClient, store)
+	forecaster := forecast.NewForecaster(cfg.Forecast, telemetryClient)
+	simService := simulation.NewService(cfg, graphClient, store, forecaster)
+	outcomeEvaluator := outcome.NewEvaluator(cfg.Outcome, store, telemetryClient)
+	calibrator := calibration.NewCalibrator(cfg, store, telemetryClient)
+	pruner := retention.NewPruner(cfg.Retention, store)
+	detector := anomaly.NewDetector(cfg.Anomaly, store, telemetryClient)
+	sloEvaluator := slo.NewEvaluator(cfg.SLO, telemetryClient)
 
 	apiHandler := api.NewHandler(cfg, graphClient, simService)
 	decisionsHandler := &api.DecisionsHandler{Store: store}
+	annotationsHandler := &api.AnnotationsHandler{Store: store}
+	reportsHandler := &api.ReportsHandler{Store: store}
+	outcomesHandler := &api.OutcomesHandler{Store: store, Evaluator: outcomeEvaluator}
+	calibrationHandler := &api.CalibrationHandler{Store: store, Calibrator: calibrator}
+	retentionHandler := &api.RetentionHandler{Pruner: pruner}
+	anomaliesHandler := &api.AnomaliesHandler{Store: store, Detector: detector}
+	forecastHandler := &api.ForecastHandler{Forecaster: forecaster}
+	slosHandler := &api.SLOsHandler{Store: store, Evaluator: sloEvaluator, DefaultWindowDays: cfg.SLO.DefaultWindowDays}
+	topologyHandler := &api.TopologyHandler{Store: store}
 	telemetryHandler := &api.TelemetryHandler{Client: telemetryClient, Cfg: cfg}
 
 	r := chi.NewRouter()
 
 	r.Use(api.CorrelationMiddleware)
+	r.NotFound(api.NotFoundHandler)
+	r.MethodNotAllowed(api.MethodNotAllowedHandler)
 
 	// Swagger UI
 	r.Get("/swagger/doc.json", func(w http.ResponseWriter, r *http.Request) {
@@ -81,19 +112,68 @@ func main() {
 	))
 
 	r.Get("/health", apiHandler.HealthHandler)
-	r.Get("/services", apiHandler.ServicesHandler)
-	r.Get("/risk/services/top", apiHandler.TopRiskHandler)
-	r.Post("/simulate/failure", apiHandler.SimulateFailureHandler)
-	r.Post("/simulate/scale", apiHandler.SimulateScalingHandler)
-	r.Post("/simulate/add", apiHandler.SimulateAddHandler)
-	r.Get("/dependency-graph/snapshot", apiHandler.DependencyGraphHandler)
+	r.Handle("/graphql", graphqlapi.NewHandler(graphClient, store))
+
+	sharedRoutes := func(r chi.Router) {
+		r.Get("/services", apiHandler.ServicesHandler)
+		r.Get("/risk/services/top", apiHandler.TopRiskHandler)
+		r.Get("/dependency-graph/snapshot", apiHandler.DependencyGraphHandler)
+		r.Get("/risk/topology/events", topologyHandler.ListEvents)
+
+		decisionsHandler.RegisterRoutes(r)
+		annotationsHandler.RegisterRoutes(r)
+		outcomesHandler.RegisterRoutes(r)
+		retentionHandler.RegisterRoutes(r)
+		reportsHandler.RegisterRoutes(r)
+		calibrationHandler.RegisterRoutes(r)
+		anomaliesHandler.RegisterRoutes(r)
+		forecastHandler.RegisterRoutes(r)
+		slosHandler.RegisterRoutes(r)
+		r.Mount("/telemetry", telemetryHandler.Routes())
+	}
+
+	v1Routes := func(r chi.Router) {
+		r.Use(api.DeprecationMiddleware)
+		r.Post("/simulate/failure", apiHandler.SimulateFailureHandler)
+		r.Post("/simulate/scale", apiHandler.SimulateScalingHandler)
+		r.Post("/simulate/add", apiHandler.SimulateAddHandler)
+		sharedRoutes(r)
+	}
 
-	decisionsHandler.RegisterRoutes(r)
-	r.Mount("/telemetry", telemetryHandler.Routes())
+	// Unversioned routes are kept as aliases of /v1 for existing clients.
+	// Endpoints added since are served under /v2 only, so they are not
+	// deprecated from the start.
+	r.Group(v1Routes)
+	r.Route("/v1", v1Routes)
+	r.Route("/v2", func(r chi.Router) {
+		r.Post("/simulate/failure", apiHandler.SimulateFailureV2Handler)
+		r.Post("/simulate/scale", apiHandler.SimulateScalingV2Handler)
+		r.Post("/simulate/add", apiHandler.SimulateAddV2Handler)
+		r.Post("/simulate/remove", apiHandler.SimulateRemoveHandler)
+		r.Post("/simulate/rewire", apiHandler.SimulateRewireHandler)
+		r.Post("/simulate/drain", apiHandler.SimulateDrainHandler)
+		r.Get("/analysis/availability/{serviceId}", apiHandler.AvailabilityHandler)
+		r.Get("/analysis/latency/{serviceId}", apiHandler.LatencyHandler)
+		r.Get("/analysis/cycles", apiHandler.CyclesHandler)
+		r.Get("/paths", apiHandler.PathsHandler)
+		sharedRoutes(r)
+	})
 
-	pollWorker := worker.NewPollWorker(cfg, graphClient, telemetryClient)
+	pollWorker := worker.NewPollWorker(cfg, graphClient, telemetryClient, analysis.NewCycleWatcher(store))
 	pollWorker.Start()
 
+	outcomeWorker := worker.NewOutcomeWorker(cfg, store, outcomeEvaluator)
+	outcomeWorker.Start()
+
+	calibrationWorker := worker.NewCalibrationWorker(cfg, calibrator)
+	calibrationWorker.Start()
+
+	retentionWorker := worker.NewRetentionWorker(cfg, pruner)
+	retentionWorker.Start()
+
+	anomalyWorker := worker.NewAnomalyWorker(cfg, detector)
+	anomalyWorker.Start()
+
 	addr := fmt.Sprintf(":%d", cfg.Server.Port)
 	srv := &http.Server{
 		Addr:    addr,
@@ -106,6 +186,21 @@ func main() {
 		}
 	}()
 
+	grpcServer := grpcapi.NewServer(graphClient, simService, store)
+	if cfg.GRPC.Enabled {
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPC.Port))
+		if err != nil {
+			log.Fatalf("Failed to listen for gRPC: %v", err)
+		}
+		log.Printf("gRPC server listening on port %d", cfg.GRPC.Port)
+
+		go func() {
+			if err := grpcServer.Serve(lis); err != nil {
+				log.Fatalf("gRPC server failed: %v", err)
+			}
+		}()
+	}
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
 	<-stop
@@ -119,7 +214,13 @@ func main() {
 		log.Printf("Server forced to shutdown: %v", err)
 	}
 
+	grpcServer.GracefulStop()
+
 	pollWorker.Stop()
+	outcomeWorker.Stop()
+	calibrationWorker.Stop()
+	retentionWorker.Stop()
+	anomalyWorker.Stop()
 
 	telemetryClient.Close()
 
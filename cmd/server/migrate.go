@@ -0,0 +1,98 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/joho/godotenv"
+
+	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+// runMigrate implements `predictive-analysis-engine migrate [up|status]`.
+func runMigrate(args []string) int {
+	_ = godotenv.Load()
+
+	command := "up"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
+	}
+
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	driver := fs.String("driver", "", "Decision store driver: sqlite or postgres (default: DECISION_STORE_DRIVER)")
+	dsn := fs.String("db", "", "SQLite path or PostgreSQL connection string (default: SQLITE_DB_PATH or POSTGRES_DSN)")
+	target := fs.Int("to", 0, "Migrate up to this version only (up)")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s migrate [up|status] [flags]\n\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if command != "up" && command != "status" {
+		fs.Usage()
+		return 2
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		return 1
+	}
+	if *driver == "" {
+		*driver = cfg.DecisionStore.Driver
+	}
+	if *dsn == "" {
+		cfg.DecisionStore.Driver = *driver
+		*dsn = cfg.DecisionStoreDSN()
+	}
+
+	db, err := storage.OpenDatabase(*driver, *dsn)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	defer db.Close()
+
+	if command == "status" {
+		status, err := db.MigrationsStatus()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 1
+		}
+		current, err := db.SchemaVersion()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 1
+		}
+		fmt.Printf("Database: %s (schema version %d)\n", db.Driver(), current)
+		for _, m := range status {
+			state := "pending"
+			if m.Applied {
+				state = "applied " + m.AppliedAt
+			}
+			fmt.Printf("  %04d %-40s %s\n", m.Version, m.Name, state)
+		}
+		if _, err := db.CheckSchema(); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	applied, err := db.MigrateUp(*target)
+	for _, m := range applied {
+		fmt.Printf("Applied %04d %s\n", m.Version, m.Name)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	if len(applied) == 0 {
+		fmt.Println("Schema is up to date")
+	}
+	return 0
+}
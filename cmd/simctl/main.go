@@ -0,0 +1,111 @@
+// Command simctl runs declarative simulation scenario files through the
+// simulation service and exits non-zero when a configured threshold is
+// breached, so it can gate deploys in CI.
+//
+// Usage:
+//
+//	simctl [-graph-url URL | -topology FILE] [-output table|json|markdown] scenario.yaml...
+//
+// Exit status is 0 when every step passed, 1 when a threshold was breached
+// and 2 when a scenario could not be loaded or a simulation failed.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/simulation"
+)
+
+const (
+	exitOK       = 0
+	exitBreached = 1
+	exitError    = 2
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "simctl: failed to load config: %v\n", err)
+		return exitError
+	}
+
+	graphURL := flag.String("graph-url", cfg.GraphAPI.BaseURL, "Graph Engine base URL")
+	topologyPath := flag.String("topology", "", "Run against a local topology file (YAML or JSON) instead of the Graph Engine")
+	output := flag.String("output", "table", "Output format: table, json or markdown")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: simctl [flags] scenario.yaml...\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		return exitError
+	}
+	render, ok := renderers[*output]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "simctl: unknown output format %q\n", *output)
+		return exitError
+	}
+
+	var source simulation.GraphSource
+	if *topologyPath != "" {
+		topology, err := loadTopology(*topologyPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "simctl: failed to load topology: %v\n", err)
+			return exitError
+		}
+		source = graph.NewTopologySource(*topology)
+	} else {
+		graphCfg := cfg.GraphAPI
+		graphCfg.BaseURL = *graphURL
+		source = graph.NewClient(graphCfg)
+	}
+
+	simService := simulation.NewService(cfg, source, nil, nil)
+
+	var files []*ScenarioFile
+	for _, path := range flag.Args() {
+		f, err := loadScenarioFile(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "simctl: %v\n", err)
+			return exitError
+		}
+		files = append(files, f)
+	}
+
+	var results []StepResult
+	for _, f := range files {
+		for _, step := range f.Steps {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.Simulation.TimeoutMs)*time.Millisecond)
+			results = append(results, runStep(ctx, simService, f, step))
+			cancel()
+		}
+	}
+
+	if err := render(os.Stdout, results); err != nil {
+		fmt.Fprintf(os.Stderr, "simctl: failed to write output: %v\n", err)
+		return exitError
+	}
+
+	code := exitOK
+	for _, r := range results {
+		if r.Error != "" {
+			return exitError
+		}
+		if len(r.Breaches) > 0 {
+			code = exitBreached
+		}
+	}
+	return code
+}
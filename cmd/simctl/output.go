@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+var renderers = map[string]func(io.Writer, []StepResult) error{
+	"table":    renderTable,
+	"json":     renderJSON,
+	"markdown": renderMarkdown,
+}
+
+func detail(r StepResult) string {
+	switch {
+	case r.Error != "":
+		return r.Error
+	case len(r.Breaches) > 0:
+		return strings.Join(r.Breaches, "; ")
+	}
+	return r.Summary
+}
+
+func renderTable(w io.Writer, results []StepResult) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SCENARIO\tSTEP\tKIND\tTARGET\tSTATUS\tDETAIL")
+	for _, r := range results {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", r.Scenario, r.Step, r.Kind, r.Target, r.status(), detail(r))
+	}
+	return tw.Flush()
+}
+
+func renderJSON(w io.Writer, results []StepResult) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(map[string]interface{}{"results": results})
+}
+
+func renderMarkdown(w io.Writer, results []StepResult) error {
+	escape := strings.NewReplacer("|", `\|`, "\n", " ")
+	fmt.Fprintln(w, "| Scenario | Step | Kind | Target | Status | Detail |")
+	fmt.Fprintln(w, "|---|---|---|---|---|---|")
+	for _, r := range results {
+		status := r.status()
+		if status != "PASS" {
+			status = "**" + status + "**"
+		}
+		fmt.Fprintf(w, "| %s | %s | %s | %s | %s | %s |\n",
+			escape.Replace(r.Scenario), escape.Replace(r.Step), r.Kind, escape.Replace(r.Target), status, escape.Replace(detail(r)))
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"predictive-analysis-engine/pkg/simulation"
+)
+
+type StepResult struct {
+	Scenario string      `json:"scenario"`
+	Step     string      `json:"step"`
+	Kind     string      `json:"kind"`
+	Target   string      `json:"target"`
+	Summary  string      `json:"summary"`
+	Breaches []string    `json:"breaches"`
+	Error    string      `json:"error,omitempty"`
+	Result   interface{} `json:"result,omitempty"`
+}
+
+func (r StepResult) status() string {
+	switch {
+	case r.Error != "":
+		return "ERROR"
+	case len(r.Breaches) > 0:
+		return "BREACHED"
+	}
+	return "PASS"
+}
+
+func runStep(ctx context.Context, svc *simulation.Service, f *ScenarioFile, step Step) StepResult {
+	out := StepResult{Scenario: f.Name, Step: step.Name, Kind: step.kind(), Breaches: []string{}}
+	t := f.Thresholds.merge(step.Thresholds)
+
+	switch {
+	case step.Failure != nil:
+		out.Target = step.Failure.ServiceId
+		res, err := svc.RunFailureSimulation(ctx, *step.Failure)
+		if err != nil {
+			out.Error = err.Error()
+			return out
+		}
+		out.Result = res
+		out.Summary = fmt.Sprintf("lost %.1f rps, %d callers, %d unreachable",
+			res.TotalLostTrafficRps, len(res.AffectedCallers), len(res.UnreachableServices))
+		out.Breaches = checkFailure(t, res)
+
+	case step.Scale != nil:
+		out.Target = step.Scale.ServiceId
+		res, err := svc.RunScalingSimulation(ctx, *step.Scale)
+		if err != nil {
+			out.Error = err.Error()
+			return out
+		}
+		out.Result = res
+		est := res.LatencyEstimate
+		if est.BaselineMs != nil && est.ProjectedMs != nil && est.DeltaMs != nil {
+			out.Summary = fmt.Sprintf("%s %.1fms -> %.1fms (%+.1fms)", res.LatencyMetric, *est.BaselineMs, *est.ProjectedMs, *est.DeltaMs)
+		} else {
+			out.Summary = fmt.Sprintf("%s latency unknown", res.LatencyMetric)
+		}
+		out.Breaches = checkScaling(t, res)
+
+	case step.Add != nil:
+		out.Target = step.Add.ServiceName
+		res, err := svc.RunAddSimulation(ctx, *step.Add)
+		if err != nil {
+			out.Error = err.Error()
+			return out
+		}
+		out.Result = res
+		placement := "fits"
+		if !res.Success {
+			placement = "does not fit"
+		}
+		out.Summary = fmt.Sprintf("%d replicas %s (capacity %d pods)", step.Add.Replicas, placement, res.TotalCapacityPods)
+		out.Breaches = checkAdd(t, res)
+	}
+
+	return out
+}
+
+func checkFailure(t Thresholds, res *simulation.FailureSimulationResult) []string {
+	breaches := []string{}
+	if t.MaxLostRps != nil && res.TotalLostTrafficRps > *t.MaxLostRps {
+		breaches = append(breaches, fmt.Sprintf("lost traffic %.1f rps exceeds %.1f", res.TotalLostTrafficRps, *t.MaxLostRps))
+	}
+	if t.MaxUnreachableServices != nil && len(res.UnreachableServices) > *t.MaxUnreachableServices {
+		breaches = append(breaches, fmt.Sprintf("%d unreachable services exceeds %d", len(res.UnreachableServices), *t.MaxUnreachableServices))
+	}
+	if t.MaxAffectedCallers != nil && len(res.AffectedCallers) > *t.MaxAffectedCallers {
+		breaches = append(breaches, fmt.Sprintf("%d affected callers exceeds %d", len(res.AffectedCallers), *t.MaxAffectedCallers))
+	}
+	return breaches
+}
+
+func checkScaling(t Thresholds, res *simulation.ScalingSimulationResult) []string {
+	breaches := []string{}
+	if t.MaxLatencyDeltaMs != nil && res.LatencyEstimate.DeltaMs != nil && *res.LatencyEstimate.DeltaMs > *t.MaxLatencyDeltaMs {
+		breaches = append(breaches, fmt.Sprintf("latency delta %.1fms exceeds %.1fms", *res.LatencyEstimate.DeltaMs, *t.MaxLatencyDeltaMs))
+	}
+	if t.MaxPathDeltaMs != nil {
+		for _, p := range res.AffectedPaths {
+			if p.DeltaMs != nil && *p.DeltaMs > *t.MaxPathDeltaMs {
+				breaches = append(breaches, fmt.Sprintf("path %v delta %.1fms exceeds %.1fms", p.Path, *p.DeltaMs, *t.MaxPathDeltaMs))
+			}
+		}
+	}
+	if t.MaxAffectedCallers != nil && len(res.AffectedCallers.Items) > *t.MaxAffectedCallers {
+		breaches = append(breaches, fmt.Sprintf("%d affected callers exceeds %d", len(res.AffectedCallers.Items), *t.MaxAffectedCallers))
+	}
+	return breaches
+}
+
+func checkAdd(t Thresholds, res *simulation.AddSimulationResult) []string {
+	breaches := []string{}
+	if t.RequirePlacement != nil && *t.RequirePlacement && !res.Success {
+		breaches = append(breaches, "service cannot be placed with the requested resources")
+	}
+	return breaches
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/simulation"
+)
+
+// ScenarioFile is a named sequence of simulation steps. Steps run in order
+// and use the same request bodies as the REST API.
+type ScenarioFile struct {
+	Name       string     `json:"name"`
+	Thresholds Thresholds `json:"thresholds"`
+	Steps      []Step     `json:"steps"`
+}
+
+// Step holds exactly one of Failure, Scale or Add. Its thresholds override
+// the file-level ones field by field.
+type Step struct {
+	Name       string                               `json:"name"`
+	Failure    *simulation.FailureSimulationRequest `json:"failure,omitempty"`
+	Scale      *simulation.ScalingSimulationRequest `json:"scale,omitempty"`
+	Add        *simulation.AddSimulationRequest     `json:"add,omitempty"`
+	Thresholds Thresholds                           `json:"thresholds"`
+}
+
+type Thresholds struct {
+	MaxLostRps             *float64 `json:"maxLostRps,omitempty"`
+	MaxUnreachableServices *int     `json:"maxUnreachableServices,omitempty"`
+	MaxAffectedCallers     *int     `json:"maxAffectedCallers,omitempty"`
+	MaxLatencyDeltaMs      *float64 `json:"maxLatencyDeltaMs,omitempty"`
+	MaxPathDeltaMs         *float64 `json:"maxPathDeltaMs,omitempty"`
+	RequirePlacement       *bool    `json:"requirePlacement,omitempty"`
+}
+
+func (t Thresholds) merge(override Thresholds) Thresholds {
+	if override.MaxLostRps != nil {
+		t.MaxLostRps = override.MaxLostRps
+	}
+	if override.MaxUnreachableServices != nil {
+		t.MaxUnreachableServices = override.MaxUnreachableServices
+	}
+	if override.MaxAffectedCallers != nil {
+		t.MaxAffectedCallers = override.MaxAffectedCallers
+	}
+	if override.MaxLatencyDeltaMs != nil {
+		t.MaxLatencyDeltaMs = override.MaxLatencyDeltaMs
+	}
+	if override.MaxPathDeltaMs != nil {
+		t.MaxPathDeltaMs = override.MaxPathDeltaMs
+	}
+	if override.RequirePlacement != nil {
+		t.RequirePlacement = override.RequirePlacement
+	}
+	return t
+}
+
+func (s Step) kind() string {
+	switch {
+	case s.Failure != nil:
+		return "failure"
+	case s.Scale != nil:
+		return "scale"
+	case s.Add != nil:
+		return "add"
+	}
+	return ""
+}
+
+func loadScenarioFile(path string) (*ScenarioFile, error) {
+	var f ScenarioFile
+	if err := decodeYAMLFile(path, &f); err != nil {
+		return nil, err
+	}
+	if f.Name == "" {
+		f.Name = path
+	}
+	if len(f.Steps) == 0 {
+		return nil, fmt.Errorf("%s: no steps defined", path)
+	}
+	for i, step := range f.Steps {
+		set := 0
+		for _, ok := range []bool{step.Failure != nil, step.Scale != nil, step.Add != nil} {
+			if ok {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("%s: step %d must define exactly one of failure, scale or add", path, i+1)
+		}
+		if step.Name == "" {
+			f.Steps[i].Name = fmt.Sprintf("%s #%d", step.kind(), i+1)
+		}
+	}
+	return &f, nil
+}
+
+func loadTopology(path string) (*graph.Topology, error) {
+	var t graph.Topology
+	if err := decodeYAMLFile(path, &t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// decodeYAMLFile reads a YAML (or JSON) file into v using v's json tags, so
+// scenario and topology files share field names with the HTTP API. Unknown
+// keys are rejected: a misspelt threshold must fail the run, not be ignored.
+func decodeYAMLFile(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	asJSON, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(asJSON))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
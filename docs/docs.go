@@ -8,316 +8,346 @@ const docTemplate = `{
     "schemes": {{ marshal .Schemes }},
     "components": {
         "schemas": {
-            "api.GraphSnapshotResponse": {
+            "analysis.AvailabilityAnalysis": {
                 "properties": {
-                    "edges": {
+                    "achievableAvailability": {
+                        "type": "number"
+                    },
+                    "assumptions": {
                         "items": {
-                            "$ref": "#/components/schemas/api.SnapshotEdge"
+                            "type": "string"
                         },
                         "type": "array",
                         "uniqueItems": false
                     },
-                    "metadata": {
-                        "$ref": "#/components/schemas/api.SnapshotMetadata"
-                    },
-                    "nodes": {
+                    "contributors": {
                         "items": {
-                            "$ref": "#/components/schemas/api.SnapshotNode"
+                            "$ref": "#/components/schemas/analysis.AvailabilityContributor"
                         },
                         "type": "array",
                         "uniqueItems": false
-                    }
-                },
-                "type": "object"
-            },
-            "api.SnapshotEdge": {
-                "properties": {
-                    "id": {
-                        "type": "string"
-                    },
-                    "latencyP95Ms": {
-                        "type": "number"
-                    },
-                    "reqRate": {
-                        "type": "number"
                     },
-                    "source": {
-                        "type": "string"
-                    },
-                    "target": {
-                        "type": "string"
-                    }
-                },
-                "type": "object"
-            },
-            "api.SnapshotMetadata": {
-                "properties": {
-                    "edgeCount": {
+                    "dependencyCount": {
                         "type": "integer"
                     },
-                    "edgesWithMetrics": {
-                        "type": "integer"
+                    "downtimeMinutesPerMonth": {
+                        "type": "number"
                     },
                     "generatedAt": {
                         "type": "string"
                     },
-                    "lastUpdatedSecondsAgo": {
-                        "type": "integer"
-                    },
-                    "nodeCount": {
-                        "type": "integer"
-                    },
-                    "nodesWithMetrics": {
-                        "type": "integer"
+                    "ownAvailability": {
+                        "type": "number"
                     },
-                    "stale": {
-                        "type": "boolean"
+                    "serviceId": {
+                        "type": "string"
                     },
-                    "windowMinutes": {
-                        "type": "integer"
+                    "warnings": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     }
                 },
                 "type": "object"
             },
-            "api.SnapshotNode": {
+            "analysis.AvailabilityContributor": {
                 "properties": {
-                    "availability": {
+                    "achievableIfPerfect": {
                         "type": "number"
                     },
-                    "availabilityPct": {
-                        "type": "number"
+                    "alternatives": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
-                    "betweenness": {
+                    "availability": {
                         "type": "number"
                     },
-                    "errorRatePct": {
+                    "effectiveAvailability": {
                         "type": "number"
                     },
-                    "id": {
+                    "kind": {
                         "type": "string"
                     },
-                    "latencyP95Ms": {
+                    "limit": {
                         "type": "number"
                     },
-                    "name": {
-                        "type": "string"
-                    },
-                    "namespace": {
-                        "type": "string"
-                    },
-                    "pageRank": {
-                        "type": "number"
+                    "path": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
-                    "podCount": {
+                    "replicas": {
                         "type": "integer"
                     },
-                    "reqRate": {
-                        "type": "number"
-                    },
-                    "riskLevel": {
-                        "type": "string"
-                    },
-                    "riskReason": {
+                    "serviceId": {
                         "type": "string"
                     },
-                    "updatedAt": {
-                        "type": "string"
+                    "shareOfUnavailability": {
+                        "type": "number"
                     }
                 },
                 "type": "object"
             },
-            "graph.CentralityServiceInfo": {
+            "analysis.CriticalPath": {
                 "properties": {
-                    "centralityScore": {
+                    "downstreamMs": {
                         "type": "number"
                     },
-                    "explanation": {
-                        "type": "string"
+                    "hops": {
+                        "items": {
+                            "$ref": "#/components/schemas/analysis.LatencyHop"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
-                    "name": {
+                    "metric": {
                         "type": "string"
                     },
-                    "namespace": {
-                        "type": "string"
+                    "path": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
-                    "riskLevel": {
-                        "type": "string"
+                    "selfMs": {
+                        "type": "number"
                     },
-                    "serviceId": {
-                        "type": "string"
+                    "serviceLatencyMs": {
+                        "type": "number"
                     }
                 },
                 "type": "object"
             },
-            "graph.DataFreshness": {
+            "analysis.Cycle": {
                 "properties": {
-                    "lastUpdatedSecondsAgo": {
-                        "type": "integer"
+                    "heaviestEdge": {
+                        "$ref": "#/components/schemas/analysis.CycleEdge"
                     },
-                    "source": {
+                    "key": {
                         "type": "string"
                     },
-                    "stale": {
-                        "type": "boolean"
+                    "path": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
-                    "windowMinutes": {
-                        "type": "integer"
+                    "rps": {
+                        "type": "number"
                     }
                 },
                 "type": "object"
             },
-            "graph.TopCentralityResponse": {
+            "analysis.CycleEdge": {
                 "properties": {
-                    "confidence": {
-                        "type": "string"
+                    "rps": {
+                        "type": "number"
                     },
-                    "dataFreshness": {
-                        "$ref": "#/components/schemas/graph.DataFreshness"
+                    "share": {
+                        "type": "number"
                     },
-                    "metric": {
+                    "source": {
                         "type": "string"
                     },
-                    "services": {
-                        "items": {
-                            "$ref": "#/components/schemas/graph.CentralityServiceInfo"
-                        },
-                        "type": "array",
-                        "uniqueItems": false
+                    "target": {
+                        "type": "string"
                     }
                 },
                 "type": "object"
             },
-            "simulation.AddRiskAnalysis": {
+            "analysis.CycleReport": {
                 "properties": {
-                    "dependencyRisk": {
-                        "type": "string"
+                    "components": {
+                        "items": {
+                            "$ref": "#/components/schemas/analysis.StronglyConnectedComponent"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
-                    "description": {
+                    "cycleCount": {
+                        "type": "integer"
+                    },
+                    "edgeCount": {
+                        "type": "integer"
+                    },
+                    "generatedAt": {
                         "type": "string"
+                    },
+                    "serviceCount": {
+                        "type": "integer"
                     }
                 },
                 "type": "object"
             },
-            "simulation.AddSimulationRequest": {
+            "analysis.DownstreamCall": {
                 "properties": {
-                    "cpuRequest": {
+                    "callRate": {
                         "type": "number"
                     },
-                    "dependencies": {
+                    "onCriticalPath": {
                         "items": {
-                            "$ref": "#/components/schemas/simulation.DependencyRef"
+                            "type": "string"
                         },
                         "type": "array",
                         "uniqueItems": false
                     },
-                    "ramRequest": {
-                        "type": "integer"
+                    "p50": {
+                        "type": "number"
                     },
-                    "replicas": {
-                        "type": "integer"
+                    "p95": {
+                        "type": "number"
                     },
-                    "serviceName": {
-                        "type": "string"
+                    "p99": {
+                        "type": "number"
                     },
-                    "timeWindow": {
+                    "serviceId": {
                         "type": "string"
                     }
                 },
                 "type": "object"
             },
-            "simulation.AddSimulationResult": {
+            "analysis.LatencyAnalysis": {
                 "properties": {
-                    "confidence": {
-                        "type": "string"
-                    },
-                    "explanation": {
-                        "type": "string"
-                    },
-                    "recommendation": {
-                        "$ref": "#/components/schemas/simulation.LegacyRecommendation"
-                    },
-                    "recommendations": {
+                    "candidates": {
                         "items": {
-                            "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                            "$ref": "#/components/schemas/analysis.OptimizationCandidate"
                         },
                         "type": "array",
                         "uniqueItems": false
                     },
-                    "riskAnalysis": {
-                        "$ref": "#/components/schemas/simulation.AddRiskAnalysis"
+                    "criticalPaths": {
+                        "items": {
+                            "$ref": "#/components/schemas/analysis.CriticalPath"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
-                    "success": {
-                        "type": "boolean"
+                    "depth": {
+                        "type": "integer"
                     },
-                    "suitableNodes": {
+                    "downstreamCalls": {
                         "items": {
-                            "$ref": "#/components/schemas/simulation.NodeCapacity"
+                            "$ref": "#/components/schemas/analysis.DownstreamCall"
                         },
                         "type": "array",
                         "uniqueItems": false
                     },
-                    "targetServiceName": {
+                    "generatedAt": {
                         "type": "string"
                     },
-                    "totalCapacityPods": {
-                        "type": "integer"
+                    "serviceId": {
+                        "type": "string"
+                    },
+                    "warnings": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     }
                 },
                 "type": "object"
             },
-            "simulation.AffectedCaller": {
+            "analysis.LatencyHop": {
                 "properties": {
-                    "edgeErrorRate": {
+                    "callRate": {
                         "type": "number"
                     },
-                    "lostTrafficRps": {
+                    "edgeLatencyMs": {
                         "type": "number"
                     },
-                    "name": {
+                    "selfMs": {
+                        "type": "number"
+                    },
+                    "serviceId": {
                         "type": "string"
                     },
-                    "namespace": {
+                    "share": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "analysis.OptimizationCandidate": {
+                "properties": {
+                    "action": {
+                        "type": "string"
+                    },
+                    "podCount": {
+                        "type": "integer"
+                    },
+                    "reason": {
                         "type": "string"
                     },
+                    "selfP95Ms": {
+                        "type": "number"
+                    },
                     "serviceId": {
                         "type": "string"
+                    },
+                    "tailRatio": {
+                        "type": "number"
                     }
                 },
                 "type": "object"
             },
-            "simulation.AffectedCallerScaling": {
+            "analysis.PathHop": {
                 "properties": {
-                    "afterMs": {
+                    "errorRate": {
                         "type": "number"
                     },
-                    "beforeMs": {
+                    "p95Ms": {
                         "type": "number"
                     },
-                    "deltaMs": {
+                    "rps": {
                         "type": "number"
                     },
-                    "endToEndAfterMs": {
+                    "share": {
                         "type": "number"
                     },
-                    "endToEndBeforeMs": {
-                        "type": "number"
+                    "source": {
+                        "type": "string"
                     },
-                    "endToEndDeltaMs": {
-                        "type": "number"
+                    "target": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "analysis.PathQueryResult": {
+                "properties": {
+                    "from": {
+                        "type": "string"
                     },
-                    "hopDistance": {
+                    "generatedAt": {
+                        "type": "string"
+                    },
+                    "k": {
                         "type": "integer"
                     },
-                    "name": {
-                        "type": "string"
+                    "paths": {
+                        "items": {
+                            "$ref": "#/components/schemas/analysis.RankedPath"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
-                    "namespace": {
+                    "rank": {
                         "type": "string"
                     },
-                    "serviceId": {
+                    "to": {
                         "type": "string"
                     },
-                    "viaPath": {
+                    "warnings": {
                         "items": {
                             "type": "string"
                         },
@@ -327,124 +357,259 @@ const docTemplate = `{
                 },
                 "type": "object"
             },
-            "simulation.AffectedCallersList": {
+            "analysis.RankedPath": {
                 "properties": {
-                    "description": {
-                        "type": "string"
+                    "bottleneckRps": {
+                        "type": "number"
                     },
-                    "items": {
+                    "cost": {
+                        "type": "number"
+                    },
+                    "hopCount": {
+                        "type": "integer"
+                    },
+                    "hops": {
                         "items": {
-                            "$ref": "#/components/schemas/simulation.AffectedCallerScaling"
+                            "$ref": "#/components/schemas/analysis.PathHop"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "latencyMs": {
+                        "type": "number"
+                    },
+                    "path": {
+                        "items": {
+                            "type": "string"
                         },
                         "type": "array",
                         "uniqueItems": false
+                    },
+                    "rank": {
+                        "type": "integer"
+                    },
+                    "routingShare": {
+                        "type": "number"
                     }
                 },
                 "type": "object"
             },
-            "simulation.AffectedDownstream": {
+            "analysis.StronglyConnectedComponent": {
                 "properties": {
-                    "edgeErrorRate": {
-                        "type": "number"
+                    "cycles": {
+                        "items": {
+                            "$ref": "#/components/schemas/analysis.Cycle"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
-                    "lostTrafficRps": {
+                    "cyclesTruncated": {
+                        "type": "boolean"
+                    },
+                    "edges": {
+                        "items": {
+                            "$ref": "#/components/schemas/analysis.CycleEdge"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "internalRps": {
                         "type": "number"
                     },
-                    "name": {
+                    "services": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "anomaly.RunResult": {
+                "properties": {
+                    "anomalies": {
+                        "items": {
+                            "$ref": "#/components/schemas/storage.AnomalyEvent"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "from": {
                         "type": "string"
                     },
-                    "namespace": {
+                    "series": {
+                        "type": "integer"
+                    },
+                    "to": {
                         "type": "string"
                     },
-                    "serviceId": {
+                    "warmup": {
+                        "type": "boolean"
+                    }
+                },
+                "type": "object"
+            },
+            "api.AccuracyResponse": {
+                "properties": {
+                    "models": {
+                        "items": {
+                            "$ref": "#/components/schemas/storage.ModelAccuracy"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "since": {
                         "type": "string"
                     }
                 },
                 "type": "object"
             },
-            "simulation.AffectedPathScaling": {
+            "api.AddSimulationResultV2": {
                 "properties": {
-                    "afterMs": {
-                        "type": "number"
+                    "confidence": {
+                        "type": "string"
                     },
-                    "beforeMs": {
-                        "type": "number"
+                    "explanation": {
+                        "type": "string"
                     },
-                    "deltaMs": {
-                        "type": "number"
+                    "nodes": {
+                        "items": {
+                            "$ref": "#/components/schemas/api.NodeCapacityV2"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
-                    "incompleteData": {
-                        "type": "boolean"
+                    "placement": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.PlacementDistribution"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
-                    "path": {
+                    "recommendations": {
                         "items": {
-                            "type": "string"
+                            "$ref": "#/components/schemas/api.RecommendationV2"
                         },
                         "type": "array",
                         "uniqueItems": false
                     },
-                    "pathRps": {
-                        "type": "number"
+                    "riskAnalysis": {
+                        "$ref": "#/components/schemas/simulation.AddRiskAnalysis"
+                    },
+                    "serviceName": {
+                        "type": "string"
+                    },
+                    "success": {
+                        "type": "boolean"
+                    },
+                    "totalCapacityPods": {
+                        "type": "integer"
                     }
                 },
                 "type": "object"
             },
-            "simulation.BrokenPath": {
+            "api.AnnotationRequest": {
                 "properties": {
-                    "path": {
+                    "author": {
+                        "type": "string"
+                    },
+                    "body": {
+                        "type": "string"
+                    },
+                    "parentId": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "api.AnnotationsResponse": {
+                "properties": {
+                    "annotations": {
                         "items": {
-                            "type": "string"
+                            "$ref": "#/components/schemas/storage.Annotation"
                         },
                         "type": "array",
                         "uniqueItems": false
                     },
-                    "pathRps": {
-                        "type": "number"
+                    "decisionId": {
+                        "type": "integer"
                     }
                 },
                 "type": "object"
             },
-            "simulation.DataFreshness": {
+            "api.AnomaliesResponse": {
                 "properties": {
-                    "lastUpdatedSecondsAgo": {
+                    "anomalies": {
+                        "items": {
+                            "$ref": "#/components/schemas/storage.AnomalyEvent"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "count": {
                         "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "api.ApprovalRequest": {
+                "properties": {
+                    "actor": {
+                        "type": "string"
                     },
-                    "source": {
+                    "comment": {
                         "type": "string"
                     },
-                    "stale": {
-                        "type": "boolean"
+                    "state": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "api.ApprovalResponse": {
+                "properties": {
+                    "approval": {
+                        "$ref": "#/components/schemas/storage.Approval"
                     },
-                    "windowMinutes": {
+                    "decisionId": {
                         "type": "integer"
+                    },
+                    "history": {
+                        "items": {
+                            "$ref": "#/components/schemas/storage.ApprovalEvent"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     }
                 },
                 "type": "object"
             },
-            "simulation.DependencyRef": {
+            "api.CalibrationsResponse": {
                 "properties": {
-                    "serviceId": {
-                        "type": "string"
+                    "calibrations": {
+                        "items": {
+                            "$ref": "#/components/schemas/storage.ScalingCalibration"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     }
                 },
                 "type": "object"
             },
-            "simulation.FailureRecommendation": {
+            "api.DecisionDiffResponse": {
                 "properties": {
-                    "action": {
-                        "type": "string"
-                    },
-                    "description": {
-                        "type": "string"
+                    "a": {
+                        "$ref": "#/components/schemas/api.DecisionRef"
                     },
-                    "priority": {
-                        "type": "string"
+                    "b": {
+                        "$ref": "#/components/schemas/api.DecisionRef"
                     },
-                    "reason": {
-                        "type": "string"
+                    "failure": {
+                        "$ref": "#/components/schemas/simulation.FailureDiff"
                     },
-                    "target": {
-                        "type": "string"
+                    "scaling": {
+                        "$ref": "#/components/schemas/simulation.ScalingDiff"
                     },
                     "type": {
                         "type": "string"
@@ -452,18 +617,21 @@ const docTemplate = `{
                 },
                 "type": "object"
             },
-            "simulation.FailureSimulationRequest": {
+            "api.DecisionRef": {
                 "properties": {
-                    "depth": {
+                    "correlationId": {
+                        "type": "string"
+                    },
+                    "id": {
                         "type": "integer"
                     },
-                    "serviceId": {
+                    "timestamp": {
                         "type": "string"
                     }
                 },
                 "type": "object"
             },
-            "simulation.FailureSimulationResult": {
+            "api.FailureSimulationResultV2": {
                 "properties": {
                     "affectedCallers": {
                         "items": {
@@ -482,7 +650,7 @@ const docTemplate = `{
                     "confidence": {
                         "type": "string"
                     },
-                    "criticalPathsToTarget": {
+                    "criticalPaths": {
                         "items": {
                             "$ref": "#/components/schemas/simulation.BrokenPath"
                         },
@@ -500,7 +668,14 @@ const docTemplate = `{
                     },
                     "recommendations": {
                         "items": {
-                            "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                            "$ref": "#/components/schemas/api.RecommendationV2"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "sloBreaches": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.SLOBreach"
                         },
                         "type": "array",
                         "uniqueItems": false
@@ -521,163 +696,159 @@ const docTemplate = `{
                 },
                 "type": "object"
             },
-            "simulation.LegacyRecommendation": {
+            "api.NodeCapacityV2": {
                 "properties": {
-                    "cpuRequest": {
+                    "cpuAvailable": {
                         "type": "number"
                     },
-                    "distribution": {
-                        "items": {
-                            "$ref": "#/components/schemas/simulation.PlacementDistribution"
-                        },
-                        "type": "array",
-                        "uniqueItems": false
+                    "cpuTotal": {
+                        "type": "number"
                     },
-                    "ramRequest": {
+                    "maxPods": {
                         "type": "integer"
                     },
-                    "serviceName": {
+                    "node": {
                         "type": "string"
-                    }
-                },
-                "type": "object"
-            },
-            "simulation.NeighborhoodMeta": {
-                "properties": {
-                    "depthUsed": {
-                        "type": "integer"
                     },
-                    "description": {
-                        "type": "string"
+                    "ramAvailableMB": {
+                        "type": "number"
                     },
-                    "edgeCount": {
-                        "type": "integer"
+                    "ramTotalMB": {
+                        "type": "number"
                     },
-                    "generatedAt": {
+                    "reason": {
                         "type": "string"
                     },
-                    "serviceCount": {
+                    "score": {
                         "type": "integer"
+                    },
+                    "suitable": {
+                        "type": "boolean"
                     }
                 },
                 "type": "object"
             },
-            "simulation.NodeCapacity": {
+            "api.Problem": {
                 "properties": {
-                    "availableCpu": {
-                        "type": "number"
-                    },
-                    "availableRam": {
-                        "type": "number"
+                    "code": {
+                        "$ref": "#/components/schemas/common.ErrorCode"
                     },
-                    "canFit": {
-                        "type": "boolean"
-                    },
-                    "cpuAvailable": {
-                        "type": "number"
-                    },
-                    "cpuTotal": {
-                        "type": "number"
-                    },
-                    "maxPods": {
-                        "type": "integer"
-                    },
-                    "node": {
+                    "correlationId": {
                         "type": "string"
                     },
-                    "nodeName": {
+                    "detail": {
                         "type": "string"
                     },
-                    "ramAvailableMB": {
-                        "type": "number"
-                    },
-                    "ramTotalMB": {
-                        "type": "number"
-                    },
-                    "reason": {
+                    "instance": {
                         "type": "string"
                     },
-                    "score": {
+                    "status": {
                         "type": "integer"
                     },
-                    "suitable": {
-                        "type": "boolean"
+                    "title": {
+                        "type": "string"
+                    },
+                    "type": {
+                        "type": "string"
                     }
                 },
                 "type": "object"
             },
-            "simulation.PlacementDistribution": {
+            "api.RecommendationV2": {
                 "properties": {
-                    "node": {
+                    "action": {
                         "type": "string"
                     },
-                    "replicas": {
-                        "type": "integer"
+                    "priority": {
+                        "type": "string"
+                    },
+                    "reason": {
+                        "type": "string"
+                    },
+                    "target": {
+                        "type": "string"
+                    },
+                    "type": {
+                        "type": "string"
                     }
                 },
                 "type": "object"
             },
-            "simulation.ScalingLatencyEstimate": {
+            "api.RecordOutcomeRequest": {
                 "properties": {
-                    "baselineMs": {
-                        "type": "number"
-                    },
-                    "deltaMs": {
-                        "type": "number"
+                    "metric": {
+                        "type": "string"
                     },
-                    "description": {
+                    "notes": {
                         "type": "string"
                     },
-                    "projectedMs": {
+                    "observedMs": {
                         "type": "number"
-                    },
-                    "unit": {
-                        "type": "string"
                     }
                 },
                 "type": "object"
             },
-            "simulation.ScalingModel": {
+            "api.RetentionPoliciesResponse": {
                 "properties": {
-                    "alpha": {
-                        "type": "number"
-                    },
-                    "type": {
-                        "type": "string"
+                    "policies": {
+                        "items": {
+                            "$ref": "#/components/schemas/retention.Policy"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     }
                 },
                 "type": "object"
             },
-            "simulation.ScalingSimulationRequest": {
+            "api.SLORequest": {
                 "properties": {
-                    "currentPods": {
-                        "type": "integer"
+                    "availabilityTarget": {
+                        "type": "number"
                     },
                     "latencyMetric": {
                         "type": "string"
                     },
-                    "maxDepth": {
-                        "type": "integer"
+                    "latencyObjective": {
+                        "type": "number"
                     },
-                    "model": {
-                        "$ref": "#/components/schemas/simulation.ScalingModel"
+                    "latencyTargetMs": {
+                        "type": "number"
                     },
-                    "newPods": {
-                        "type": "integer"
+                    "name": {
+                        "type": "string"
                     },
                     "serviceId": {
                         "type": "string"
                     },
-                    "timeWindow": {
-                        "type": "string"
+                    "windowDays": {
+                        "type": "integer"
                     }
                 },
                 "type": "object"
             },
-            "simulation.ScalingSimulationResult": {
+            "api.SLOsResponse": {
+                "properties": {
+                    "count": {
+                        "type": "integer"
+                    },
+                    "slos": {
+                        "items": {
+                            "$ref": "#/components/schemas/storage.SLO"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "api.ScalingSimulationResultV2": {
                 "properties": {
                     "affectedCallers": {
-                        "$ref": "#/components/schemas/simulation.AffectedCallersList"
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AffectedCallerScaling"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
                     "affectedPaths": {
                         "items": {
@@ -686,6 +857,9 @@ const docTemplate = `{
                         "type": "array",
                         "uniqueItems": false
                     },
+                    "baseline": {
+                        "$ref": "#/components/schemas/simulation.ScalingBaseline"
+                    },
                     "confidence": {
                         "type": "string"
                     },
@@ -712,7 +886,7 @@ const docTemplate = `{
                     },
                     "recommendations": {
                         "items": {
-                            "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                            "$ref": "#/components/schemas/api.RecommendationV2"
                         },
                         "type": "array",
                         "uniqueItems": false
@@ -723,6 +897,13 @@ const docTemplate = `{
                     "scalingModel": {
                         "$ref": "#/components/schemas/simulation.ScalingModel"
                     },
+                    "sloBreaches": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.SLOBreach"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
                     "target": {
                         "$ref": "#/components/schemas/simulation.ServiceRef"
                     },
@@ -736,30 +917,216 @@ const docTemplate = `{
                 },
                 "type": "object"
             },
-            "simulation.ServiceRef": {
+            "api.TagsRequest": {
                 "properties": {
-                    "name": {
+                    "tags": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "api.TagsResponse": {
+                "properties": {
+                    "decisionId": {
+                        "type": "integer"
+                    },
+                    "tags": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "api.TopologyEventsResponse": {
+                "properties": {
+                    "count": {
+                        "type": "integer"
+                    },
+                    "events": {
+                        "items": {
+                            "$ref": "#/components/schemas/storage.TopologyEvent"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "common.ErrorCode": {
+                "enum": [
+                    "INVALID_REQUEST_BODY",
+                    "VALIDATION_FAILED",
+                    "MISSING_PARAMETER",
+                    "INVALID_PARAMETER",
+                    "INVALID_TIMESTAMP",
+                    "TIME_RANGE_TOO_LARGE",
+                    "DEPTH_OUT_OF_RANGE",
+                    "INVALID_LATENCY_METRIC",
+                    "INVALID_POD_COUNT",
+                    "INVALID_SCALING_MODEL",
+                    "INVALID_ALPHA",
+                    "INVALID_RESOURCE_REQUEST",
+                    "INVALID_METRIC",
+                    "INVALID_DECISION_TYPE",
+                    "SERVICE_NOT_FOUND",
+                    "NOT_FOUND",
+                    "METHOD_NOT_ALLOWED",
+                    "NO_NODES_FOUND",
+                    "UPSTREAM_UNAVAILABLE",
+                    "UPSTREAM_TIMEOUT",
+                    "UPSTREAM_ERROR",
+                    "TELEMETRY_UNAVAILABLE",
+                    "DECISION_STORE_UNAVAILABLE",
+                    "CHANGE_WINDOW_OPEN",
+                    "NO_OBSERVED_DATA",
+                    "INVALID_TRANSITION",
+                    "ALREADY_EXISTS",
+                    "INTERNAL_ERROR"
+                ],
+                "type": "string",
+                "x-enum-varnames": [
+                    "CodeInvalidRequestBody",
+                    "CodeValidationFailed",
+                    "CodeMissingParameter",
+                    "CodeInvalidParameter",
+                    "CodeInvalidTimestamp",
+                    "CodeTimeRangeTooLarge",
+                    "CodeDepthOutOfRange",
+                    "CodeInvalidLatencyMetric",
+                    "CodeInvalidPodCount",
+                    "CodeInvalidScalingModel",
+                    "CodeInvalidAlpha",
+                    "CodeInvalidResourceRequest",
+                    "CodeInvalidMetric",
+                    "CodeInvalidDecisionType",
+                    "CodeServiceNotFound",
+                    "CodeNotFound",
+                    "CodeMethodNotAllowed",
+                    "CodeNoNodesFound",
+                    "CodeUpstreamUnavailable",
+                    "CodeUpstreamTimeout",
+                    "CodeUpstreamError",
+                    "CodeTelemetryUnavailable",
+                    "CodeDecisionStoreUnavailable",
+                    "CodeChangeWindowOpen",
+                    "CodeNoObservedData",
+                    "CodeInvalidTransition",
+                    "CodeAlreadyExists",
+                    "CodeInternal"
+                ]
+            },
+            "forecast.Interval": {
+                "properties": {
+                    "lower": {
+                        "type": "number"
+                    },
+                    "upper": {
+                        "type": "number"
+                    },
+                    "value": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "forecast.Model": {
+                "properties": {
+                    "alpha": {
+                        "type": "number"
+                    },
+                    "beta": {
+                        "type": "number"
+                    },
+                    "gamma": {
+                        "type": "number"
+                    },
+                    "method": {
                         "type": "string"
                     },
-                    "namespace": {
+                    "period": {
+                        "type": "integer"
+                    },
+                    "rmse": {
+                        "type": "number"
+                    },
+                    "samples": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "forecast.Point": {
+                "properties": {
+                    "p95": {
+                        "$ref": "#/components/schemas/forecast.Interval"
+                    },
+                    "rps": {
+                        "$ref": "#/components/schemas/forecast.Interval"
+                    },
+                    "timestamp": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "forecast.ServiceForecast": {
+                "properties": {
+                    "confidenceLevel": {
+                        "type": "number"
+                    },
+                    "historyFrom": {
+                        "type": "string"
+                    },
+                    "historyTo": {
+                        "type": "string"
+                    },
+                    "horizon": {
                         "type": "string"
                     },
+                    "models": {
+                        "$ref": "#/components/schemas/forecast.ServiceModels"
+                    },
+                    "points": {
+                        "items": {
+                            "$ref": "#/components/schemas/forecast.Point"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
                     "serviceId": {
                         "type": "string"
+                    },
+                    "stepSeconds": {
+                        "type": "integer"
                     }
                 },
                 "type": "object"
             },
-            "simulation.UnreachableService": {
+            "forecast.ServiceModels": {
                 "properties": {
-                    "lostFromReachableCutsRps": {
-                        "type": "number"
+                    "p95": {
+                        "$ref": "#/components/schemas/forecast.Model"
                     },
-                    "lostFromTargetRps": {
+                    "rps": {
+                        "$ref": "#/components/schemas/forecast.Model"
+                    }
+                },
+                "type": "object"
+            },
+            "graph.CentralityServiceInfo": {
+                "properties": {
+                    "centralityScore": {
                         "type": "number"
                     },
-                    "lostTrafficRps": {
-                        "type": "number"
+                    "explanation": {
+                        "type": "string"
                     },
                     "name": {
                         "type": "string"
@@ -767,128 +1134,12649 @@ const docTemplate = `{
                     "namespace": {
                         "type": "string"
                     },
+                    "riskLevel": {
+                        "type": "string"
+                    },
                     "serviceId": {
                         "type": "string"
                     }
                 },
                 "type": "object"
             },
-            "storage.LogDecisionInput": {
+            "graph.DataFreshness": {
                 "properties": {
-                    "correlationId": {
+                    "lastUpdatedSecondsAgo": {
+                        "type": "integer"
+                    },
+                    "source": {
                         "type": "string"
                     },
-                    "result": {},
-                    "scenario": {},
-                    "timestamp": {
+                    "stale": {
+                        "type": "boolean"
+                    },
+                    "windowMinutes": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "graph.TopCentralityResponse": {
+                "properties": {
+                    "confidence": {
                         "type": "string"
                     },
-                    "type": {
+                    "dataFreshness": {
+                        "$ref": "#/components/schemas/graph.DataFreshness"
+                    },
+                    "metric": {
                         "type": "string"
+                    },
+                    "services": {
+                        "items": {
+                            "$ref": "#/components/schemas/graph.CentralityServiceInfo"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     }
                 },
                 "type": "object"
-            }
-        }
-    },
-    "info": {
-        "contact": {
+            },
+            "graphqlapi.Request": {
+                "properties": {
+                    "operationName": {
+                        "type": "string"
+                    },
+                    "query": {
+                        "type": "string"
+                    },
+                    "variables": {
+                        "additionalProperties": {},
+                        "type": "object"
+                    }
+                },
+                "type": "object"
+            },
+            "retention.Policy": {
+                "properties": {
+                    "before": {
+                        "type": "string"
+                    },
+                    "deleted": {
+                        "type": "integer"
+                    },
+                    "maxAgeDays": {
+                        "type": "integer"
+                    },
+                    "type": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "retention.Result": {
+                "properties": {
+                    "archiveFile": {
+                        "type": "string"
+                    },
+                    "deleted": {
+                        "type": "integer"
+                    },
+                    "policies": {
+                        "items": {
+                            "$ref": "#/components/schemas/retention.Policy"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.AddRiskAnalysis": {
+                "properties": {
+                    "dependencyRisk": {
+                        "type": "string"
+                    },
+                    "description": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.AddSimulationRequest": {
+                "properties": {
+                    "cpuRequest": {
+                        "type": "number"
+                    },
+                    "dependencies": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.DependencyRef"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "ramRequest": {
+                        "type": "integer"
+                    },
+                    "replicas": {
+                        "type": "integer"
+                    },
+                    "serviceName": {
+                        "type": "string"
+                    },
+                    "timeWindow": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.AddSimulationResult": {
+                "properties": {
+                    "confidence": {
+                        "type": "string"
+                    },
+                    "explanation": {
+                        "type": "string"
+                    },
+                    "recommendation": {
+                        "$ref": "#/components/schemas/simulation.LegacyRecommendation"
+                    },
+                    "recommendations": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "riskAnalysis": {
+                        "$ref": "#/components/schemas/simulation.AddRiskAnalysis"
+                    },
+                    "success": {
+                        "type": "boolean"
+                    },
+                    "suitableNodes": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.NodeCapacity"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "targetServiceName": {
+                        "type": "string"
+                    },
+                    "totalCapacityPods": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.AffectedCaller": {
+                "properties": {
+                    "edgeErrorRate": {
+                        "type": "number"
+                    },
+                    "lostTrafficRps": {
+                        "type": "number"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "namespace": {
+                        "type": "string"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.AffectedCallerScaling": {
+                "properties": {
+                    "afterMs": {
+                        "type": "number"
+                    },
+                    "beforeMs": {
+                        "type": "number"
+                    },
+                    "deltaMs": {
+                        "type": "number"
+                    },
+                    "endToEndAfterMs": {
+                        "type": "number"
+                    },
+                    "endToEndBeforeMs": {
+                        "type": "number"
+                    },
+                    "endToEndDeltaMs": {
+                        "type": "number"
+                    },
+                    "hopDistance": {
+                        "type": "integer"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "namespace": {
+                        "type": "string"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    },
+                    "viaPath": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.AffectedCallersList": {
+                "properties": {
+                    "description": {
+                        "type": "string"
+                    },
+                    "items": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AffectedCallerScaling"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.AffectedDownstream": {
+                "properties": {
+                    "edgeErrorRate": {
+                        "type": "number"
+                    },
+                    "lostTrafficRps": {
+                        "type": "number"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "namespace": {
+                        "type": "string"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.AffectedPathScaling": {
+                "properties": {
+                    "afterMs": {
+                        "type": "number"
+                    },
+                    "beforeMs": {
+                        "type": "number"
+                    },
+                    "deltaMs": {
+                        "type": "number"
+                    },
+                    "incompleteData": {
+                        "type": "boolean"
+                    },
+                    "path": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "pathRps": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.AppliedMutation": {
+                "properties": {
+                    "afterRps": {
+                        "type": "number"
+                    },
+                    "beforeRps": {
+                        "type": "number"
+                    },
+                    "from": {
+                        "type": "string"
+                    },
+                    "op": {
+                        "type": "string"
+                    },
+                    "percent": {
+                        "type": "number"
+                    },
+                    "rate": {
+                        "type": "number"
+                    },
+                    "source": {
+                        "type": "string"
+                    },
+                    "target": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.BrokenPath": {
+                "properties": {
+                    "path": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "pathRps": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.CallerLatencyChange": {
+                "properties": {
+                    "afterMs": {
+                        "type": "number"
+                    },
+                    "beforeMs": {
+                        "type": "number"
+                    },
+                    "deltaMs": {
+                        "type": "number"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.CallersDiff": {
+                "properties": {
+                    "added": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AffectedCaller"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "changed": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.ServiceRpsChange"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "removed": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AffectedCaller"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.CriticalPathsDiff": {
+                "properties": {
+                    "added": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.BrokenPath"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "changed": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.PathRpsChange"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "removed": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.BrokenPath"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.CycleComponentChange": {
+                "properties": {
+                    "afterServices": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "beforeServices": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "services": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.DataFreshness": {
+                "properties": {
+                    "lastUpdatedSecondsAgo": {
+                        "type": "integer"
+                    },
+                    "source": {
+                        "type": "string"
+                    },
+                    "stale": {
+                        "type": "boolean"
+                    },
+                    "windowMinutes": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.DependencyRef": {
+                "properties": {
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.DrainSimulationRequest": {
+                "properties": {
+                    "nodes": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.DrainSimulationResult": {
+                "properties": {
+                    "affectedServices": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.DrainedService"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "confidence": {
+                        "type": "string"
+                    },
+                    "drainedNodes": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "explanation": {
+                        "type": "string"
+                    },
+                    "nodes": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.NodeUtilization"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "podsToMove": {
+                        "type": "integer"
+                    },
+                    "recommendations": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "rescheduled": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.PodMove"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "success": {
+                        "type": "boolean"
+                    },
+                    "unschedulable": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.PodMove"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.DrainedService": {
+                "properties": {
+                    "podsLost": {
+                        "type": "integer"
+                    },
+                    "podsMoved": {
+                        "type": "integer"
+                    },
+                    "replicasAfter": {
+                        "type": "integer"
+                    },
+                    "replicasBefore": {
+                        "type": "integer"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.DroppedCall": {
+                "properties": {
+                    "rps": {
+                        "type": "number"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.EdgeMutation": {
+                "properties": {
+                    "from": {
+                        "type": "string"
+                    },
+                    "op": {
+                        "type": "string"
+                    },
+                    "percent": {
+                        "type": "number"
+                    },
+                    "rate": {
+                        "type": "number"
+                    },
+                    "source": {
+                        "type": "string"
+                    },
+                    "target": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.FailureDiff": {
+                "properties": {
+                    "callers": {
+                        "$ref": "#/components/schemas/simulation.CallersDiff"
+                    },
+                    "confidenceChanged": {
+                        "$ref": "#/components/schemas/simulation.StringChange"
+                    },
+                    "criticalPaths": {
+                        "$ref": "#/components/schemas/simulation.CriticalPathsDiff"
+                    },
+                    "identical": {
+                        "type": "boolean"
+                    },
+                    "recommendations": {
+                        "$ref": "#/components/schemas/simulation.RecommendationsDiff"
+                    },
+                    "targetChanged": {
+                        "$ref": "#/components/schemas/simulation.StringChange"
+                    },
+                    "totalLostTrafficRps": {
+                        "$ref": "#/components/schemas/simulation.ValueChange"
+                    },
+                    "unreachable": {
+                        "$ref": "#/components/schemas/simulation.UnreachableDiff"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.FailureRecommendation": {
+                "properties": {
+                    "action": {
+                        "type": "string"
+                    },
+                    "description": {
+                        "type": "string"
+                    },
+                    "priority": {
+                        "type": "string"
+                    },
+                    "reason": {
+                        "type": "string"
+                    },
+                    "target": {
+                        "type": "string"
+                    },
+                    "type": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.FailureSimulationRequest": {
+                "properties": {
+                    "depth": {
+                        "type": "integer"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.FailureSimulationResult": {
+                "properties": {
+                    "affectedCallers": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AffectedCaller"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "affectedDownstream": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AffectedDownstream"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "confidence": {
+                        "type": "string"
+                    },
+                    "criticalPathsToTarget": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.BrokenPath"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "dataFreshness": {
+                        "$ref": "#/components/schemas/simulation.DataFreshness"
+                    },
+                    "explanation": {
+                        "type": "string"
+                    },
+                    "neighborhood": {
+                        "$ref": "#/components/schemas/simulation.NeighborhoodMeta"
+                    },
+                    "recommendations": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "sloBreaches": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.SLOBreach"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "target": {
+                        "$ref": "#/components/schemas/simulation.ServiceRef"
+                    },
+                    "totalLostTrafficRps": {
+                        "type": "number"
+                    },
+                    "unreachableServices": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.UnreachableService"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.FreedCapacity": {
+                "properties": {
+                    "cpuCores": {
+                        "type": "number"
+                    },
+                    "nodes": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.NodeRelease"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "pods": {
+                        "type": "integer"
+                    },
+                    "ramMB": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.LegacyRecommendation": {
+                "properties": {
+                    "cpuRequest": {
+                        "type": "number"
+                    },
+                    "distribution": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.PlacementDistribution"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "ramRequest": {
+                        "type": "integer"
+                    },
+                    "serviceName": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ModelCalibration": {
+                "properties": {
+                    "fittedAt": {
+                        "type": "string"
+                    },
+                    "rmse": {
+                        "type": "number"
+                    },
+                    "samples": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.NeighborhoodMeta": {
+                "properties": {
+                    "depthUsed": {
+                        "type": "integer"
+                    },
+                    "description": {
+                        "type": "string"
+                    },
+                    "edgeCount": {
+                        "type": "integer"
+                    },
+                    "generatedAt": {
+                        "type": "string"
+                    },
+                    "serviceCount": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.NodeCapacity": {
+                "properties": {
+                    "availableCpu": {
+                        "type": "number"
+                    },
+                    "availableRam": {
+                        "type": "number"
+                    },
+                    "canFit": {
+                        "type": "boolean"
+                    },
+                    "cpuAvailable": {
+                        "type": "number"
+                    },
+                    "cpuTotal": {
+                        "type": "number"
+                    },
+                    "maxPods": {
+                        "type": "integer"
+                    },
+                    "node": {
+                        "type": "string"
+                    },
+                    "nodeName": {
+                        "type": "string"
+                    },
+                    "ramAvailableMB": {
+                        "type": "number"
+                    },
+                    "ramTotalMB": {
+                        "type": "number"
+                    },
+                    "reason": {
+                        "type": "string"
+                    },
+                    "score": {
+                        "type": "integer"
+                    },
+                    "suitable": {
+                        "type": "boolean"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.NodeRelease": {
+                "properties": {
+                    "cpuAvailableAfter": {
+                        "type": "number"
+                    },
+                    "cpuAvailableBefore": {
+                        "type": "number"
+                    },
+                    "cpuFreedCores": {
+                        "type": "number"
+                    },
+                    "cpuTotal": {
+                        "type": "number"
+                    },
+                    "node": {
+                        "type": "string"
+                    },
+                    "podsRemoved": {
+                        "type": "integer"
+                    },
+                    "ramAvailableAfterMB": {
+                        "type": "number"
+                    },
+                    "ramAvailableBeforeMB": {
+                        "type": "number"
+                    },
+                    "ramFreedMB": {
+                        "type": "number"
+                    },
+                    "ramTotalMB": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.NodeUtilization": {
+                "properties": {
+                    "cpuTotal": {
+                        "type": "number"
+                    },
+                    "cpuUsedAfter": {
+                        "type": "number"
+                    },
+                    "cpuUsedBefore": {
+                        "type": "number"
+                    },
+                    "cpuUtilizationAfter": {
+                        "type": "number"
+                    },
+                    "cpuUtilizationBefore": {
+                        "type": "number"
+                    },
+                    "node": {
+                        "type": "string"
+                    },
+                    "podsAdded": {
+                        "type": "integer"
+                    },
+                    "ramTotalMB": {
+                        "type": "number"
+                    },
+                    "ramUsedAfterMB": {
+                        "type": "number"
+                    },
+                    "ramUsedBeforeMB": {
+                        "type": "number"
+                    },
+                    "ramUtilizationAfter": {
+                        "type": "number"
+                    },
+                    "ramUtilizationBefore": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.PathRpsChange": {
+                "properties": {
+                    "path": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "pathRps": {
+                        "$ref": "#/components/schemas/simulation.ValueChange"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.PlacementDistribution": {
+                "properties": {
+                    "node": {
+                        "type": "string"
+                    },
+                    "replicas": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.PodMove": {
+                "properties": {
+                    "cpuRequest": {
+                        "type": "number"
+                    },
+                    "fromNode": {
+                        "type": "string"
+                    },
+                    "pod": {
+                        "type": "string"
+                    },
+                    "ramRequestMB": {
+                        "type": "number"
+                    },
+                    "reason": {
+                        "type": "string"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    },
+                    "toNode": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.RecommendationChange": {
+                "properties": {
+                    "after": {
+                        "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                    },
+                    "before": {
+                        "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                    },
+                    "target": {
+                        "type": "string"
+                    },
+                    "type": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.RecommendationsDiff": {
+                "properties": {
+                    "added": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "changed": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.RecommendationChange"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "removed": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.RedirectImpact": {
+                "properties": {
+                    "afterMs": {
+                        "type": "number"
+                    },
+                    "beforeMs": {
+                        "type": "number"
+                    },
+                    "callerId": {
+                        "type": "string"
+                    },
+                    "deltaMs": {
+                        "type": "number"
+                    },
+                    "replacementId": {
+                        "type": "string"
+                    },
+                    "rps": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.RemoveSimulationRequest": {
+                "properties": {
+                    "latencyMetric": {
+                        "type": "string"
+                    },
+                    "redirects": {
+                        "additionalProperties": {
+                            "type": "string"
+                        },
+                        "type": "object"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.RemoveSimulationResult": {
+                "properties": {
+                    "confidence": {
+                        "type": "string"
+                    },
+                    "droppedCalls": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.DroppedCall"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "explanation": {
+                        "type": "string"
+                    },
+                    "freedCapacity": {
+                        "$ref": "#/components/schemas/simulation.FreedCapacity"
+                    },
+                    "latencyMetric": {
+                        "type": "string"
+                    },
+                    "lostTrafficRps": {
+                        "type": "number"
+                    },
+                    "recommendations": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "redirects": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.RedirectImpact"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "replacements": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.ReplacementLoad"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "scalingModel": {
+                        "$ref": "#/components/schemas/simulation.ScalingModel"
+                    },
+                    "target": {
+                        "$ref": "#/components/schemas/simulation.ServiceRef"
+                    },
+                    "unmigratedCallers": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AffectedCaller"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "warnings": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ReplacementLoad": {
+                "properties": {
+                    "addedRps": {
+                        "type": "number"
+                    },
+                    "currentLatencyMs": {
+                        "type": "number"
+                    },
+                    "currentRps": {
+                        "type": "number"
+                    },
+                    "deltaMs": {
+                        "type": "number"
+                    },
+                    "loadFactor": {
+                        "type": "number"
+                    },
+                    "podCount": {
+                        "type": "integer"
+                    },
+                    "projectedLatencyMs": {
+                        "type": "number"
+                    },
+                    "projectedRps": {
+                        "type": "number"
+                    },
+                    "scalingModel": {
+                        "$ref": "#/components/schemas/simulation.ScalingModel"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    },
+                    "suggestedPods": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.RewireSimulationRequest": {
+                "properties": {
+                    "depth": {
+                        "type": "integer"
+                    },
+                    "latencyMetric": {
+                        "type": "string"
+                    },
+                    "mutations": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.EdgeMutation"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.RewireSimulationResult": {
+                "properties": {
+                    "assumptions": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "callerLatency": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.CallerLatencyChange"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "changedComponents": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.CycleComponentChange"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "confidence": {
+                        "type": "string"
+                    },
+                    "explanation": {
+                        "type": "string"
+                    },
+                    "latencyMetric": {
+                        "type": "string"
+                    },
+                    "mutations": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AppliedMutation"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "neighborhood": {
+                        "$ref": "#/components/schemas/simulation.NeighborhoodMeta"
+                    },
+                    "newCycles": {
+                        "items": {
+                            "$ref": "#/components/schemas/analysis.Cycle"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "newSpofs": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.SinglePointOfFailure"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "pathChanges": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.RewiredPath"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "recommendations": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "removedCycles": {
+                        "items": {
+                            "$ref": "#/components/schemas/analysis.Cycle"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "resolvedSpofs": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.SinglePointOfFailure"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "serviceLoad": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.ServiceLoadChange"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "warnings": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.RewiredPath": {
+                "properties": {
+                    "afterMs": {
+                        "type": "number"
+                    },
+                    "afterRps": {
+                        "type": "number"
+                    },
+                    "beforeMs": {
+                        "type": "number"
+                    },
+                    "beforeRps": {
+                        "type": "number"
+                    },
+                    "change": {
+                        "type": "string"
+                    },
+                    "deltaMs": {
+                        "type": "number"
+                    },
+                    "incompleteData": {
+                        "type": "boolean"
+                    },
+                    "path": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.SLOBreach": {
+                "properties": {
+                    "name": {
+                        "type": "string"
+                    },
+                    "objective": {
+                        "type": "string"
+                    },
+                    "projected": {
+                        "type": "number"
+                    },
+                    "reason": {
+                        "type": "string"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    },
+                    "sloId": {
+                        "type": "integer"
+                    },
+                    "target": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ScalingBaseline": {
+                "properties": {
+                    "at": {
+                        "type": "string"
+                    },
+                    "currentEdges": {
+                        "type": "integer"
+                    },
+                    "forecastEdges": {
+                        "type": "integer"
+                    },
+                    "source": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ScalingCallerChange": {
+                "properties": {
+                    "afterMs": {
+                        "$ref": "#/components/schemas/simulation.ValueChange"
+                    },
+                    "deltaMs": {
+                        "$ref": "#/components/schemas/simulation.ValueChange"
+                    },
+                    "endToEndAfterMs": {
+                        "$ref": "#/components/schemas/simulation.ValueChange"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ScalingCallersDiff": {
+                "properties": {
+                    "added": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AffectedCallerScaling"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "changed": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.ScalingCallerChange"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "removed": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AffectedCallerScaling"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ScalingDiff": {
+                "properties": {
+                    "baselineMs": {
+                        "$ref": "#/components/schemas/simulation.ValueChange"
+                    },
+                    "callers": {
+                        "$ref": "#/components/schemas/simulation.ScalingCallersDiff"
+                    },
+                    "confidenceChanged": {
+                        "$ref": "#/components/schemas/simulation.StringChange"
+                    },
+                    "currentPods": {
+                        "$ref": "#/components/schemas/simulation.ValueChange"
+                    },
+                    "identical": {
+                        "type": "boolean"
+                    },
+                    "latencyMetricChanged": {
+                        "$ref": "#/components/schemas/simulation.StringChange"
+                    },
+                    "newPods": {
+                        "$ref": "#/components/schemas/simulation.ValueChange"
+                    },
+                    "paths": {
+                        "$ref": "#/components/schemas/simulation.ScalingPathsDiff"
+                    },
+                    "projectedMs": {
+                        "$ref": "#/components/schemas/simulation.ValueChange"
+                    },
+                    "recommendations": {
+                        "$ref": "#/components/schemas/simulation.RecommendationsDiff"
+                    },
+                    "scalingModelChanged": {
+                        "$ref": "#/components/schemas/simulation.StringChange"
+                    },
+                    "targetChanged": {
+                        "$ref": "#/components/schemas/simulation.StringChange"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ScalingLatencyEstimate": {
+                "properties": {
+                    "baselineMs": {
+                        "type": "number"
+                    },
+                    "deltaMs": {
+                        "type": "number"
+                    },
+                    "description": {
+                        "type": "string"
+                    },
+                    "projectedMs": {
+                        "type": "number"
+                    },
+                    "unit": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ScalingModel": {
+                "properties": {
+                    "alpha": {
+                        "type": "number"
+                    },
+                    "calibration": {
+                        "$ref": "#/components/schemas/simulation.ModelCalibration"
+                    },
+                    "minLatencyFactor": {
+                        "type": "number"
+                    },
+                    "source": {
+                        "type": "string"
+                    },
+                    "type": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ScalingPathChange": {
+                "properties": {
+                    "afterMs": {
+                        "$ref": "#/components/schemas/simulation.ValueChange"
+                    },
+                    "deltaMs": {
+                        "$ref": "#/components/schemas/simulation.ValueChange"
+                    },
+                    "path": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ScalingPathsDiff": {
+                "properties": {
+                    "added": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AffectedPathScaling"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "changed": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.ScalingPathChange"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "removed": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AffectedPathScaling"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ScalingSimulationRequest": {
+                "properties": {
+                    "baseline": {
+                        "description": "Baseline is \"current\" (the default) or \"forecast@\u003cRFC3339 time\u003e\" to\nreplace edge metrics with their forecast at that time.",
+                        "type": "string"
+                    },
+                    "currentPods": {
+                        "type": "integer"
+                    },
+                    "latencyMetric": {
+                        "type": "string"
+                    },
+                    "maxDepth": {
+                        "type": "integer"
+                    },
+                    "model": {
+                        "$ref": "#/components/schemas/simulation.ScalingModel"
+                    },
+                    "newPods": {
+                        "type": "integer"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    },
+                    "timeWindow": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ScalingSimulationResult": {
+                "properties": {
+                    "affectedCallers": {
+                        "$ref": "#/components/schemas/simulation.AffectedCallersList"
+                    },
+                    "affectedPaths": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.AffectedPathScaling"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "baseline": {
+                        "$ref": "#/components/schemas/simulation.ScalingBaseline"
+                    },
+                    "confidence": {
+                        "type": "string"
+                    },
+                    "currentPods": {
+                        "type": "integer"
+                    },
+                    "dataFreshness": {
+                        "$ref": "#/components/schemas/simulation.DataFreshness"
+                    },
+                    "explanation": {
+                        "type": "string"
+                    },
+                    "latencyEstimate": {
+                        "$ref": "#/components/schemas/simulation.ScalingLatencyEstimate"
+                    },
+                    "latencyMetric": {
+                        "type": "string"
+                    },
+                    "neighborhood": {
+                        "$ref": "#/components/schemas/simulation.NeighborhoodMeta"
+                    },
+                    "newPods": {
+                        "type": "integer"
+                    },
+                    "recommendations": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.FailureRecommendation"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "scalingDirection": {
+                        "type": "string"
+                    },
+                    "scalingModel": {
+                        "$ref": "#/components/schemas/simulation.ScalingModel"
+                    },
+                    "sloBreaches": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.SLOBreach"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "target": {
+                        "$ref": "#/components/schemas/simulation.ServiceRef"
+                    },
+                    "warnings": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ServiceLoadChange": {
+                "properties": {
+                    "afterRps": {
+                        "type": "number"
+                    },
+                    "beforeRps": {
+                        "type": "number"
+                    },
+                    "deltaRps": {
+                        "type": "number"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ServiceRef": {
+                "properties": {
+                    "name": {
+                        "type": "string"
+                    },
+                    "namespace": {
+                        "type": "string"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ServiceRpsChange": {
+                "properties": {
+                    "lostTrafficRps": {
+                        "$ref": "#/components/schemas/simulation.ValueChange"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.SinglePointOfFailure": {
+                "properties": {
+                    "cutOff": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.StringChange": {
+                "properties": {
+                    "after": {
+                        "type": "string"
+                    },
+                    "before": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.UnreachableDiff": {
+                "properties": {
+                    "added": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.UnreachableService"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "changed": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.ServiceRpsChange"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "removed": {
+                        "items": {
+                            "$ref": "#/components/schemas/simulation.UnreachableService"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.UnreachableService": {
+                "properties": {
+                    "lostFromReachableCutsRps": {
+                        "type": "number"
+                    },
+                    "lostFromTargetRps": {
+                        "type": "number"
+                    },
+                    "lostTrafficRps": {
+                        "type": "number"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "namespace": {
+                        "type": "string"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "simulation.ValueChange": {
+                "properties": {
+                    "after": {
+                        "type": "number"
+                    },
+                    "before": {
+                        "type": "number"
+                    },
+                    "delta": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "slo.BurnAlert": {
+                "properties": {
+                    "longWindow": {
+                        "type": "string"
+                    },
+                    "shortWindow": {
+                        "type": "string"
+                    },
+                    "threshold": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "slo.BurnRate": {
+                "properties": {
+                    "rate": {
+                        "type": "number"
+                    },
+                    "window": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "slo.ObjectiveStatus": {
+                "properties": {
+                    "alerts": {
+                        "items": {
+                            "$ref": "#/components/schemas/slo.BurnAlert"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "burnRates": {
+                        "items": {
+                            "$ref": "#/components/schemas/slo.BurnRate"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "compliance": {
+                        "type": "number"
+                    },
+                    "errorBudgetRemaining": {
+                        "type": "number"
+                    },
+                    "latencyMetric": {
+                        "type": "string"
+                    },
+                    "latencyTargetMs": {
+                        "type": "number"
+                    },
+                    "objective": {
+                        "type": "string"
+                    },
+                    "state": {
+                        "type": "string"
+                    },
+                    "target": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "slo.Status": {
+                "properties": {
+                    "evaluatedAt": {
+                        "type": "string"
+                    },
+                    "objectives": {
+                        "items": {
+                            "$ref": "#/components/schemas/slo.ObjectiveStatus"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "slo": {
+                        "$ref": "#/components/schemas/storage.SLO"
+                    },
+                    "state": {
+                        "type": "string"
+                    },
+                    "stepSeconds": {
+                        "type": "integer"
+                    },
+                    "windowFrom": {
+                        "type": "string"
+                    },
+                    "windowTo": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "snapshot.Edge": {
+                "properties": {
+                    "id": {
+                        "type": "string"
+                    },
+                    "latencyP95Ms": {
+                        "type": "number"
+                    },
+                    "reqRate": {
+                        "type": "number"
+                    },
+                    "source": {
+                        "type": "string"
+                    },
+                    "target": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "snapshot.Metadata": {
+                "properties": {
+                    "edgeCount": {
+                        "type": "integer"
+                    },
+                    "edgesWithMetrics": {
+                        "type": "integer"
+                    },
+                    "generatedAt": {
+                        "type": "string"
+                    },
+                    "lastUpdatedSecondsAgo": {
+                        "type": "integer"
+                    },
+                    "nodeCount": {
+                        "type": "integer"
+                    },
+                    "nodesWithMetrics": {
+                        "type": "integer"
+                    },
+                    "stale": {
+                        "type": "boolean"
+                    },
+                    "windowMinutes": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "snapshot.Node": {
+                "properties": {
+                    "availability": {
+                        "type": "number"
+                    },
+                    "availabilityPct": {
+                        "type": "number"
+                    },
+                    "betweenness": {
+                        "type": "number"
+                    },
+                    "errorRatePct": {
+                        "type": "number"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "latencyP95Ms": {
+                        "type": "number"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "namespace": {
+                        "type": "string"
+                    },
+                    "pageRank": {
+                        "type": "number"
+                    },
+                    "podCount": {
+                        "type": "integer"
+                    },
+                    "reqRate": {
+                        "type": "number"
+                    },
+                    "riskLevel": {
+                        "type": "string"
+                    },
+                    "riskReason": {
+                        "type": "string"
+                    },
+                    "updatedAt": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "snapshot.Response": {
+                "properties": {
+                    "edges": {
+                        "items": {
+                            "$ref": "#/components/schemas/snapshot.Edge"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "metadata": {
+                        "$ref": "#/components/schemas/snapshot.Metadata"
+                    },
+                    "nodes": {
+                        "items": {
+                            "$ref": "#/components/schemas/snapshot.Node"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "storage.Annotation": {
+                "properties": {
+                    "author": {
+                        "type": "string"
+                    },
+                    "body": {
+                        "type": "string"
+                    },
+                    "createdAt": {
+                        "type": "string"
+                    },
+                    "decisionId": {
+                        "type": "integer"
+                    },
+                    "id": {
+                        "type": "integer"
+                    },
+                    "parentId": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "storage.AnomalyEvent": {
+                "properties": {
+                    "detectedAt": {
+                        "type": "string"
+                    },
+                    "deviationScore": {
+                        "type": "number"
+                    },
+                    "direction": {
+                        "type": "string"
+                    },
+                    "expected": {
+                        "type": "number"
+                    },
+                    "id": {
+                        "type": "integer"
+                    },
+                    "kind": {
+                        "type": "string"
+                    },
+                    "method": {
+                        "type": "string"
+                    },
+                    "metric": {
+                        "type": "string"
+                    },
+                    "observedAt": {
+                        "type": "string"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    },
+                    "severity": {
+                        "type": "string"
+                    },
+                    "targetId": {
+                        "type": "string"
+                    },
+                    "value": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "storage.Approval": {
+                "properties": {
+                    "actor": {
+                        "type": "string"
+                    },
+                    "state": {
+                        "type": "string"
+                    },
+                    "updatedAt": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "storage.ApprovalEvent": {
+                "properties": {
+                    "actor": {
+                        "type": "string"
+                    },
+                    "comment": {
+                        "type": "string"
+                    },
+                    "createdAt": {
+                        "type": "string"
+                    },
+                    "fromState": {
+                        "type": "string"
+                    },
+                    "id": {
+                        "type": "integer"
+                    },
+                    "state": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "storage.DecisionRecord": {
+                "properties": {
+                    "approval": {
+                        "$ref": "#/components/schemas/storage.Approval"
+                    },
+                    "correlationId": {
+                        "type": "string"
+                    },
+                    "createdAt": {
+                        "type": "string"
+                    },
+                    "id": {
+                        "type": "integer"
+                    },
+                    "result": {},
+                    "scenario": {},
+                    "tags": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "timestamp": {
+                        "type": "string"
+                    },
+                    "type": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "storage.LogDecisionInput": {
+                "properties": {
+                    "correlationId": {
+                        "type": "string"
+                    },
+                    "result": {},
+                    "scenario": {},
+                    "tags": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "timestamp": {
+                        "type": "string"
+                    },
+                    "type": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "storage.ModelAccuracy": {
+                "properties": {
+                    "biasMs": {
+                        "type": "number"
+                    },
+                    "maeMs": {
+                        "type": "number"
+                    },
+                    "mapePct": {
+                        "type": "number"
+                    },
+                    "maxErrorMs": {
+                        "type": "number"
+                    },
+                    "modelType": {
+                        "type": "string"
+                    },
+                    "samples": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "storage.Outcome": {
+                "properties": {
+                    "baselineMs": {
+                        "type": "number"
+                    },
+                    "decisionId": {
+                        "type": "integer"
+                    },
+                    "errorMs": {
+                        "type": "number"
+                    },
+                    "metric": {
+                        "type": "string"
+                    },
+                    "modelType": {
+                        "type": "string"
+                    },
+                    "notes": {
+                        "type": "string"
+                    },
+                    "observedMs": {
+                        "type": "number"
+                    },
+                    "predictedMs": {
+                        "type": "number"
+                    },
+                    "recordedAt": {
+                        "type": "string"
+                    },
+                    "source": {
+                        "type": "string"
+                    },
+                    "windowEnd": {
+                        "type": "string"
+                    },
+                    "windowStart": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "storage.SLO": {
+                "properties": {
+                    "availabilityTarget": {
+                        "type": "number"
+                    },
+                    "createdAt": {
+                        "type": "string"
+                    },
+                    "id": {
+                        "type": "integer"
+                    },
+                    "latencyMetric": {
+                        "type": "string"
+                    },
+                    "latencyObjective": {
+                        "type": "number"
+                    },
+                    "latencyTargetMs": {
+                        "type": "number"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    },
+                    "updatedAt": {
+                        "type": "string"
+                    },
+                    "windowDays": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "storage.ScalingCalibration": {
+                "properties": {
+                    "alpha": {
+                        "type": "number"
+                    },
+                    "fittedAt": {
+                        "type": "string"
+                    },
+                    "metric": {
+                        "type": "string"
+                    },
+                    "minLatencyFactor": {
+                        "type": "number"
+                    },
+                    "rmse": {
+                        "type": "number"
+                    },
+                    "samples": {
+                        "type": "integer"
+                    },
+                    "serviceId": {
+                        "type": "string"
+                    },
+                    "windowEnd": {
+                        "type": "string"
+                    },
+                    "windowStart": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "storage.SearchPage": {
+                "properties": {
+                    "decisions": {
+                        "items": {
+                            "$ref": "#/components/schemas/storage.DecisionRecord"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "limit": {
+                        "type": "integer"
+                    },
+                    "nextCursor": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "storage.TopologyEvent": {
+                "properties": {
+                    "detail": {
+                        "type": "string"
+                    },
+                    "detectedAt": {
+                        "type": "string"
+                    },
+                    "id": {
+                        "type": "integer"
+                    },
+                    "key": {
+                        "type": "string"
+                    },
+                    "kind": {
+                        "type": "string"
+                    },
+                    "rps": {
+                        "type": "number"
+                    },
+                    "services": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "severity": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            }
+        }
+    },
+    "info": {
+        "contact": {
             "email": "support@example.com",
             "name": "API Support"
         },
-        "description": "{{escape .Description}}",
-        "license": {
-            "name": "Apache 2.0",
-            "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+        "description": "{{escape .Description}}",
+        "license": {
+            "name": "Apache 2.0",
+            "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+        },
+        "termsOfService": "http://swagger.io/terms/",
+        "title": "{{.Title}}",
+        "version": "{{.Version}}"
+    },
+    "externalDocs": {
+        "description": "",
+        "url": ""
+    },
+    "paths": {
+        "/anomalies": {
+            "get": {
+                "description": "Returns detected anomalies in request rate, error rate and p95, most recent first. The service filter matches the service itself and edges on either side of it.",
+                "parameters": [
+                    {
+                        "description": "Observed at or after (RFC3339)",
+                        "in": "query",
+                        "name": "from",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Observed at or before (RFC3339)",
+                        "in": "query",
+                        "name": "to",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "service",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated metrics: rps, error_rate, p95",
+                        "in": "query",
+                        "name": "metric",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated severities: low, medium, high, critical",
+                        "in": "query",
+                        "name": "severity",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum number of events, at most 1000",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 100,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.AnomaliesResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Anomalies",
+                "tags": [
+                    "anomalies"
+                ]
+            }
+        },
+        "/anomalies/run": {
+            "post": {
+                "description": "Scores the service and edge metrics recorded since the previous run against their baselines and stores the anomalies found. The first run after startup only builds the baselines from ANOMALY_WARMUP_HOURS of history.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/anomaly.RunResult"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Run Anomaly Detection",
+                "tags": [
+                    "anomalies"
+                ]
+            }
+        },
+        "/calibration/scaling": {
+            "get": {
+                "description": "Lists the bounded_sqrt parameters fitted per service from historical pod-count changes. Scaling simulations use them when the request does not specify a model.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.CalibrationsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Scaling Calibrations",
+                "tags": [
+                    "calibration"
+                ]
+            }
+        },
+        "/calibration/scaling/run": {
+            "post": {
+                "description": "Refits per-service bounded_sqrt parameters from pod-count changes in service_metrics over the configured lookback window and returns the services that were calibrated",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.CalibrationsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Run Scaling Calibration",
+                "tags": [
+                    "calibration"
+                ]
+            }
+        },
+        "/calibration/scaling/{serviceId}": {
+            "get": {
+                "description": "Returns the bounded_sqrt parameters fitted for one service",
+                "parameters": [
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "path",
+                        "name": "serviceId",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.ScalingCalibration"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Scaling Calibration",
+                "tags": [
+                    "calibration"
+                ]
+            }
+        },
+        "/decisions/accuracy": {
+            "get": {
+                "description": "Aggregates the error between projected and observed latency per scaling model type. errorMs is predicted minus observed, so a positive bias means the model over-estimates latency.",
+                "parameters": [
+                    {
+                        "description": "Only include outcomes recorded at or after this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "since",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.AccuracyResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Prediction Accuracy",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/diff": {
+            "get": {
+                "description": "Compares two stored failure or scaling simulations of the same type, treating a as before and b as after: added, removed and changed callers, unreachable services, critical or affected paths and recommendations.",
+                "parameters": [
+                    {
+                        "description": "Decision ID of the earlier simulation",
+                        "in": "query",
+                        "name": "a",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Decision ID of the later simulation",
+                        "in": "query",
+                        "name": "b",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.DecisionDiffResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Diff Two Decisions",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/export": {
+            "get": {
+                "description": "Streams every decision matching the search filters, oldest first, as NDJSON, CSV or Parquet. Decisions are read in batches and written one at a time, so exports are not limited in size. Each row carries the decision's recommendation types, tags, outcome, annotations and approval history; CSV writes scenario, result and the child rows as JSON text; Parquet keeps tags and recommendation types as lists.",
+                "parameters": [
+                    {
+                        "description": "Export format: ndjson, csv or parquet",
+                        "in": "query",
+                        "name": "format",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decision type",
+                        "in": "query",
+                        "name": "type",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Target service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "serviceId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Correlation ID of the request that produced the decision",
+                        "in": "query",
+                        "name": "correlationId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decisions at or after this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "from",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decisions at or before this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "to",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated confidence levels (high, low, unknown)",
+                        "in": "query",
+                        "name": "confidence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Minimum totalLostTrafficRps of failure decisions",
+                        "in": "query",
+                        "name": "minLostTrafficRps",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated recommendation types; matches decisions with any of them",
+                        "in": "query",
+                        "name": "recommendationType",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated tags; matches decisions carrying all of them",
+                        "in": "query",
+                        "name": "tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated approval states (proposed, approved, rejected, applied)",
+                        "in": "query",
+                        "name": "approvalState",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/vnd.apache.parquet": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            },
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            },
+                            "text/csv": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/vnd.apache.parquet": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/vnd.apache.parquet": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/vnd.apache.parquet": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Export Decisions",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/history": {
+            "get": {
+                "description": "Retrieves a history of logged decisions with pagination",
+                "parameters": [
+                    {
+                        "description": "Limit number of records",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 50,
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Offset for pagination",
+                        "in": "query",
+                        "name": "offset",
+                        "schema": {
+                            "default": 0,
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Filter by decision type",
+                        "in": "query",
+                        "name": "type",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Only decisions carrying this tag",
+                        "in": "query",
+                        "name": "tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Filter by approval state (proposed, approved, rejected, applied)",
+                        "in": "query",
+                        "name": "approvalState",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Decision History",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/log": {
+            "post": {
+                "description": "Logs a decision made by the system or a user",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/storage.LogDecisionInput",
+                                        "summary": "request",
+                                        "description": "Decision details"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Decision details",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Log a Decision",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/retention": {
+            "get": {
+                "description": "Lists the active decision retention rules. Type \"*\" applies to every decision type without its own rule.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.RetentionPoliciesResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    }
+                },
+                "summary": "Get Retention Policies",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/retention/run": {
+            "post": {
+                "description": "Deletes decisions past their retention period, archiving them to a gzip-compressed NDJSON file in RETENTION_ARCHIVE_DIR first unless RETENTION_ARCHIVE_ENABLED=false",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/retention.Result"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Run Decision Pruner. Approved and applied decisions are kept regardless of age as the audit record of a change.",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/search": {
+            "get": {
+                "description": "Filters logged decisions on fields extracted from their scenario and result, newest first. Pass nextCursor from a response as cursor to fetch the following page.",
+                "parameters": [
+                    {
+                        "description": "Decision type",
+                        "in": "query",
+                        "name": "type",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Target service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "serviceId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Correlation ID of the request that produced the decision",
+                        "in": "query",
+                        "name": "correlationId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decisions at or after this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "from",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decisions at or before this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "to",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated confidence levels (high, low, unknown)",
+                        "in": "query",
+                        "name": "confidence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Minimum totalLostTrafficRps of failure decisions",
+                        "in": "query",
+                        "name": "minLostTrafficRps",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated recommendation types; matches decisions with any of them",
+                        "in": "query",
+                        "name": "recommendationType",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated tags; matches decisions carrying all of them",
+                        "in": "query",
+                        "name": "tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated approval states (proposed, approved, rejected, applied)",
+                        "in": "query",
+                        "name": "approvalState",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Cursor returned as nextCursor by the previous page",
+                        "in": "query",
+                        "name": "cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Page size, at most 100",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 50,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.SearchPage"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Search Decisions",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/{decisionId}/annotations": {
+            "get": {
+                "description": "Returns the annotations on a decision in the order they were written. Replies carry the parentId of the annotation they answer.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.AnnotationsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Decision Annotations",
+                "tags": [
+                    "decisions"
+                ]
+            },
+            "post": {
+                "description": "Adds a comment to a decision, or a reply to one of its annotations when parentId is set",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.AnnotationRequest",
+                                        "summary": "request",
+                                        "description": "Annotation"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Annotation",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.Annotation"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Annotate a Decision",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/{decisionId}/approval": {
+            "get": {
+                "description": "Returns the approval state of a decision and the history of changes to it",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.ApprovalResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Decision Approval",
+                "tags": [
+                    "decisions"
+                ]
+            },
+            "put": {
+                "description": "Moves a decision to a new approval state. Allowed transitions: proposed to approved or rejected; approved to applied, rejected or proposed; rejected to proposed. Applied is final.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.ApprovalRequest",
+                                        "summary": "request",
+                                        "description": "New approval state"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "New approval state",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.ApprovalResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Set Decision Approval",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/{decisionId}/outcome": {
+            "get": {
+                "description": "Returns the observed outcome attached to a decision",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.Outcome"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Decision Outcome",
+                "tags": [
+                    "decisions"
+                ]
+            },
+            "post": {
+                "description": "Attaches a manually observed latency to a decision, replacing any previous outcome. For scaling decisions the projected latency is copied from the stored result so the outcome counts towards model accuracy.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.RecordOutcomeRequest",
+                                        "summary": "request",
+                                        "description": "Observed outcome"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Observed outcome",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.Outcome"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Record Decision Outcome",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/{decisionId}/outcome/evaluate": {
+            "post": {
+                "description": "Queries service_metrics for the target of a scaling decision after its change window and records the observed latency against the projected latency. Nothing is recorded (422 NO_OBSERVED_DATA) unless the target ran the simulated newPods during the window; record a manual outcome for changes made differently.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.Outcome"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "422": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Unprocessable Entity"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Evaluate Decision Outcome",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/{decisionId}/tags": {
+            "post": {
+                "description": "Adds free-form tags such as a ticket ID or change name to a decision. Tags it already carries are ignored.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.TagsRequest",
+                                        "summary": "request",
+                                        "description": "Tags to add"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Tags to add",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.TagsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Tag a Decision",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/decisions/{decisionId}/tags/{tag}": {
+            "delete": {
+                "description": "Removes one tag from a decision. Removing a tag the decision does not carry is not an error.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Tag",
+                        "in": "path",
+                        "name": "tag",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.TagsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Remove a Decision Tag",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/dependency-graph/snapshot": {
+            "get": {
+                "description": "Fetches the current dependency graph snapshot with optional filtering by namespace",
+                "parameters": [
+                    {
+                        "description": "Filter by namespace",
+                        "in": "query",
+                        "name": "namespace",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Export format: json (default), dot, mermaid, graphml or cytoscape",
+                        "in": "query",
+                        "name": "format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/snapshot.Response"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/snapshot.Response"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/snapshot.Response"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/snapshot.Response"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "503": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Dependency Graph Snapshot",
+                "tags": [
+                    "graph"
+                ]
+            }
+        },
+        "/forecast/service": {
+            "get": {
+                "description": "Fits Holt-Winters with daily seasonality to the service's history in service_metrics and returns predicted request rate and p95 with 95% prediction intervals. Histories shorter than two days fall back to a damped trend without seasonality.",
+                "parameters": [
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "service",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "How far ahead to forecast, as a duration (e.g. 24h, 168h)",
+                        "in": "query",
+                        "name": "horizon",
+                        "schema": {
+                            "default": "24h",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Step size in seconds, also used for the history",
+                        "in": "query",
+                        "name": "step",
+                        "schema": {
+                            "default": 3600,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/forecast.ServiceForecast"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "422": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Unprocessable Entity"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Forecast Service Traffic and Latency",
+                "tags": [
+                    "forecast"
+                ]
+            }
+        },
+        "/graphql": {
+            "post": {
+                "description": "Executes a GraphQL query over services, edges, centrality, risk and decisions. GET accepts query, operationName and variables (JSON) as query parameters.",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/graphqlapi.Request",
+                                        "summary": "request",
+                                        "description": "GraphQL request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "GraphQL request",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    }
+                },
+                "summary": "GraphQL Query",
+                "tags": [
+                    "graphql"
+                ]
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Checks if the API and connections to the Graph Engine are healthy",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    }
+                },
+                "summary": "Check API Health",
+                "tags": [
+                    "system"
+                ]
+            }
+        },
+        "/reports/failure/{decisionId}": {
+            "get": {
+                "description": "Renders a stored failure simulation decision as a standalone Markdown or self-contained HTML report",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Report format: markdown (default) or html",
+                        "in": "query",
+                        "name": "format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Failure Impact Report",
+                "tags": [
+                    "reports"
+                ]
+            }
+        },
+        "/reports/scaling/{decisionId}": {
+            "get": {
+                "description": "Renders a stored scaling simulation decision as a standalone Markdown or self-contained HTML report",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Report format: markdown (default) or html",
+                        "in": "query",
+                        "name": "format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Scaling Impact Report",
+                "tags": [
+                    "reports"
+                ]
+            }
+        },
+        "/risk/services/top": {
+            "get": {
+                "description": "Returns services ordered by risk metrics (pagerank or betweenness)",
+                "parameters": [
+                    {
+                        "description": "Risk metric (pagerank, betweenness)",
+                        "in": "query",
+                        "name": "metric",
+                        "schema": {
+                            "default": "pagerank",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Number of services to return (1-20)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 5,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/graph.TopCentralityResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Get Top Risky Services",
+                "tags": [
+                    "risk"
+                ]
+            }
+        },
+        "/risk/topology/events": {
+            "get": {
+                "description": "Returns risky changes in the dependency topology found by the poll worker, most recent first. A cycle_detected event is raised the first time a dependency cycle appears in a snapshot, and again if it disappears and returns; cycle_resolved marks its disappearance. Components with too many cycles to enumerate are reported as a whole, keyed scc:\u003cservices\u003e.",
+                "parameters": [
+                    {
+                        "description": "Detected at or after (RFC3339)",
+                        "in": "query",
+                        "name": "from",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Detected at or before (RFC3339)",
+                        "in": "query",
+                        "name": "to",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated kinds: cycle_detected, cycle_resolved",
+                        "in": "query",
+                        "name": "kind",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum number of events, at most 1000",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 100,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.TopologyEventsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Topology Risk Events",
+                "tags": [
+                    "risk"
+                ]
+            }
+        },
+        "/services": {
+            "get": {
+                "description": "Fetches a list of services and their status from the Graph Engine",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Services",
+                "tags": [
+                    "services"
+                ]
+            }
+        },
+        "/simulate/add": {
+            "post": {
+                "deprecated": true,
+                "description": "Simulates adding a new service to the cluster (capacity planning)",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/simulation.AddSimulationRequest",
+                                        "summary": "request",
+                                        "description": "Simulation parameters"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Simulation parameters",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.AddSimulationResult"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Simulate Adding Service",
+                "tags": [
+                    "simulation"
+                ]
+            }
+        },
+        "/simulate/failure": {
+            "post": {
+                "deprecated": true,
+                "description": "Simulates a failure of a specific service and analyzes the impact",
+                "parameters": [
+                    {
+                        "description": "Export the impact graph instead of JSON: dot, mermaid, graphml or cytoscape",
+                        "in": "query",
+                        "name": "format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/simulation.FailureSimulationRequest",
+                                        "summary": "request",
+                                        "description": "Simulation parameters"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Simulation parameters",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.FailureSimulationResult"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.FailureSimulationResult"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.FailureSimulationResult"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.FailureSimulationResult"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Simulate Service Failure",
+                "tags": [
+                    "simulation"
+                ]
+            }
+        },
+        "/simulate/scale": {
+            "post": {
+                "deprecated": true,
+                "description": "Simulates scaling a service and analyzes latency impact",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/simulation.ScalingSimulationRequest",
+                                        "summary": "request",
+                                        "description": "Simulation parameters"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Simulation parameters",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.ScalingSimulationResult"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Simulate Scaling",
+                "tags": [
+                    "simulation"
+                ]
+            }
+        },
+        "/slos": {
+            "get": {
+                "description": "Lists registered SLOs, optionally for one service",
+                "parameters": [
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "serviceId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.SLOsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List SLOs",
+                "tags": [
+                    "slos"
+                ]
+            },
+            "post": {
+                "description": "Registers an SLO for a service with an availability target, a latency target on p95 or p99, or both. The latency objective is the fraction of time the percentile must stay within the target (default 0.99).",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.SLORequest",
+                                        "summary": "request",
+                                        "description": "SLO"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "SLO",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.SLO"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Create an SLO",
+                "tags": [
+                    "slos"
+                ]
+            }
+        },
+        "/slos/{sloId}": {
+            "delete": {
+                "parameters": [
+                    {
+                        "description": "SLO ID",
+                        "in": "path",
+                        "name": "sloId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Delete an SLO",
+                "tags": [
+                    "slos"
+                ]
+            },
+            "get": {
+                "parameters": [
+                    {
+                        "description": "SLO ID",
+                        "in": "path",
+                        "name": "sloId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.SLO"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get an SLO",
+                "tags": [
+                    "slos"
+                ]
+            },
+            "put": {
+                "description": "Replaces every field of an SLO. Objectives left out of the request are removed.",
+                "parameters": [
+                    {
+                        "description": "SLO ID",
+                        "in": "path",
+                        "name": "sloId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.SLORequest",
+                                        "summary": "request",
+                                        "description": "SLO"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "SLO",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.SLO"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Replace an SLO",
+                "tags": [
+                    "slos"
+                ]
+            }
+        },
+        "/slos/{sloId}/status": {
+            "get": {
+                "description": "Computes compliance over the SLO window, remaining error budget and burn rates over trailing windows from service_metrics. An objective is at_risk when a fast or slow multi-window burn rate alert is firing and breached once its budget is spent.",
+                "parameters": [
+                    {
+                        "description": "SLO ID",
+                        "in": "path",
+                        "name": "sloId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/slo.Status"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get SLO Compliance",
+                "tags": [
+                    "slos"
+                ]
+            }
+        },
+        "/telemetry/edges": {
+            "get": {
+                "description": "Fetches telemetry metrics for edges between services",
+                "parameters": [
+                    {
+                        "description": "Source service name",
+                        "in": "query",
+                        "name": "fromService",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Target service name",
+                        "in": "query",
+                        "name": "toService",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Start timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "from",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "End timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "to",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Step size in seconds",
+                        "in": "query",
+                        "name": "step",
+                        "schema": {
+                            "default": 60,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Edge Metrics",
+                "tags": [
+                    "telemetry"
+                ]
+            }
+        },
+        "/telemetry/service": {
+            "get": {
+                "description": "Fetches telemetry metrics for a specific service or all services",
+                "parameters": [
+                    {
+                        "description": "Service name",
+                        "in": "query",
+                        "name": "service",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Start timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "from",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "End timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "to",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Step size in seconds",
+                        "in": "query",
+                        "name": "step",
+                        "schema": {
+                            "default": 60,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Service Metrics",
+                "tags": [
+                    "telemetry"
+                ]
+            }
+        },
+        "/v1/anomalies": {
+            "get": {
+                "description": "Returns detected anomalies in request rate, error rate and p95, most recent first. The service filter matches the service itself and edges on either side of it.",
+                "parameters": [
+                    {
+                        "description": "Observed at or after (RFC3339)",
+                        "in": "query",
+                        "name": "from",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Observed at or before (RFC3339)",
+                        "in": "query",
+                        "name": "to",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "service",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated metrics: rps, error_rate, p95",
+                        "in": "query",
+                        "name": "metric",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated severities: low, medium, high, critical",
+                        "in": "query",
+                        "name": "severity",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum number of events, at most 1000",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 100,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.AnomaliesResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Anomalies",
+                "tags": [
+                    "anomalies"
+                ]
+            }
+        },
+        "/v1/anomalies/run": {
+            "post": {
+                "description": "Scores the service and edge metrics recorded since the previous run against their baselines and stores the anomalies found. The first run after startup only builds the baselines from ANOMALY_WARMUP_HOURS of history.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/anomaly.RunResult"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Run Anomaly Detection",
+                "tags": [
+                    "anomalies"
+                ]
+            }
+        },
+        "/v1/calibration/scaling": {
+            "get": {
+                "description": "Lists the bounded_sqrt parameters fitted per service from historical pod-count changes. Scaling simulations use them when the request does not specify a model.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.CalibrationsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Scaling Calibrations",
+                "tags": [
+                    "calibration"
+                ]
+            }
+        },
+        "/v1/calibration/scaling/run": {
+            "post": {
+                "description": "Refits per-service bounded_sqrt parameters from pod-count changes in service_metrics over the configured lookback window and returns the services that were calibrated",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.CalibrationsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Run Scaling Calibration",
+                "tags": [
+                    "calibration"
+                ]
+            }
+        },
+        "/v1/calibration/scaling/{serviceId}": {
+            "get": {
+                "description": "Returns the bounded_sqrt parameters fitted for one service",
+                "parameters": [
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "path",
+                        "name": "serviceId",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.ScalingCalibration"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Scaling Calibration",
+                "tags": [
+                    "calibration"
+                ]
+            }
+        },
+        "/v1/decisions/accuracy": {
+            "get": {
+                "description": "Aggregates the error between projected and observed latency per scaling model type. errorMs is predicted minus observed, so a positive bias means the model over-estimates latency.",
+                "parameters": [
+                    {
+                        "description": "Only include outcomes recorded at or after this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "since",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.AccuracyResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Prediction Accuracy",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/diff": {
+            "get": {
+                "description": "Compares two stored failure or scaling simulations of the same type, treating a as before and b as after: added, removed and changed callers, unreachable services, critical or affected paths and recommendations.",
+                "parameters": [
+                    {
+                        "description": "Decision ID of the earlier simulation",
+                        "in": "query",
+                        "name": "a",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Decision ID of the later simulation",
+                        "in": "query",
+                        "name": "b",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.DecisionDiffResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Diff Two Decisions",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/export": {
+            "get": {
+                "description": "Streams every decision matching the search filters, oldest first, as NDJSON, CSV or Parquet. Decisions are read in batches and written one at a time, so exports are not limited in size. Each row carries the decision's recommendation types, tags, outcome, annotations and approval history; CSV writes scenario, result and the child rows as JSON text; Parquet keeps tags and recommendation types as lists.",
+                "parameters": [
+                    {
+                        "description": "Export format: ndjson, csv or parquet",
+                        "in": "query",
+                        "name": "format",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decision type",
+                        "in": "query",
+                        "name": "type",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Target service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "serviceId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Correlation ID of the request that produced the decision",
+                        "in": "query",
+                        "name": "correlationId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decisions at or after this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "from",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decisions at or before this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "to",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated confidence levels (high, low, unknown)",
+                        "in": "query",
+                        "name": "confidence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Minimum totalLostTrafficRps of failure decisions",
+                        "in": "query",
+                        "name": "minLostTrafficRps",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated recommendation types; matches decisions with any of them",
+                        "in": "query",
+                        "name": "recommendationType",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated tags; matches decisions carrying all of them",
+                        "in": "query",
+                        "name": "tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated approval states (proposed, approved, rejected, applied)",
+                        "in": "query",
+                        "name": "approvalState",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/vnd.apache.parquet": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            },
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            },
+                            "text/csv": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/vnd.apache.parquet": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/vnd.apache.parquet": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/vnd.apache.parquet": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Export Decisions",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/history": {
+            "get": {
+                "description": "Retrieves a history of logged decisions with pagination",
+                "parameters": [
+                    {
+                        "description": "Limit number of records",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 50,
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Offset for pagination",
+                        "in": "query",
+                        "name": "offset",
+                        "schema": {
+                            "default": 0,
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Filter by decision type",
+                        "in": "query",
+                        "name": "type",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Only decisions carrying this tag",
+                        "in": "query",
+                        "name": "tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Filter by approval state (proposed, approved, rejected, applied)",
+                        "in": "query",
+                        "name": "approvalState",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Decision History",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/log": {
+            "post": {
+                "description": "Logs a decision made by the system or a user",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/storage.LogDecisionInput",
+                                        "summary": "request",
+                                        "description": "Decision details"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Decision details",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Log a Decision",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/retention": {
+            "get": {
+                "description": "Lists the active decision retention rules. Type \"*\" applies to every decision type without its own rule.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.RetentionPoliciesResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    }
+                },
+                "summary": "Get Retention Policies",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/retention/run": {
+            "post": {
+                "description": "Deletes decisions past their retention period, archiving them to a gzip-compressed NDJSON file in RETENTION_ARCHIVE_DIR first unless RETENTION_ARCHIVE_ENABLED=false",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/retention.Result"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Run Decision Pruner. Approved and applied decisions are kept regardless of age as the audit record of a change.",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/search": {
+            "get": {
+                "description": "Filters logged decisions on fields extracted from their scenario and result, newest first. Pass nextCursor from a response as cursor to fetch the following page.",
+                "parameters": [
+                    {
+                        "description": "Decision type",
+                        "in": "query",
+                        "name": "type",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Target service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "serviceId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Correlation ID of the request that produced the decision",
+                        "in": "query",
+                        "name": "correlationId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decisions at or after this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "from",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decisions at or before this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "to",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated confidence levels (high, low, unknown)",
+                        "in": "query",
+                        "name": "confidence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Minimum totalLostTrafficRps of failure decisions",
+                        "in": "query",
+                        "name": "minLostTrafficRps",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated recommendation types; matches decisions with any of them",
+                        "in": "query",
+                        "name": "recommendationType",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated tags; matches decisions carrying all of them",
+                        "in": "query",
+                        "name": "tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated approval states (proposed, approved, rejected, applied)",
+                        "in": "query",
+                        "name": "approvalState",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Cursor returned as nextCursor by the previous page",
+                        "in": "query",
+                        "name": "cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Page size, at most 100",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 50,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.SearchPage"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Search Decisions",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/{decisionId}/annotations": {
+            "get": {
+                "description": "Returns the annotations on a decision in the order they were written. Replies carry the parentId of the annotation they answer.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.AnnotationsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Decision Annotations",
+                "tags": [
+                    "decisions"
+                ]
+            },
+            "post": {
+                "description": "Adds a comment to a decision, or a reply to one of its annotations when parentId is set",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.AnnotationRequest",
+                                        "summary": "request",
+                                        "description": "Annotation"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Annotation",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.Annotation"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Annotate a Decision",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/{decisionId}/approval": {
+            "get": {
+                "description": "Returns the approval state of a decision and the history of changes to it",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.ApprovalResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Decision Approval",
+                "tags": [
+                    "decisions"
+                ]
+            },
+            "put": {
+                "description": "Moves a decision to a new approval state. Allowed transitions: proposed to approved or rejected; approved to applied, rejected or proposed; rejected to proposed. Applied is final.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.ApprovalRequest",
+                                        "summary": "request",
+                                        "description": "New approval state"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "New approval state",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.ApprovalResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Set Decision Approval",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/{decisionId}/outcome": {
+            "get": {
+                "description": "Returns the observed outcome attached to a decision",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.Outcome"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Decision Outcome",
+                "tags": [
+                    "decisions"
+                ]
+            },
+            "post": {
+                "description": "Attaches a manually observed latency to a decision, replacing any previous outcome. For scaling decisions the projected latency is copied from the stored result so the outcome counts towards model accuracy.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.RecordOutcomeRequest",
+                                        "summary": "request",
+                                        "description": "Observed outcome"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Observed outcome",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.Outcome"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Record Decision Outcome",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/{decisionId}/outcome/evaluate": {
+            "post": {
+                "description": "Queries service_metrics for the target of a scaling decision after its change window and records the observed latency against the projected latency. Nothing is recorded (422 NO_OBSERVED_DATA) unless the target ran the simulated newPods during the window; record a manual outcome for changes made differently.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.Outcome"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "422": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Unprocessable Entity"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Evaluate Decision Outcome",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/{decisionId}/tags": {
+            "post": {
+                "description": "Adds free-form tags such as a ticket ID or change name to a decision. Tags it already carries are ignored.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.TagsRequest",
+                                        "summary": "request",
+                                        "description": "Tags to add"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Tags to add",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.TagsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Tag a Decision",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/decisions/{decisionId}/tags/{tag}": {
+            "delete": {
+                "description": "Removes one tag from a decision. Removing a tag the decision does not carry is not an error.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Tag",
+                        "in": "path",
+                        "name": "tag",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.TagsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Remove a Decision Tag",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v1/dependency-graph/snapshot": {
+            "get": {
+                "description": "Fetches the current dependency graph snapshot with optional filtering by namespace",
+                "parameters": [
+                    {
+                        "description": "Filter by namespace",
+                        "in": "query",
+                        "name": "namespace",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Export format: json (default), dot, mermaid, graphml or cytoscape",
+                        "in": "query",
+                        "name": "format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/snapshot.Response"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/snapshot.Response"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/snapshot.Response"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/snapshot.Response"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "503": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Dependency Graph Snapshot",
+                "tags": [
+                    "graph"
+                ]
+            }
+        },
+        "/v1/forecast/service": {
+            "get": {
+                "description": "Fits Holt-Winters with daily seasonality to the service's history in service_metrics and returns predicted request rate and p95 with 95% prediction intervals. Histories shorter than two days fall back to a damped trend without seasonality.",
+                "parameters": [
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "service",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "How far ahead to forecast, as a duration (e.g. 24h, 168h)",
+                        "in": "query",
+                        "name": "horizon",
+                        "schema": {
+                            "default": "24h",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Step size in seconds, also used for the history",
+                        "in": "query",
+                        "name": "step",
+                        "schema": {
+                            "default": 3600,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/forecast.ServiceForecast"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "422": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Unprocessable Entity"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Forecast Service Traffic and Latency",
+                "tags": [
+                    "forecast"
+                ]
+            }
+        },
+        "/v1/reports/failure/{decisionId}": {
+            "get": {
+                "description": "Renders a stored failure simulation decision as a standalone Markdown or self-contained HTML report",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Report format: markdown (default) or html",
+                        "in": "query",
+                        "name": "format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Failure Impact Report",
+                "tags": [
+                    "reports"
+                ]
+            }
+        },
+        "/v1/reports/scaling/{decisionId}": {
+            "get": {
+                "description": "Renders a stored scaling simulation decision as a standalone Markdown or self-contained HTML report",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Report format: markdown (default) or html",
+                        "in": "query",
+                        "name": "format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Scaling Impact Report",
+                "tags": [
+                    "reports"
+                ]
+            }
+        },
+        "/v1/risk/services/top": {
+            "get": {
+                "description": "Returns services ordered by risk metrics (pagerank or betweenness)",
+                "parameters": [
+                    {
+                        "description": "Risk metric (pagerank, betweenness)",
+                        "in": "query",
+                        "name": "metric",
+                        "schema": {
+                            "default": "pagerank",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Number of services to return (1-20)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 5,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/graph.TopCentralityResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Get Top Risky Services",
+                "tags": [
+                    "risk"
+                ]
+            }
+        },
+        "/v1/risk/topology/events": {
+            "get": {
+                "description": "Returns risky changes in the dependency topology found by the poll worker, most recent first. A cycle_detected event is raised the first time a dependency cycle appears in a snapshot, and again if it disappears and returns; cycle_resolved marks its disappearance. Components with too many cycles to enumerate are reported as a whole, keyed scc:\u003cservices\u003e.",
+                "parameters": [
+                    {
+                        "description": "Detected at or after (RFC3339)",
+                        "in": "query",
+                        "name": "from",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Detected at or before (RFC3339)",
+                        "in": "query",
+                        "name": "to",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated kinds: cycle_detected, cycle_resolved",
+                        "in": "query",
+                        "name": "kind",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum number of events, at most 1000",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 100,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.TopologyEventsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Topology Risk Events",
+                "tags": [
+                    "risk"
+                ]
+            }
+        },
+        "/v1/services": {
+            "get": {
+                "description": "Fetches a list of services and their status from the Graph Engine",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Services",
+                "tags": [
+                    "services"
+                ]
+            }
+        },
+        "/v1/simulate/add": {
+            "post": {
+                "deprecated": true,
+                "description": "Simulates adding a new service to the cluster (capacity planning)",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/simulation.AddSimulationRequest",
+                                        "summary": "request",
+                                        "description": "Simulation parameters"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Simulation parameters",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.AddSimulationResult"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Simulate Adding Service",
+                "tags": [
+                    "simulation"
+                ]
+            }
+        },
+        "/v1/simulate/failure": {
+            "post": {
+                "deprecated": true,
+                "description": "Simulates a failure of a specific service and analyzes the impact",
+                "parameters": [
+                    {
+                        "description": "Export the impact graph instead of JSON: dot, mermaid, graphml or cytoscape",
+                        "in": "query",
+                        "name": "format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/simulation.FailureSimulationRequest",
+                                        "summary": "request",
+                                        "description": "Simulation parameters"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Simulation parameters",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.FailureSimulationResult"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.FailureSimulationResult"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.FailureSimulationResult"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.FailureSimulationResult"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Simulate Service Failure",
+                "tags": [
+                    "simulation"
+                ]
+            }
+        },
+        "/v1/simulate/scale": {
+            "post": {
+                "deprecated": true,
+                "description": "Simulates scaling a service and analyzes latency impact",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/simulation.ScalingSimulationRequest",
+                                        "summary": "request",
+                                        "description": "Simulation parameters"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Simulation parameters",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.ScalingSimulationResult"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Simulate Scaling",
+                "tags": [
+                    "simulation"
+                ]
+            }
+        },
+        "/v1/slos": {
+            "get": {
+                "description": "Lists registered SLOs, optionally for one service",
+                "parameters": [
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "serviceId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.SLOsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List SLOs",
+                "tags": [
+                    "slos"
+                ]
+            },
+            "post": {
+                "description": "Registers an SLO for a service with an availability target, a latency target on p95 or p99, or both. The latency objective is the fraction of time the percentile must stay within the target (default 0.99).",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.SLORequest",
+                                        "summary": "request",
+                                        "description": "SLO"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "SLO",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.SLO"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Create an SLO",
+                "tags": [
+                    "slos"
+                ]
+            }
+        },
+        "/v1/slos/{sloId}": {
+            "delete": {
+                "parameters": [
+                    {
+                        "description": "SLO ID",
+                        "in": "path",
+                        "name": "sloId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Delete an SLO",
+                "tags": [
+                    "slos"
+                ]
+            },
+            "get": {
+                "parameters": [
+                    {
+                        "description": "SLO ID",
+                        "in": "path",
+                        "name": "sloId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.SLO"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get an SLO",
+                "tags": [
+                    "slos"
+                ]
+            },
+            "put": {
+                "description": "Replaces every field of an SLO. Objectives left out of the request are removed.",
+                "parameters": [
+                    {
+                        "description": "SLO ID",
+                        "in": "path",
+                        "name": "sloId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.SLORequest",
+                                        "summary": "request",
+                                        "description": "SLO"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "SLO",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.SLO"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Replace an SLO",
+                "tags": [
+                    "slos"
+                ]
+            }
+        },
+        "/v1/slos/{sloId}/status": {
+            "get": {
+                "description": "Computes compliance over the SLO window, remaining error budget and burn rates over trailing windows from service_metrics. An objective is at_risk when a fast or slow multi-window burn rate alert is firing and breached once its budget is spent.",
+                "parameters": [
+                    {
+                        "description": "SLO ID",
+                        "in": "path",
+                        "name": "sloId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/slo.Status"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get SLO Compliance",
+                "tags": [
+                    "slos"
+                ]
+            }
+        },
+        "/v1/telemetry/edges": {
+            "get": {
+                "description": "Fetches telemetry metrics for edges between services",
+                "parameters": [
+                    {
+                        "description": "Source service name",
+                        "in": "query",
+                        "name": "fromService",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Target service name",
+                        "in": "query",
+                        "name": "toService",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Start timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "from",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "End timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "to",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Step size in seconds",
+                        "in": "query",
+                        "name": "step",
+                        "schema": {
+                            "default": 60,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Edge Metrics",
+                "tags": [
+                    "telemetry"
+                ]
+            }
+        },
+        "/v1/telemetry/service": {
+            "get": {
+                "description": "Fetches telemetry metrics for a specific service or all services",
+                "parameters": [
+                    {
+                        "description": "Service name",
+                        "in": "query",
+                        "name": "service",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Start timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "from",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "End timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "to",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Step size in seconds",
+                        "in": "query",
+                        "name": "step",
+                        "schema": {
+                            "default": 60,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Service Metrics",
+                "tags": [
+                    "telemetry"
+                ]
+            }
+        },
+        "/v2/analysis/availability/{serviceId}": {
+            "get": {
+                "description": "Composes the availability of a service across everything it transitively calls. Distinct dependencies are in series and multiply; instances of a dependency with the same name in other namespaces, and declared alternatives called by the same caller, are in parallel. Each service's reported availability is used as is unless replicaRedundancy is set. Contributors are ranked by how much the achievable availability would rise if they never failed.",
+                "parameters": [
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "path",
+                        "name": "serviceId",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum number of contributors, at most 100",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 10,
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Treat reported availability as per replica, with replicas failing independently",
+                        "in": "query",
+                        "name": "replicaRedundancy",
+                        "schema": {
+                            "default": false,
+                            "type": "boolean"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated group of interchangeable services; repeat for more groups",
+                        "in": "query",
+                        "name": "alternatives",
+                        "schema": {
+                            "items": {
+                                "type": "string"
+                            },
+                            "type": "array"
+                        },
+                        "style": "form"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/analysis.AvailabilityAnalysis"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Composite Availability",
+                "tags": [
+                    "analysis"
+                ]
+            }
+        },
+        "/v2/analysis/cycles": {
+            "get": {
+                "description": "Runs Tarjan's strongly connected components algorithm over the full dependency graph and reports every group of services that can reach each other. Each cycle is listed with the traffic that can flow all the way around it (its least-used edge) and the edge carrying the most; a failure anywhere in a cycle can feed back into itself.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/analysis.CycleReport"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Dependency Cycles",
+                "tags": [
+                    "analysis"
+                ]
+            }
+        },
+        "/v2/analysis/latency/{serviceId}": {
+            "get": {
+                "description": "Attributes a service's p50, p95 and p99 to its downstream calls within the neighborhood. Each critical path follows the slowest call at every hop; a hop's self time is its edge latency minus the next hop's. Candidates are the services on the p95 critical path ranked by self time, marked \"scale\" when their tail is at least 3x their median and \"optimize\" otherwise.",
+                "parameters": [
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "path",
+                        "name": "serviceId",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Hops to follow downstream (1-3); defaults to MAX_TRAVERSAL_DEPTH",
+                        "in": "query",
+                        "name": "depth",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/analysis.LatencyAnalysis"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Latency Decomposition",
+                "tags": [
+                    "analysis"
+                ]
+            }
+        },
+        "/v2/anomalies": {
+            "get": {
+                "description": "Returns detected anomalies in request rate, error rate and p95, most recent first. The service filter matches the service itself and edges on either side of it.",
+                "parameters": [
+                    {
+                        "description": "Observed at or after (RFC3339)",
+                        "in": "query",
+                        "name": "from",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Observed at or before (RFC3339)",
+                        "in": "query",
+                        "name": "to",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "service",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated metrics: rps, error_rate, p95",
+                        "in": "query",
+                        "name": "metric",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated severities: low, medium, high, critical",
+                        "in": "query",
+                        "name": "severity",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum number of events, at most 1000",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 100,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.AnomaliesResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Anomalies",
+                "tags": [
+                    "anomalies"
+                ]
+            }
+        },
+        "/v2/anomalies/run": {
+            "post": {
+                "description": "Scores the service and edge metrics recorded since the previous run against their baselines and stores the anomalies found. The first run after startup only builds the baselines from ANOMALY_WARMUP_HOURS of history.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/anomaly.RunResult"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Run Anomaly Detection",
+                "tags": [
+                    "anomalies"
+                ]
+            }
+        },
+        "/v2/calibration/scaling": {
+            "get": {
+                "description": "Lists the bounded_sqrt parameters fitted per service from historical pod-count changes. Scaling simulations use them when the request does not specify a model.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.CalibrationsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Scaling Calibrations",
+                "tags": [
+                    "calibration"
+                ]
+            }
+        },
+        "/v2/calibration/scaling/run": {
+            "post": {
+                "description": "Refits per-service bounded_sqrt parameters from pod-count changes in service_metrics over the configured lookback window and returns the services that were calibrated",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.CalibrationsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Run Scaling Calibration",
+                "tags": [
+                    "calibration"
+                ]
+            }
+        },
+        "/v2/calibration/scaling/{serviceId}": {
+            "get": {
+                "description": "Returns the bounded_sqrt parameters fitted for one service",
+                "parameters": [
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "path",
+                        "name": "serviceId",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.ScalingCalibration"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Scaling Calibration",
+                "tags": [
+                    "calibration"
+                ]
+            }
+        },
+        "/v2/decisions/accuracy": {
+            "get": {
+                "description": "Aggregates the error between projected and observed latency per scaling model type. errorMs is predicted minus observed, so a positive bias means the model over-estimates latency.",
+                "parameters": [
+                    {
+                        "description": "Only include outcomes recorded at or after this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "since",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.AccuracyResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Prediction Accuracy",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/diff": {
+            "get": {
+                "description": "Compares two stored failure or scaling simulations of the same type, treating a as before and b as after: added, removed and changed callers, unreachable services, critical or affected paths and recommendations.",
+                "parameters": [
+                    {
+                        "description": "Decision ID of the earlier simulation",
+                        "in": "query",
+                        "name": "a",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Decision ID of the later simulation",
+                        "in": "query",
+                        "name": "b",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.DecisionDiffResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Diff Two Decisions",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/export": {
+            "get": {
+                "description": "Streams every decision matching the search filters, oldest first, as NDJSON, CSV or Parquet. Decisions are read in batches and written one at a time, so exports are not limited in size. Each row carries the decision's recommendation types, tags, outcome, annotations and approval history; CSV writes scenario, result and the child rows as JSON text; Parquet keeps tags and recommendation types as lists.",
+                "parameters": [
+                    {
+                        "description": "Export format: ndjson, csv or parquet",
+                        "in": "query",
+                        "name": "format",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decision type",
+                        "in": "query",
+                        "name": "type",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Target service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "serviceId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Correlation ID of the request that produced the decision",
+                        "in": "query",
+                        "name": "correlationId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decisions at or after this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "from",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decisions at or before this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "to",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated confidence levels (high, low, unknown)",
+                        "in": "query",
+                        "name": "confidence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Minimum totalLostTrafficRps of failure decisions",
+                        "in": "query",
+                        "name": "minLostTrafficRps",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated recommendation types; matches decisions with any of them",
+                        "in": "query",
+                        "name": "recommendationType",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated tags; matches decisions carrying all of them",
+                        "in": "query",
+                        "name": "tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated approval states (proposed, approved, rejected, applied)",
+                        "in": "query",
+                        "name": "approvalState",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/vnd.apache.parquet": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            },
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            },
+                            "text/csv": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/vnd.apache.parquet": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/vnd.apache.parquet": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/vnd.apache.parquet": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Export Decisions",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/history": {
+            "get": {
+                "description": "Retrieves a history of logged decisions with pagination",
+                "parameters": [
+                    {
+                        "description": "Limit number of records",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 50,
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Offset for pagination",
+                        "in": "query",
+                        "name": "offset",
+                        "schema": {
+                            "default": 0,
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Filter by decision type",
+                        "in": "query",
+                        "name": "type",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Only decisions carrying this tag",
+                        "in": "query",
+                        "name": "tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Filter by approval state (proposed, approved, rejected, applied)",
+                        "in": "query",
+                        "name": "approvalState",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Decision History",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/log": {
+            "post": {
+                "description": "Logs a decision made by the system or a user",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/storage.LogDecisionInput",
+                                        "summary": "request",
+                                        "description": "Decision details"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Decision details",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Log a Decision",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/retention": {
+            "get": {
+                "description": "Lists the active decision retention rules. Type \"*\" applies to every decision type without its own rule.",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.RetentionPoliciesResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    }
+                },
+                "summary": "Get Retention Policies",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/retention/run": {
+            "post": {
+                "description": "Deletes decisions past their retention period, archiving them to a gzip-compressed NDJSON file in RETENTION_ARCHIVE_DIR first unless RETENTION_ARCHIVE_ENABLED=false",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/retention.Result"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Run Decision Pruner. Approved and applied decisions are kept regardless of age as the audit record of a change.",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/search": {
+            "get": {
+                "description": "Filters logged decisions on fields extracted from their scenario and result, newest first. Pass nextCursor from a response as cursor to fetch the following page.",
+                "parameters": [
+                    {
+                        "description": "Decision type",
+                        "in": "query",
+                        "name": "type",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Target service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "serviceId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Correlation ID of the request that produced the decision",
+                        "in": "query",
+                        "name": "correlationId",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decisions at or after this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "from",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Decisions at or before this timestamp (ISO 8601)",
+                        "in": "query",
+                        "name": "to",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated confidence levels (high, low, unknown)",
+                        "in": "query",
+                        "name": "confidence",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Minimum totalLostTrafficRps of failure decisions",
+                        "in": "query",
+                        "name": "minLostTrafficRps",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated recommendation types; matches decisions with any of them",
+                        "in": "query",
+                        "name": "recommendationType",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated tags; matches decisions carrying all of them",
+                        "in": "query",
+                        "name": "tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated approval states (proposed, approved, rejected, applied)",
+                        "in": "query",
+                        "name": "approvalState",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Cursor returned as nextCursor by the previous page",
+                        "in": "query",
+                        "name": "cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Page size, at most 100",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 50,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.SearchPage"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Search Decisions",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/{decisionId}/annotations": {
+            "get": {
+                "description": "Returns the annotations on a decision in the order they were written. Replies carry the parentId of the annotation they answer.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.AnnotationsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Decision Annotations",
+                "tags": [
+                    "decisions"
+                ]
+            },
+            "post": {
+                "description": "Adds a comment to a decision, or a reply to one of its annotations when parentId is set",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.AnnotationRequest",
+                                        "summary": "request",
+                                        "description": "Annotation"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Annotation",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.Annotation"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Annotate a Decision",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/{decisionId}/approval": {
+            "get": {
+                "description": "Returns the approval state of a decision and the history of changes to it",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.ApprovalResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Decision Approval",
+                "tags": [
+                    "decisions"
+                ]
+            },
+            "put": {
+                "description": "Moves a decision to a new approval state. Allowed transitions: proposed to approved or rejected; approved to applied, rejected or proposed; rejected to proposed. Applied is final.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.ApprovalRequest",
+                                        "summary": "request",
+                                        "description": "New approval state"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "New approval state",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.ApprovalResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Set Decision Approval",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/{decisionId}/outcome": {
+            "get": {
+                "description": "Returns the observed outcome attached to a decision",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.Outcome"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Decision Outcome",
+                "tags": [
+                    "decisions"
+                ]
+            },
+            "post": {
+                "description": "Attaches a manually observed latency to a decision, replacing any previous outcome. For scaling decisions the projected latency is copied from the stored result so the outcome counts towards model accuracy.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.RecordOutcomeRequest",
+                                        "summary": "request",
+                                        "description": "Observed outcome"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Observed outcome",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.Outcome"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Record Decision Outcome",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/{decisionId}/outcome/evaluate": {
+            "post": {
+                "description": "Queries service_metrics for the target of a scaling decision after its change window and records the observed latency against the projected latency. Nothing is recorded (422 NO_OBSERVED_DATA) unless the target ran the simulated newPods during the window; record a manual outcome for changes made differently.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/storage.Outcome"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "422": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Unprocessable Entity"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Evaluate Decision Outcome",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/{decisionId}/tags": {
+            "post": {
+                "description": "Adds free-form tags such as a ticket ID or change name to a decision. Tags it already carries are ignored.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.TagsRequest",
+                                        "summary": "request",
+                                        "description": "Tags to add"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Tags to add",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.TagsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Tag a Decision",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/decisions/{decisionId}/tags/{tag}": {
+            "delete": {
+                "description": "Removes one tag from a decision. Removing a tag the decision does not carry is not an error.",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Tag",
+                        "in": "path",
+                        "name": "tag",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.TagsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Remove a Decision Tag",
+                "tags": [
+                    "decisions"
+                ]
+            }
+        },
+        "/v2/dependency-graph/snapshot": {
+            "get": {
+                "description": "Fetches the current dependency graph snapshot with optional filtering by namespace",
+                "parameters": [
+                    {
+                        "description": "Filter by namespace",
+                        "in": "query",
+                        "name": "namespace",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Export format: json (default), dot, mermaid, graphml or cytoscape",
+                        "in": "query",
+                        "name": "format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/snapshot.Response"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/snapshot.Response"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/snapshot.Response"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/snapshot.Response"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "503": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Get Dependency Graph Snapshot",
+                "tags": [
+                    "graph"
+                ]
+            }
+        },
+        "/v2/forecast/service": {
+            "get": {
+                "description": "Fits Holt-Winters with daily seasonality to the service's history in service_metrics and returns predicted request rate and p95 with 95% prediction intervals. Histories shorter than two days fall back to a damped trend without seasonality.",
+                "parameters": [
+                    {
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "service",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "How far ahead to forecast, as a duration (e.g. 24h, 168h)",
+                        "in": "query",
+                        "name": "horizon",
+                        "schema": {
+                            "default": "24h",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Step size in seconds, also used for the history",
+                        "in": "query",
+                        "name": "step",
+                        "schema": {
+                            "default": 3600,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/forecast.ServiceForecast"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "422": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Unprocessable Entity"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Forecast Service Traffic and Latency",
+                "tags": [
+                    "forecast"
+                ]
+            }
+        },
+        "/v2/paths": {
+            "get": {
+                "description": "Returns up to k loopless call paths from one service to another over the dependency graph snapshot, found with Yen's k-shortest paths. rank=rps prefers the paths the most traffic follows (the product of each caller's share of traffic sent to the next hop), rank=latency the lowest summed p95 and rank=hops the fewest calls. Each hop carries its rate, error rate, p95 and share.",
+                "parameters": [
+                    {
+                        "description": "Source service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "from",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Target service ID (namespace:name, or name in the default namespace)",
+                        "in": "query",
+                        "name": "to",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Number of paths, at most 10",
+                        "in": "query",
+                        "name": "k",
+                        "schema": {
+                            "default": 3,
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Ranking: rps, latency or hops",
+                        "in": "query",
+                        "name": "rank",
+                        "schema": {
+                            "default": "hops",
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/analysis.PathQueryResult"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "K Best Paths Between Services",
+                "tags": [
+                    "analysis"
+                ]
+            }
+        },
+        "/v2/reports/failure/{decisionId}": {
+            "get": {
+                "description": "Renders a stored failure simulation decision as a standalone Markdown or self-contained HTML report",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Report format: markdown (default) or html",
+                        "in": "query",
+                        "name": "format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Failure Impact Report",
+                "tags": [
+                    "reports"
+                ]
+            }
+        },
+        "/v2/reports/scaling/{decisionId}": {
+            "get": {
+                "description": "Renders a stored scaling simulation decision as a standalone Markdown or self-contained HTML report",
+                "parameters": [
+                    {
+                        "description": "Decision ID",
+                        "in": "path",
+                        "name": "decisionId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Report format: markdown (default) or html",
+                        "in": "query",
+                        "name": "format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/markdown": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "Scaling Impact Report",
+                "tags": [
+                    "reports"
+                ]
+            }
+        },
+        "/v2/risk/services/top": {
+            "get": {
+                "description": "Returns services ordered by risk metrics (pagerank or betweenness)",
+                "parameters": [
+                    {
+                        "description": "Risk metric (pagerank, betweenness)",
+                        "in": "query",
+                        "name": "metric",
+                        "schema": {
+                            "default": "pagerank",
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Number of services to return (1-20)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 5,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/graph.TopCentralityResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Get Top Risky Services",
+                "tags": [
+                    "risk"
+                ]
+            }
+        },
+        "/v2/risk/topology/events": {
+            "get": {
+                "description": "Returns risky changes in the dependency topology found by the poll worker, most recent first. A cycle_detected event is raised the first time a dependency cycle appears in a snapshot, and again if it disappears and returns; cycle_resolved marks its disappearance. Components with too many cycles to enumerate are reported as a whole, keyed scc:\u003cservices\u003e.",
+                "parameters": [
+                    {
+                        "description": "Detected at or after (RFC3339)",
+                        "in": "query",
+                        "name": "from",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Detected at or before (RFC3339)",
+                        "in": "query",
+                        "name": "to",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comma-separated kinds: cycle_detected, cycle_resolved",
+                        "in": "query",
+                        "name": "kind",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum number of events, at most 1000",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "default": 100,
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.TopologyEventsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Topology Risk Events",
+                "tags": [
+                    "risk"
+                ]
+            }
+        },
+        "/v2/services": {
+            "get": {
+                "description": "Fetches a list of services and their status from the Graph Engine",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "additionalProperties": {},
+                                    "type": "object"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List Services",
+                "tags": [
+                    "services"
+                ]
+            }
+        },
+        "/v2/simulate/add": {
+            "post": {
+                "description": "Simulates adding a new service to the cluster without the legacy recommendation and duplicated node fields",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/simulation.AddSimulationRequest",
+                                        "summary": "request",
+                                        "description": "Simulation parameters"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Simulation parameters",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.AddSimulationResultV2"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Simulate Adding Service (v2)",
+                "tags": [
+                    "simulation-v2"
+                ]
+            }
+        },
+        "/v2/simulate/drain": {
+            "post": {
+                "description": "Re-places every pod on the given nodes onto the remaining nodes, using each pod's observed CPU and RAM usage as its requests. Reports the pods that cannot be rescheduled and the resulting utilization of each surviving node.",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/simulation.DrainSimulationRequest",
+                                        "summary": "request",
+                                        "description": "Simulation parameters"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Simulation parameters",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.DrainSimulationResult"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Simulate Draining Nodes",
+                "tags": [
+                    "simulation"
+                ]
+            }
         },
-        "termsOfService": "http://swagger.io/terms/",
-        "title": "{{.Title}}",
-        "version": "{{.Version}}"
-    },
-    "externalDocs": {
-        "description": "",
-        "url": ""
-    },
-    "paths": {
-        "/decisions/history": {
-            "get": {
-                "description": "Retrieves a history of logged decisions with pagination",
+        "/v2/simulate/failure": {
+            "post": {
+                "description": "Simulates a failure of a specific service. Accepts \"name\" or \"namespace:name\" service IDs and always returns canonical IDs.",
                 "parameters": [
                     {
-                        "description": "Limit number of records",
+                        "description": "Export the impact graph instead of JSON: dot, mermaid, graphml or cytoscape",
                         "in": "query",
-                        "name": "limit",
+                        "name": "format",
                         "schema": {
-                            "default": 50,
-                            "type": "integer"
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/simulation.FailureSimulationRequest",
+                                        "summary": "request",
+                                        "description": "Simulation parameters"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Simulation parameters",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.FailureSimulationResultV2"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.FailureSimulationResultV2"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.FailureSimulationResultV2"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.FailureSimulationResultV2"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/graphml+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            },
+                            "text/vnd.graphviz": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
+                    }
+                },
+                "summary": "Simulate Service Failure (v2)",
+                "tags": [
+                    "simulation-v2"
+                ]
+            }
+        },
+        "/v2/simulate/remove": {
+            "post": {
+                "description": "Simulates a planned decommission. redirects maps each caller to the service that takes over its calls; replacements must exist in the cluster and differ from the caller. Projects the added load and latency on each replacement with the configured scaling model, using the parameters calibrated for the replacement when there are any, reports the traffic lost from callers without a replacement, and the node capacity freed by the removed pods.",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/simulation.RemoveSimulationRequest",
+                                        "summary": "request",
+                                        "description": "Simulation parameters"
+                                    }
+                                ]
+                            }
                         }
                     },
-                    {
-                        "description": "Offset for pagination",
-                        "in": "query",
-                        "name": "offset",
-                        "schema": {
-                            "default": 0,
-                            "type": "integer"
-                        }
+                    "description": "Simulation parameters",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/simulation.RemoveSimulationResult"
+                                }
+                            }
+                        },
+                        "description": "OK"
                     },
-                    {
-                        "description": "Filter by decision type",
-                        "in": "query",
-                        "name": "type",
-                        "schema": {
-                            "type": "string"
-                        }
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
                     }
-                ],
+                },
+                "summary": "Simulate Removing Service",
+                "tags": [
+                    "simulation"
+                ]
+            }
+        },
+        "/v2/simulate/rewire": {
+            "post": {
+                "description": "Applies edge mutations to a copy of the graph around the mutated services and compares it with the original. add_edge moves percent of the traffic source sends to from onto a new edge (or adds rate), remove_edge drops an edge, set_rate changes an edge's rate. Reports the change in load per service, the latency of the mutated callers and their paths, and new cycles and single points of failure.",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/simulation.RewireSimulationRequest",
+                                        "summary": "request",
+                                        "description": "Simulation parameters"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Simulation parameters",
+                    "required": true
+                },
                 "responses": {
                     "200": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {},
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/simulation.RewireSimulationResult"
                                 }
                             }
                         },
                         "description": "OK"
                     },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
                     "500": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
                         "description": "Internal Server Error"
                     },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
                     "503": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
                         "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
                     }
                 },
-                "summary": "Get Decision History",
+                "summary": "Simulate Rewiring Edges",
                 "tags": [
-                    "decisions"
+                    "simulation"
                 ]
             }
         },
-        "/decisions/log": {
+        "/v2/simulate/scale": {
             "post": {
-                "description": "Logs a decision made by the system or a user",
+                "description": "Simulates scaling a service. Accepts \"name\" or \"namespace:name\" service IDs and always returns canonical IDs.",
                 "requestBody": {
                     "content": {
                         "application/json": {
@@ -898,194 +13786,267 @@ const docTemplate = `{
                                         "type": "object"
                                     },
                                     {
-                                        "$ref": "#/components/schemas/storage.LogDecisionInput",
+                                        "$ref": "#/components/schemas/simulation.ScalingSimulationRequest",
                                         "summary": "request",
-                                        "description": "Decision details"
+                                        "description": "Simulation parameters"
                                     }
                                 ]
                             }
                         }
                     },
-                    "description": "Decision details",
+                    "description": "Simulation parameters",
                     "required": true
                 },
                 "responses": {
-                    "201": {
+                    "200": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {},
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.ScalingSimulationResultV2"
                                 }
                             }
                         },
-                        "description": "Created"
+                        "description": "OK"
                     },
                     "400": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
                         "description": "Bad Request"
                     },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
                     "500": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
                         "description": "Internal Server Error"
                     },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
                     "503": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
                         "description": "Service Unavailable"
+                    },
+                    "504": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Gateway Timeout"
                     }
                 },
-                "summary": "Log a Decision",
+                "summary": "Simulate Scaling (v2)",
                 "tags": [
-                    "decisions"
+                    "simulation-v2"
                 ]
             }
         },
-        "/dependency-graph/snapshot": {
+        "/v2/slos": {
             "get": {
-                "description": "Fetches the current dependency graph snapshot with optional filtering by namespace",
+                "description": "Lists registered SLOs, optionally for one service",
                 "parameters": [
                     {
-                        "description": "Filter by namespace",
+                        "description": "Service ID (namespace:name, or name in the default namespace)",
                         "in": "query",
-                        "name": "namespace",
+                        "name": "serviceId",
                         "schema": {
                             "type": "string"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.SLOsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
+                    }
+                },
+                "summary": "List SLOs",
+                "tags": [
+                    "slos"
+                ]
+            },
+            "post": {
+                "description": "Registers an SLO for a service with an availability target, a latency target on p95 or p99, or both. The latency objective is the fraction of time the percentile must stay within the target (default 0.99).",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/api.SLORequest",
+                                        "summary": "request",
+                                        "description": "SLO"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "SLO",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/api.GraphSnapshotResponse"
+                                    "$ref": "#/components/schemas/storage.SLO"
                                 }
                             }
                         },
-                        "description": "OK"
+                        "description": "Created"
                     },
-                    "503": {
+                    "400": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {},
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
-                        "description": "Service Unavailable"
-                    }
-                },
-                "summary": "Get Dependency Graph Snapshot",
-                "tags": [
-                    "graph"
-                ]
-            }
-        },
-        "/health": {
-            "get": {
-                "description": "Checks if the API and connections to the Graph Engine are healthy",
-                "responses": {
-                    "200": {
+                        "description": "Bad Request"
+                    },
+                    "409": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {},
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
-                        "description": "OK"
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Service Unavailable"
                     }
                 },
-                "summary": "Check API Health",
+                "summary": "Create an SLO",
                 "tags": [
-                    "system"
+                    "slos"
                 ]
             }
         },
-        "/risk/services/top": {
-            "get": {
-                "description": "Returns services ordered by risk metrics (pagerank or betweenness)",
+        "/v2/slos/{sloId}": {
+            "delete": {
                 "parameters": [
                     {
-                        "description": "Risk metric (pagerank, betweenness)",
-                        "in": "query",
-                        "name": "metric",
-                        "schema": {
-                            "default": "pagerank",
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "Number of services to return (1-20)",
-                        "in": "query",
-                        "name": "limit",
+                        "description": "SLO ID",
+                        "in": "path",
+                        "name": "sloId",
+                        "required": true,
                         "schema": {
-                            "default": 5,
                             "type": "integer"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/graph.TopCentralityResponse"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
-                        "description": "OK"
+                        "description": "Bad Request"
                     },
-                    "400": {
+                    "404": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
-                        "description": "Bad Request"
+                        "description": "Not Found"
                     },
                     "500": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
@@ -1095,127 +14056,100 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
                         "description": "Service Unavailable"
                     }
                 },
-                "summary": "Get Top Risky Services",
+                "summary": "Delete an SLO",
                 "tags": [
-                    "risk"
+                    "slos"
                 ]
-            }
-        },
-        "/services": {
+            },
             "get": {
-                "description": "Fetches a list of services and their status from the Graph Engine",
+                "parameters": [
+                    {
+                        "description": "SLO ID",
+                        "in": "path",
+                        "name": "sloId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {},
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/storage.SLO"
                                 }
                             }
                         },
                         "description": "OK"
                     },
-                    "503": {
+                    "400": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
-                        "description": "Service Unavailable"
-                    }
-                },
-                "summary": "List Services",
-                "tags": [
-                    "services"
-                ]
-            }
-        },
-        "/simulate/add": {
-            "post": {
-                "description": "Simulates adding a new service to the cluster (capacity planning)",
-                "requestBody": {
-                    "content": {
-                        "application/json": {
-                            "schema": {
-                                "oneOf": [
-                                    {
-                                        "type": "object"
-                                    },
-                                    {
-                                        "$ref": "#/components/schemas/simulation.AddSimulationRequest",
-                                        "summary": "request",
-                                        "description": "Simulation parameters"
-                                    }
-                                ]
-                            }
-                        }
+                        "description": "Bad Request"
                     },
-                    "description": "Simulation parameters",
-                    "required": true
-                },
-                "responses": {
-                    "200": {
+                    "404": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/simulation.AddSimulationResult"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
-                        "description": "OK"
+                        "description": "Not Found"
                     },
-                    "400": {
+                    "500": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
-                        "description": "Bad Request"
+                        "description": "Internal Server Error"
                     },
-                    "500": {
+                    "503": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
-                        "description": "Internal Server Error"
+                        "description": "Service Unavailable"
                     }
                 },
-                "summary": "Simulate Adding Service",
+                "summary": "Get an SLO",
                 "tags": [
-                    "simulation"
+                    "slos"
                 ]
-            }
-        },
-        "/simulate/failure": {
-            "post": {
-                "description": "Simulates a failure of a specific service and analyzes the impact",
+            },
+            "put": {
+                "description": "Replaces every field of an SLO. Objectives left out of the request are removed.",
+                "parameters": [
+                    {
+                        "description": "SLO ID",
+                        "in": "path",
+                        "name": "sloId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
                 "requestBody": {
                     "content": {
                         "application/json": {
@@ -1225,15 +14159,15 @@ const docTemplate = `{
                                         "type": "object"
                                     },
                                     {
-                                        "$ref": "#/components/schemas/simulation.FailureSimulationRequest",
+                                        "$ref": "#/components/schemas/api.SLORequest",
                                         "summary": "request",
-                                        "description": "Simulation parameters"
+                                        "description": "SLO"
                                     }
                                 ]
                             }
                         }
                     },
-                    "description": "Simulation parameters",
+                    "description": "SLO",
                     "required": true
                 },
                 "responses": {
@@ -1241,7 +14175,7 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/simulation.FailureSimulationResult"
+                                    "$ref": "#/components/schemas/storage.SLO"
                                 }
                             }
                         },
@@ -1251,10 +14185,7 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
@@ -1264,23 +14195,27 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
                         "description": "Not Found"
                     },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
                     "500": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
@@ -1290,51 +14225,39 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
                         "description": "Service Unavailable"
                     }
                 },
-                "summary": "Simulate Service Failure",
+                "summary": "Replace an SLO",
                 "tags": [
-                    "simulation"
+                    "slos"
                 ]
             }
         },
-        "/simulate/scale": {
-            "post": {
-                "description": "Simulates scaling a service and analyzes latency impact",
-                "requestBody": {
-                    "content": {
-                        "application/json": {
-                            "schema": {
-                                "oneOf": [
-                                    {
-                                        "type": "object"
-                                    },
-                                    {
-                                        "$ref": "#/components/schemas/simulation.ScalingSimulationRequest",
-                                        "summary": "request",
-                                        "description": "Simulation parameters"
-                                    }
-                                ]
-                            }
+        "/v2/slos/{sloId}/status": {
+            "get": {
+                "description": "Computes compliance over the SLO window, remaining error budget and burn rates over trailing windows from service_metrics. An objective is at_risk when a fast or slow multi-window burn rate alert is firing and breached once its budget is spent.",
+                "parameters": [
+                    {
+                        "description": "SLO ID",
+                        "in": "path",
+                        "name": "sloId",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
                         }
-                    },
-                    "description": "Simulation parameters",
-                    "required": true
-                },
+                    }
+                ],
                 "responses": {
                     "200": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/simulation.ScalingSimulationResult"
+                                    "$ref": "#/components/schemas/slo.Status"
                                 }
                             }
                         },
@@ -1344,10 +14267,7 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
@@ -1357,10 +14277,7 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
@@ -1370,36 +14287,40 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
                         "description": "Internal Server Error"
                     },
+                    "502": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/api.Problem"
+                                }
+                            }
+                        },
+                        "description": "Bad Gateway"
+                    },
                     "503": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
                         "description": "Service Unavailable"
                     }
                 },
-                "summary": "Simulate Scaling",
+                "summary": "Get SLO Compliance",
                 "tags": [
-                    "simulation"
+                    "slos"
                 ]
             }
         },
-        "/telemetry/edges": {
+        "/v2/telemetry/edges": {
             "get": {
                 "description": "Fetches telemetry metrics for edges between services",
                 "parameters": [
@@ -1463,36 +14384,27 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
                         "description": "Bad Request"
                     },
-                    "500": {
+                    "502": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
-                        "description": "Internal Server Error"
+                        "description": "Bad Gateway"
                     },
                     "503": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
@@ -1505,7 +14417,7 @@ const docTemplate = `{
                 ]
             }
         },
-        "/telemetry/service": {
+        "/v2/telemetry/service": {
             "get": {
                 "description": "Fetches telemetry metrics for a specific service or all services",
                 "parameters": [
@@ -1561,36 +14473,27 @@ const docTemplate = `{
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
                         "description": "Bad Request"
                     },
-                    "500": {
+                    "502": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
-                        "description": "Internal Server Error"
+                        "description": "Bad Gateway"
                     },
                     "503": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "additionalProperties": {
-                                        "type": "string"
-                                    },
-                                    "type": "object"
+                                    "$ref": "#/components/schemas/api.Problem"
                                 }
                             }
                         },
@@ -0,0 +1,356 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"predictive-analysis-engine/pkg/common"
+)
+
+const (
+	ContributorService       = "service"
+	ContributorParallelGroup = "parallel_group"
+)
+
+const minutesPerMonth = 30 * 24 * 60
+
+// AvailabilityContributor is one block of the availability composition:
+// a service, or a set of alternative dependencies of which any one is
+// enough. Limit is how much the achievable availability would rise if this
+// block never failed.
+type AvailabilityContributor struct {
+	Kind                  string   `json:"kind"`
+	ServiceID             string   `json:"serviceId"`
+	Alternatives          []string `json:"alternatives,omitempty"`
+	Availability          *float64 `json:"availability,omitempty"`
+	Replicas              int      `json:"replicas,omitempty"`
+	EffectiveAvailability float64  `json:"effectiveAvailability"`
+	ShareOfUnavailability float64  `json:"shareOfUnavailability"`
+	AchievableIfPerfect   float64  `json:"achievableIfPerfect"`
+	Limit                 float64  `json:"limit"`
+	Path                  []string `json:"path"`
+}
+
+// AvailabilityAnalysis is the best availability a service can reach given
+// every service it transitively calls.
+type AvailabilityAnalysis struct {
+	ServiceID               string                    `json:"serviceId"`
+	AchievableAvailability  float64                   `json:"achievableAvailability"`
+	DowntimeMinutesPerMonth float64                   `json:"downtimeMinutesPerMonth"`
+	OwnAvailability         float64                   `json:"ownAvailability"`
+	DependencyCount         int                       `json:"dependencyCount"`
+	Contributors            []AvailabilityContributor `json:"contributors"`
+	Assumptions             []string                  `json:"assumptions"`
+	Warnings                []string                  `json:"warnings"`
+	GeneratedAt             string                    `json:"generatedAt"`
+}
+
+// availabilityBlock is a contributor with the services it stands for. For
+// a parallel group, needs holds the blocks only some alternatives need.
+type availabilityBlock struct {
+	contributor AvailabilityContributor
+	members     []string
+	needs       map[string]bool
+}
+
+// AvailabilityOptions tunes the composition. By default a service's
+// reported availability is used as is. ReplicaRedundancy instead treats it
+// as the availability of one replica, with replicas failing independently.
+// Alternatives declares groups of interchangeable services: where a caller
+// calls two or more members of a group, any one of them is enough. Instances
+// of a dependency with the same name in other namespaces are always
+// alternatives.
+type AvailabilityOptions struct {
+	Limit             int
+	ReplicaRedundancy bool
+	Alternatives      [][]string
+}
+
+type availabilityComposer struct {
+	graph    *DependencyGraph
+	opts     AvailabilityOptions
+	groupOf  map[string]string
+	blocks   map[string]*availabilityBlock
+	closures map[string]map[string]bool
+	onStack  map[string]bool
+	cycles   map[string]bool
+	unknown  map[string]bool
+	overlaps map[string]bool
+}
+
+// ComposeAvailability composes the availability of serviceID across its
+// outgoing dependency graph. Distinct dependencies are in series and
+// multiply; alternatives a caller actually calls are in parallel. A
+// dependency reached along several serial paths is counted once, and so is
+// one every alternative of a group needs: it is taken out of the group and
+// composed in series with it. Failures are assumed independent.
+func ComposeAvailability(ctx context.Context, client SnapshotSource, serviceID string, opts AvailabilityOptions) (*AvailabilityAnalysis, error) {
+	g, err := LoadDependencyGraph(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return AnalyzeAvailability(g, serviceID, opts)
+}
+
+// AnalyzeAvailability composes the availability of serviceID in g.
+func AnalyzeAvailability(g *DependencyGraph, serviceID string, opts AvailabilityOptions) (*AvailabilityAnalysis, error) {
+	if _, ok := g.Nodes[serviceID]; !ok {
+		return nil, common.NewError(common.CodeServiceNotFound, "Service not found: %s", serviceID)
+	}
+	groupOf, err := alternativeGroups(g, opts.Alternatives)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &availabilityComposer{
+		graph:    g,
+		opts:     opts,
+		groupOf:  groupOf,
+		blocks:   map[string]*availabilityBlock{},
+		closures: map[string]map[string]bool{},
+		onStack:  map[string]bool{},
+		cycles:   map[string]bool{},
+		unknown:  map[string]bool{},
+		overlaps: map[string]bool{},
+	}
+	closure := c.closure(serviceID)
+	achievable := c.product(closure)
+	for key := range closure {
+		for need := range c.blocks[key].needs {
+			if closure[need] {
+				c.overlaps[strings.Join(c.blocks[key].members, "|")] = true
+			}
+		}
+	}
+
+	contributors := []AvailabilityContributor{}
+	for key := range closure {
+		b := c.blocks[key]
+		ct := b.contributor
+		ct.AchievableIfPerfect = achievable
+		if ct.EffectiveAvailability > 0 {
+			ct.AchievableIfPerfect = math.Min(achievable/ct.EffectiveAvailability, 1)
+		}
+		ct.Limit = ct.AchievableIfPerfect - achievable
+		if achievable < 1 && ct.EffectiveAvailability > 0 {
+			ct.ShareOfUnavailability = math.Log(ct.EffectiveAvailability) / math.Log(achievable)
+		}
+		ct.Path = g.ShortestPath(serviceID, b.members[0])
+		contributors = append(contributors, ct)
+	}
+	sort.Slice(contributors, func(i, j int) bool {
+		if contributors[i].Limit != contributors[j].Limit {
+			return contributors[i].Limit > contributors[j].Limit
+		}
+		return contributors[i].ServiceID < contributors[j].ServiceID
+	})
+	if opts.Limit > 0 && len(contributors) > opts.Limit {
+		contributors = contributors[:opts.Limit]
+	}
+
+	warnings := []string{}
+	if len(c.unknown) > 0 {
+		warnings = append(warnings, fmt.Sprintf("No availability reported for %s; assumed 1.", strings.Join(sortedKeys(c.unknown), ", ")))
+	}
+	if len(c.cycles) > 0 {
+		warnings = append(warnings, fmt.Sprintf("Dependency cycles through %s were cut; each service is still counted once.", strings.Join(sortedKeys(c.cycles), ", ")))
+	}
+	if len(c.overlaps) > 0 {
+		warnings = append(warnings, fmt.Sprintf("Alternatives %s share dependencies with each other or with the rest of the graph that could not be factored out; those are counted more than once, so the result is approximate.", strings.Join(sortedKeys(c.overlaps), ", ")))
+	}
+
+	return &AvailabilityAnalysis{
+		ServiceID:               serviceID,
+		AchievableAvailability:  achievable,
+		DowntimeMinutesPerMonth: (1 - achievable) * minutesPerMonth,
+		OwnAvailability:         c.blocks[c.serviceBlock(serviceID)].contributor.EffectiveAvailability,
+		DependencyCount:         len(g.Reachable(serviceID)) - 1,
+		Contributors:            contributors,
+		Assumptions:             c.assumptions(),
+		Warnings:                warnings,
+		GeneratedAt:             time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// closure returns the keys of the blocks id needs to be up: its own block,
+// the closures of its single-instance dependencies, and per dependency with
+// alternatives one parallel block plus the blocks all alternatives need.
+func (c *availabilityComposer) closure(id string) map[string]bool {
+	if cl, ok := c.closures[id]; ok {
+		return cl
+	}
+	c.onStack[id] = true
+	defer delete(c.onStack, id)
+
+	cl := map[string]bool{c.serviceBlock(id): true}
+	byGroup := map[string][]string{}
+	var groups []string
+	for _, e := range c.graph.Outgoing[id] {
+		if e.Target == id {
+			continue
+		}
+		if c.onStack[e.Target] {
+			c.cycles[e.Target] = true
+			continue
+		}
+		group, ok := c.groupOf[e.Target]
+		if !ok {
+			group = c.graph.Nodes[e.Target].Name
+		}
+		if _, ok := byGroup[group]; !ok {
+			groups = append(groups, group)
+		}
+		if !slices.Contains(byGroup[group], e.Target) {
+			byGroup[group] = append(byGroup[group], e.Target)
+		}
+	}
+
+	for _, group := range groups {
+		targets := byGroup[group]
+		if len(targets) == 1 {
+			for key := range c.closure(targets[0]) {
+				cl[key] = true
+			}
+			continue
+		}
+		sort.Strings(targets)
+		shared := c.sharedBlocks(targets)
+		for k := range shared {
+			cl[k] = true
+		}
+		key := "group:" + strings.Join(targets, "|")
+		if _, ok := c.blocks[key]; !ok {
+			down := 1.0
+			needs := map[string]bool{}
+			for _, t := range targets {
+				own := map[string]bool{}
+				for k := range c.closure(t) {
+					if !shared[k] {
+						own[k] = true
+						needs[k] = true
+					}
+				}
+				down *= 1 - c.product(own)
+			}
+			c.blocks[key] = &availabilityBlock{
+				members: targets,
+				needs:   needs,
+				contributor: AvailabilityContributor{
+					Kind:                  ContributorParallelGroup,
+					ServiceID:             group,
+					Alternatives:          targets,
+					EffectiveAvailability: 1 - down,
+				},
+			}
+		}
+		cl[key] = true
+	}
+
+	c.closures[id] = cl
+	return cl
+}
+
+// sharedBlocks returns the blocks every one of targets needs. Blocks needed
+// by more than one alternative but not all cannot be factored out, so the
+// group is recorded in overlaps.
+func (c *availabilityComposer) sharedBlocks(targets []string) map[string]bool {
+	count := map[string]int{}
+	for _, t := range targets {
+		for k := range c.closure(t) {
+			count[k]++
+		}
+	}
+	shared := map[string]bool{}
+	for k, n := range count {
+		switch n {
+		case len(targets):
+			shared[k] = true
+		case 1:
+		default:
+			c.overlaps[strings.Join(targets, "|")] = true
+		}
+	}
+	return shared
+}
+
+func (c *availabilityComposer) serviceBlock(id string) string {
+	key := "service:" + id
+	if _, ok := c.blocks[key]; ok {
+		return key
+	}
+	node := c.graph.Nodes[id]
+	ct := AvailabilityContributor{Kind: ContributorService, ServiceID: id, Replicas: max(node.PodCount, 1), EffectiveAvailability: 1}
+	if node.Availability > 0 {
+		a := math.Min(node.Availability, 1)
+		ct.Availability = &a
+		ct.EffectiveAvailability = a
+		if c.opts.ReplicaRedundancy {
+			ct.EffectiveAvailability = 1 - math.Pow(1-a, float64(ct.Replicas))
+		}
+	} else {
+		c.unknown[id] = true
+	}
+	c.blocks[key] = &availabilityBlock{contributor: ct, members: []string{id}}
+	return key
+}
+
+func (c *availabilityComposer) assumptions() []string {
+	a := []string{"Every outgoing dependency is called synchronously and is required."}
+	if c.opts.ReplicaRedundancy {
+		a = append(a, "The availability reported for a service is that of one replica; replicas fail independently.")
+	} else {
+		a = append(a, "The availability reported for a service already accounts for its replicas.")
+	}
+	a = append(a, "Instances of a dependency with the same name in different namespaces are alternatives; any one is enough.")
+	if len(c.opts.Alternatives) > 0 {
+		a = append(a, "Declared alternatives called by the same caller are in parallel; any one is enough.")
+	}
+	return a
+}
+
+// alternativeGroups maps each service of a declared alternatives group to
+// the group's label, the sorted member IDs joined with "|".
+func alternativeGroups(g *DependencyGraph, groups [][]string) (map[string]string, error) {
+	groupOf := map[string]string{}
+	for _, group := range groups {
+		members := slices.Clone(group)
+		sort.Strings(members)
+		members = slices.Compact(members)
+		if len(members) < 2 {
+			return nil, common.NewError(common.CodeInvalidParameter, "An alternatives group needs at least two distinct services: %s", strings.Join(group, ","))
+		}
+		label := strings.Join(members, "|")
+		for _, id := range members {
+			if _, ok := g.Nodes[id]; !ok {
+				return nil, common.NewError(common.CodeServiceNotFound, "Service not found: %s", id)
+			}
+			if other, ok := groupOf[id]; ok {
+				return nil, common.NewError(common.CodeInvalidParameter, "%s is in more than one alternatives group (%s and %s)", id, other, label)
+			}
+			groupOf[id] = label
+		}
+	}
+	return groupOf, nil
+}
+
+func (c *availabilityComposer) product(closure map[string]bool) float64 {
+	p := 1.0
+	for key := range closure {
+		p *= c.blocks[key].contributor.EffectiveAvailability
+	}
+	return p
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
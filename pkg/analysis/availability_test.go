@@ -0,0 +1,76 @@
+package analysis
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestAnalyzeAvailability(t *testing.T) {
+	tests := []struct {
+		name         string
+		edges        []string
+		alternatives []string
+		achievable   float64
+		overlap      bool
+	}{
+		{
+			name:       "serial chain",
+			edges:      []string{"a>b1", "b1>db"},
+			achievable: 0.9 * 0.99,
+		},
+		{
+			name:         "dependency shared by every alternative is counted once",
+			edges:        []string{"a>b1", "a>b2", "b1>db", "b2>db"},
+			alternatives: []string{"b1", "b2"},
+			achievable:   0.99 * (1 - 0.1*0.1),
+		},
+		{
+			name:         "dependency shared by some alternatives",
+			edges:        []string{"a>b1", "a>b2", "a>b3", "b1>db", "b2>db"},
+			alternatives: []string{"b1", "b2", "b3"},
+			achievable:   1 - (1-0.9*0.99)*(1-0.9*0.99)*(1-0.9),
+			overlap:      true,
+		},
+		{
+			name:         "alternative needs a serial dependency",
+			edges:        []string{"a>b1", "a>b2", "a>db", "b1>db"},
+			alternatives: []string{"b1", "b2"},
+			achievable:   0.99 * (1 - (1-0.9*0.99)*(1-0.9)),
+			overlap:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := testGraph(t, tt.edges...)
+			for id, n := range g.Nodes {
+				switch {
+				case id == "a":
+					n.Availability = 1
+				case id == "db":
+					n.Availability = 0.99
+				default:
+					n.Availability = 0.9
+				}
+			}
+			var opts AvailabilityOptions
+			if tt.alternatives != nil {
+				opts.Alternatives = [][]string{tt.alternatives}
+			}
+			r, err := AnalyzeAvailability(g, "a", opts)
+			if err != nil {
+				t.Fatalf("AnalyzeAvailability: %v", err)
+			}
+			if math.Abs(r.AchievableAvailability-tt.achievable) > 1e-9 {
+				t.Errorf("achievable = %v, want %v", r.AchievableAvailability, tt.achievable)
+			}
+			warned := false
+			for _, w := range r.Warnings {
+				warned = warned || strings.Contains(w, "share dependencies")
+			}
+			if warned != tt.overlap {
+				t.Errorf("warnings = %v, want overlap warning %v", r.Warnings, tt.overlap)
+			}
+		})
+	}
+}
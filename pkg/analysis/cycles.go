@@ -0,0 +1,285 @@
+package analysis
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxCyclesPerComponent bounds cycle enumeration; a dense component can
+// hold exponentially many elementary cycles. With Johnson's algorithm the
+// work done is bounded by this many cycles, not by the paths explored.
+const maxCyclesPerComponent = 50
+
+// CycleEdge is an edge inside a strongly connected component. Share is its
+// fraction of the traffic on all edges of the component.
+type CycleEdge struct {
+	Source string  `json:"source"`
+	Target string  `json:"target"`
+	RPS    float64 `json:"rps"`
+	Share  float64 `json:"share"`
+}
+
+// Cycle is an elementary cycle, starting and ending at its smallest service
+// ID. RPS is the rate of its least-used edge, the most traffic that can
+// flow all the way around; HeaviestEdge is the edge carrying the most.
+type Cycle struct {
+	Key          string    `json:"key"`
+	Path         []string  `json:"path"`
+	RPS          float64   `json:"rps"`
+	HeaviestEdge CycleEdge `json:"heaviestEdge"`
+}
+
+// StronglyConnectedComponent is a set of services that can all reach each
+// other, so a failure or slowdown in any of them can feed back into itself.
+type StronglyConnectedComponent struct {
+	Services        []string    `json:"services"`
+	InternalRPS     float64     `json:"internalRps"`
+	Edges           []CycleEdge `json:"edges"`
+	Cycles          []Cycle     `json:"cycles"`
+	CyclesTruncated bool        `json:"cyclesTruncated"`
+}
+
+type CycleReport struct {
+	ServiceCount int                          `json:"serviceCount"`
+	EdgeCount    int                          `json:"edgeCount"`
+	Components   []StronglyConnectedComponent `json:"components"`
+	CycleCount   int                          `json:"cycleCount"`
+	GeneratedAt  string                       `json:"generatedAt"`
+}
+
+// FindCycles runs Tarjan's algorithm over the full snapshot graph and
+// reports every component with a cycle, heaviest internal traffic first.
+func FindCycles(ctx context.Context, client SnapshotSource) (*CycleReport, error) {
+	g, err := LoadDependencyGraph(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return AnalyzeCycles(g), nil
+}
+
+// AnalyzeCycles reports the components of g that contain a cycle.
+func AnalyzeCycles(g *DependencyGraph) *CycleReport {
+	report := &CycleReport{
+		ServiceCount: len(g.Nodes),
+		Components:   []StronglyConnectedComponent{},
+		GeneratedAt:  time.Now().Format(time.RFC3339),
+	}
+	for _, edges := range g.Outgoing {
+		report.EdgeCount += len(edges)
+	}
+
+	for _, members := range StronglyConnectedComponents(g) {
+		if len(members) == 1 && !hasSelfLoop(g, members[0]) {
+			continue
+		}
+		scc := componentDetails(g, members)
+		report.CycleCount += len(scc.Cycles)
+		report.Components = append(report.Components, scc)
+	}
+	sort.Slice(report.Components, func(i, j int) bool {
+		if report.Components[i].InternalRPS != report.Components[j].InternalRPS {
+			return report.Components[i].InternalRPS > report.Components[j].InternalRPS
+		}
+		return report.Components[i].Services[0] < report.Components[j].Services[0]
+	})
+	return report
+}
+
+// StronglyConnectedComponents returns the strongly connected components of
+// g with Tarjan's algorithm, each sorted by service ID.
+func StronglyConnectedComponents(g *DependencyGraph) [][]string {
+	index := map[string]int{}
+	lowlink := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var components [][]string
+	next := 0
+
+	var visit func(v string)
+	visit = func(v string) {
+		index[v], lowlink[v] = next, next
+		next++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, e := range g.Outgoing[v] {
+			w := e.Target
+			if _, seen := index[w]; !seen {
+				visit(w)
+				lowlink[v] = min(lowlink[v], lowlink[w])
+			} else if onStack[w] {
+				lowlink[v] = min(lowlink[v], index[w])
+			}
+		}
+
+		if lowlink[v] == index[v] {
+			var component []string
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == v {
+					break
+				}
+			}
+			sort.Strings(component)
+			components = append(components, component)
+		}
+	}
+
+	for _, id := range g.NodeIDs() {
+		if _, seen := index[id]; !seen {
+			visit(id)
+		}
+	}
+	return components
+}
+
+func componentDetails(g *DependencyGraph, members []string) StronglyConnectedComponent {
+	in := map[string]bool{}
+	for _, m := range members {
+		in[m] = true
+	}
+
+	scc := StronglyConnectedComponent{Services: members, Edges: []CycleEdge{}, Cycles: []Cycle{}}
+	rates := map[[2]string]float64{}
+	for _, m := range members {
+		for _, e := range g.Outgoing[m] {
+			if in[e.Target] {
+				scc.Edges = append(scc.Edges, CycleEdge{Source: e.Source, Target: e.Target, RPS: e.RPS})
+				scc.InternalRPS += e.RPS
+				rates[[2]string{e.Source, e.Target}] += e.RPS
+			}
+		}
+	}
+	for i := range scc.Edges {
+		if scc.InternalRPS > 0 {
+			scc.Edges[i].Share = scc.Edges[i].RPS / scc.InternalRPS
+		}
+	}
+	sort.Slice(scc.Edges, func(i, j int) bool { return scc.Edges[i].RPS > scc.Edges[j].RPS })
+
+	// Each cycle is found once, from its smallest member, by only visiting
+	// members larger than the start.
+	adj := map[string][]string{}
+	for _, m := range members {
+		for _, e := range g.Outgoing[m] {
+			if in[e.Target] && !slices.Contains(adj[m], e.Target) {
+				adj[m] = append(adj[m], e.Target)
+			}
+		}
+	}
+	for _, start := range members {
+		search := &cycleSearch{
+			adj:       adj,
+			start:     start,
+			blocked:   map[string]bool{},
+			blockedBy: map[string]map[string]bool{},
+		}
+		search.emit = func(path []string) bool {
+			if len(scc.Cycles) == maxCyclesPerComponent {
+				scc.CyclesTruncated = true
+				return false
+			}
+			scc.Cycles = append(scc.Cycles, newCycle(path, rates, scc.InternalRPS))
+			return true
+		}
+		search.circuit(start)
+		if scc.CyclesTruncated {
+			break
+		}
+	}
+	sort.Slice(scc.Cycles, func(i, j int) bool {
+		if scc.Cycles[i].RPS != scc.Cycles[j].RPS {
+			return scc.Cycles[i].RPS > scc.Cycles[j].RPS
+		}
+		return scc.Cycles[i].Key < scc.Cycles[j].Key
+	})
+	return scc
+}
+
+// cycleSearch enumerates the elementary cycles through start with Johnson's
+// algorithm. A vertex stays blocked after a search from it finds no cycle,
+// until a cycle through one of its successors frees it, so the work between
+// two cycles is linear in the component and the cycle cap bounds the total.
+type cycleSearch struct {
+	adj       map[string][]string
+	start     string
+	blocked   map[string]bool
+	blockedBy map[string]map[string]bool
+	path      []string
+	emit      func(path []string) bool
+	stopped   bool
+}
+
+func (c *cycleSearch) circuit(v string) bool {
+	found := false
+	c.path = append(c.path, v)
+	c.blocked[v] = true
+	for _, w := range c.adj[v] {
+		if c.stopped {
+			break
+		}
+		switch {
+		case w < c.start:
+		case w == c.start:
+			if !c.emit(append(slices.Clone(c.path), c.start)) {
+				c.stopped = true
+			}
+			found = true
+		case !c.blocked[w] && c.circuit(w):
+			found = true
+		}
+	}
+	if found {
+		c.unblock(v)
+	} else {
+		for _, w := range c.adj[v] {
+			if c.blockedBy[w] == nil {
+				c.blockedBy[w] = map[string]bool{}
+			}
+			c.blockedBy[w][v] = true
+		}
+	}
+	c.path = c.path[:len(c.path)-1]
+	return found
+}
+
+func (c *cycleSearch) unblock(v string) {
+	c.blocked[v] = false
+	for w := range c.blockedBy[v] {
+		delete(c.blockedBy[v], w)
+		if c.blocked[w] {
+			c.unblock(w)
+		}
+	}
+}
+
+func newCycle(path []string, rates map[[2]string]float64, internalRPS float64) Cycle {
+	c := Cycle{Key: strings.Join(path, "->"), Path: path, RPS: math.Inf(1)}
+	for i := 0; i < len(path)-1; i++ {
+		rps := rates[[2]string{path[i], path[i+1]}]
+		c.RPS = math.Min(c.RPS, rps)
+		if i == 0 || rps > c.HeaviestEdge.RPS {
+			c.HeaviestEdge = CycleEdge{Source: path[i], Target: path[i+1], RPS: rps}
+		}
+	}
+	if internalRPS > 0 {
+		c.HeaviestEdge.Share = c.HeaviestEdge.RPS / internalRPS
+	}
+	return c
+}
+
+func hasSelfLoop(g *DependencyGraph, id string) bool {
+	for _, e := range g.Outgoing[id] {
+		if e.Target == id {
+			return true
+		}
+	}
+	return false
+}
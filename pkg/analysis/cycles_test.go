@@ -0,0 +1,161 @@
+package analysis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// testGraph builds a graph from edges written "source>target" or
+// "source>target:rps"; edges without a rate carry 1 rps.
+func testGraph(t *testing.T, edges ...string) *DependencyGraph {
+	t.Helper()
+	g := &DependencyGraph{
+		Nodes:    map[string]*DependencyNode{},
+		Outgoing: map[string][]DependencyEdge{},
+		Incoming: map[string][]DependencyEdge{},
+	}
+	for _, spec := range edges {
+		endpoints, rate, hasRate := strings.Cut(spec, ":")
+		source, target, ok := strings.Cut(endpoints, ">")
+		if !ok {
+			t.Fatalf("bad edge %q", spec)
+		}
+		e := DependencyEdge{Source: source, Target: target, RPS: 1}
+		if hasRate {
+			rps, err := strconv.ParseFloat(rate, 64)
+			if err != nil {
+				t.Fatalf("bad edge %q: %v", spec, err)
+			}
+			e.RPS = rps
+		}
+		for _, id := range []string{source, target} {
+			if _, ok := g.Nodes[id]; !ok {
+				g.Nodes[id] = &DependencyNode{ID: id, Name: id}
+			}
+		}
+		g.Outgoing[source] = append(g.Outgoing[source], e)
+		g.Incoming[target] = append(g.Incoming[target], e)
+	}
+	g.sortEdges()
+	return g
+}
+
+func cycleKeys(scc StronglyConnectedComponent) []string {
+	keys := make([]string, len(scc.Cycles))
+	for i, c := range scc.Cycles {
+		keys[i] = c.Key
+	}
+	return keys
+}
+
+func TestAnalyzeCycles(t *testing.T) {
+	tests := []struct {
+		name       string
+		edges      []string
+		components [][]string
+		cycles     [][]string
+	}{
+		{
+			name:  "acyclic",
+			edges: []string{"a>b", "b>c", "a>c"},
+		},
+		{
+			name:       "self-loop",
+			edges:      []string{"a>a", "a>b"},
+			components: [][]string{{"a"}},
+			cycles:     [][]string{{"a->a"}},
+		},
+		{
+			name:       "two overlapping cycles",
+			edges:      []string{"a>b:10", "b>a:4", "b>c:6", "c>a:6"},
+			components: [][]string{{"a", "b", "c"}},
+			cycles:     [][]string{{"a->b->c->a", "a->b->a"}},
+		},
+		{
+			name:       "separate components, heaviest first",
+			edges:      []string{"a>b:1", "b>a:1", "c>d:5", "d>c:5", "b>c:100"},
+			components: [][]string{{"c", "d"}, {"a", "b"}},
+			cycles:     [][]string{{"c->d->c"}, {"a->b->a"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := AnalyzeCycles(testGraph(t, tt.edges...))
+			if len(report.Components) != len(tt.components) {
+				t.Fatalf("components = %+v, want %v", report.Components, tt.components)
+			}
+			for i, scc := range report.Components {
+				if fmt.Sprint(scc.Services) != fmt.Sprint(tt.components[i]) {
+					t.Errorf("component %d = %v, want %v", i, scc.Services, tt.components[i])
+				}
+				if got := cycleKeys(scc); fmt.Sprint(got) != fmt.Sprint(tt.cycles[i]) {
+					t.Errorf("component %d cycles = %v, want %v", i, got, tt.cycles[i])
+				}
+				if scc.CyclesTruncated {
+					t.Errorf("component %d truncated", i)
+				}
+			}
+		})
+	}
+}
+
+func TestAnalyzeCyclesTruncates(t *testing.T) {
+	// A complete graph on six services has 409 elementary cycles.
+	var edges []string
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 6; j++ {
+			if i != j {
+				edges = append(edges, fmt.Sprintf("s%d>s%d", i, j))
+			}
+		}
+	}
+	report := AnalyzeCycles(testGraph(t, edges...))
+	if len(report.Components) != 1 {
+		t.Fatalf("components = %d, want 1", len(report.Components))
+	}
+	scc := report.Components[0]
+	if !scc.CyclesTruncated || len(scc.Cycles) != maxCyclesPerComponent || report.CycleCount != maxCyclesPerComponent {
+		t.Errorf("cycles = %d (truncated %v), want %d truncated", len(scc.Cycles), scc.CyclesTruncated, maxCyclesPerComponent)
+	}
+	seen := map[string]bool{}
+	for _, c := range scc.Cycles {
+		if seen[c.Key] {
+			t.Errorf("cycle %s reported twice", c.Key)
+		}
+		seen[c.Key] = true
+	}
+}
+
+// From a, every one of the 2^30 routes through the diamond chain ends at t,
+// whose only way back is through r, already on the path. Enumeration must
+// not walk them one by one.
+func TestAnalyzeCyclesPrunesDeadEnds(t *testing.T) {
+	const depth = 30
+	edges := []string{"a>r", "r>q", "q>a", "r>l00x", "r>l00y"}
+	for i := 0; i < depth-1; i++ {
+		for _, from := range []string{"x", "y"} {
+			for _, to := range []string{"x", "y"} {
+				edges = append(edges, fmt.Sprintf("l%02d%s>l%02d%s", i, from, i+1, to))
+			}
+		}
+	}
+	edges = append(edges, fmt.Sprintf("l%02dx>t", depth-1), fmt.Sprintf("l%02dy>t", depth-1), "t>r")
+
+	report := AnalyzeCycles(testGraph(t, edges...))
+	if len(report.Components) != 1 {
+		t.Fatalf("components = %d, want 1", len(report.Components))
+	}
+	scc := report.Components[0]
+	if !scc.CyclesTruncated {
+		t.Error("component not truncated")
+	}
+	keys := map[string]bool{}
+	for _, c := range scc.Cycles {
+		keys[c.Key] = true
+	}
+	if !keys["a->r->q->a"] {
+		t.Errorf("cycles = %v, want a->r->q->a", cycleKeys(scc))
+	}
+}
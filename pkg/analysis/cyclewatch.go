@@ -0,0 +1,179 @@
+package analysis
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+// TopologyEventStore is the part of the decision store the cycle watcher
+// records to. It is satisfied by storage.TopologyStore.
+type TopologyEventStore interface {
+	SaveTopologyEvents(events []storage.TopologyEvent) error
+	ListTopologyEvents(f storage.TopologyEventFilter) ([]storage.TopologyEvent, error)
+}
+
+// seedEventLimit bounds how many stored cycle events are read to recall the
+// cycles that were present before a restart.
+const seedEventLimit = 1000
+
+// CycleWatcher flags dependency cycles that were not present in the previous
+// snapshot, and records when a flagged cycle disappears. A cycle that
+// disappears and later returns is flagged again, across restarts too.
+//
+// Components with more cycles than are enumerated are watched as a whole:
+// which cycles get enumerated depends on the component's exact edges, so
+// diffing them would report cycles as new that were merely not listed
+// before.
+type CycleWatcher struct {
+	store TopologyEventStore
+	mu    sync.Mutex
+	// known maps the key of each cycle or truncated component present in the
+	// previous snapshot to its services.
+	known  map[string][]string
+	seeded bool
+}
+
+func NewCycleWatcher(store TopologyEventStore) *CycleWatcher {
+	return &CycleWatcher{store: store, known: map[string][]string{}}
+}
+
+// Observe records a topology-risk event for each cycle in snapshot that was
+// not seen in the previous one, and a resolved event for each one that is
+// gone, and returns the events.
+func (w *CycleWatcher) Observe(snapshot *graph.MetricsSnapshotResponse) ([]storage.TopologyEvent, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.seeded && w.store != nil {
+		if err := w.seed(); err != nil {
+			return nil, err
+		}
+	}
+	w.seeded = true
+
+	report := AnalyzeCycles(BuildDependencyGraph(snapshot))
+	current := map[string][]string{}
+	events := []storage.TopologyEvent{}
+	now := time.Now().UTC().Format(time.RFC3339)
+	for _, scc := range report.Components {
+		if scc.CyclesTruncated {
+			key := "scc:" + strings.Join(scc.Services, ",")
+			current[key] = scc.Services
+			// Cycles seen while the component was smaller are still there
+			// as far as we can tell.
+			for k, services := range w.known {
+				if !strings.HasPrefix(k, "scc:") && isSubset(services, scc.Services) {
+					current[k] = services
+				}
+			}
+			if _, ok := w.known[key]; !ok {
+				events = append(events, storage.TopologyEvent{
+					DetectedAt: now,
+					Kind:       storage.TopologyKindCycleDetected,
+					Key:        key,
+					Services:   scc.Services,
+					RPS:        scc.InternalRPS,
+					Severity:   "high",
+					Detail: fmt.Sprintf("component of %d services with more than %d cycles; %.2f rps between its members",
+						len(scc.Services), maxCyclesPerComponent, scc.InternalRPS),
+				})
+			}
+			continue
+		}
+		for _, c := range scc.Cycles {
+			services := c.Path[:len(c.Path)-1]
+			current[c.Key] = services
+			if _, ok := w.known[c.Key]; ok {
+				continue
+			}
+			severity := "medium"
+			if c.RPS > 0 {
+				severity = "high"
+			}
+			events = append(events, storage.TopologyEvent{
+				DetectedAt: now,
+				Kind:       storage.TopologyKindCycleDetected,
+				Key:        c.Key,
+				Services:   services,
+				RPS:        c.RPS,
+				Severity:   severity,
+				Detail: fmt.Sprintf("heaviest edge %s -> %s at %.2f rps",
+					c.HeaviestEdge.Source, c.HeaviestEdge.Target, c.HeaviestEdge.RPS),
+			})
+		}
+	}
+	for _, key := range sortedKeysOf(w.known) {
+		if _, ok := current[key]; ok {
+			continue
+		}
+		events = append(events, storage.TopologyEvent{
+			DetectedAt: now,
+			Kind:       storage.TopologyKindCycleResolved,
+			Key:        key,
+			Services:   w.known[key],
+			Severity:   "low",
+			Detail:     "no longer present",
+		})
+	}
+
+	if len(events) > 0 {
+		if w.store == nil {
+			for _, e := range events {
+				log.Printf("[CycleWatcher] %s: %s (%.2f rps)", e.Kind, e.Key, e.RPS)
+			}
+		} else if err := w.store.SaveTopologyEvents(events); err != nil {
+			return nil, err
+		}
+	}
+	w.known = current
+	return events, nil
+}
+
+// seed recalls the cycles present before a restart: those whose latest
+// stored event is a detection rather than a resolution.
+func (w *CycleWatcher) seed() error {
+	stored, err := w.store.ListTopologyEvents(storage.TopologyEventFilter{
+		Kinds: []string{storage.TopologyKindCycleDetected, storage.TopologyKindCycleResolved},
+		Limit: seedEventLimit,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load known cycles: %w", err)
+	}
+	latest := map[string]bool{}
+	for _, e := range stored {
+		if latest[e.Key] {
+			continue
+		}
+		latest[e.Key] = true
+		if e.Kind == storage.TopologyKindCycleDetected {
+			w.known[e.Key] = e.Services
+		}
+	}
+	return nil
+}
+
+func isSubset(items, set []string) bool {
+	for _, it := range items {
+		if !slices.Contains(set, it) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeysOf(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,243 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/common"
+)
+
+// SnapshotSource is the subset of the Graph Engine API the whole-graph
+// analyses read from. It is satisfied by *graph.Client.
+type SnapshotSource interface {
+	GetMetricsSnapshot(ctx context.Context) (*graph.MetricsSnapshotResponse, error)
+}
+
+// NeighborhoodSource reads the k-hop neighborhood of a service, which unlike
+// the metrics snapshot carries p50 and p99 edge latencies. It is satisfied
+// by *graph.Client.
+type NeighborhoodSource interface {
+	GetNeighborhood(ctx context.Context, serviceName string, k int) (*graph.NeighborhoodResponse, error)
+}
+
+// DependencyNode is a service in the dependency graph, keyed by canonical
+// "namespace:name" ID.
+type DependencyNode struct {
+	ID           string
+	Name         string
+	Namespace    string
+	Availability float64
+	PodCount     int
+}
+
+// DependencyEdge is a call from Source to Target. P50 and P99 are only set
+// on graphs built from a neighborhood.
+type DependencyEdge struct {
+	Source    string
+	Target    string
+	RPS       float64
+	ErrorRate float64
+	P50       float64
+	P95       float64
+	P99       float64
+}
+
+// DependencyGraph is the whole service graph from the Graph Engine's metrics
+// snapshot. Outgoing edges are sorted by target ID so traversals are
+// deterministic.
+type DependencyGraph struct {
+	Nodes    map[string]*DependencyNode
+	Outgoing map[string][]DependencyEdge
+	Incoming map[string][]DependencyEdge
+}
+
+// LoadDependencyGraph fetches the metrics snapshot and builds the graph.
+func LoadDependencyGraph(ctx context.Context, client SnapshotSource) (*DependencyGraph, error) {
+	snapshot, err := client.GetMetricsSnapshot(ctx)
+	if err != nil {
+		return nil, common.WrapError(common.CodeUpstreamUnavailable, err, "Failed to fetch graph snapshot from Graph Engine")
+	}
+	return BuildDependencyGraph(snapshot), nil
+}
+
+// BuildDependencyGraph builds the graph of a metrics snapshot, resolving
+// edge namespaces the same way the dependency graph snapshot does. Edge
+// endpoints missing from the service list become nodes without metrics.
+func BuildDependencyGraph(snapshot *graph.MetricsSnapshotResponse) *DependencyGraph {
+	g := &DependencyGraph{
+		Nodes:    map[string]*DependencyNode{},
+		Outgoing: map[string][]DependencyEdge{},
+		Incoming: map[string][]DependencyEdge{},
+	}
+	namespaces := map[string]string{}
+	for _, svc := range snapshot.Services {
+		ns := svc.Namespace
+		if ns == "" {
+			ns = "default"
+		}
+		namespaces[svc.Name] = ns
+		id := fmt.Sprintf("%s:%s", ns, svc.Name)
+		g.Nodes[id] = &DependencyNode{
+			ID:           id,
+			Name:         svc.Name,
+			Namespace:    ns,
+			Availability: svc.Availability.Value,
+			PodCount:     svc.PodCount.Value,
+		}
+	}
+
+	for _, e := range snapshot.Edges {
+		fromNs, ok := namespaces[e.From]
+		if !ok {
+			fromNs = "default"
+		}
+		toNs := e.Namespace
+		if toNs == "" {
+			if ns, ok := namespaces[e.To]; ok {
+				toNs = ns
+			} else {
+				toNs = "default"
+			}
+		}
+		edge := DependencyEdge{
+			Source:    g.ensureNode(fromNs, e.From),
+			Target:    g.ensureNode(toNs, e.To),
+			RPS:       e.RPS,
+			ErrorRate: e.ErrorRate,
+			P95:       e.P95,
+		}
+		g.Outgoing[edge.Source] = append(g.Outgoing[edge.Source], edge)
+		g.Incoming[edge.Target] = append(g.Incoming[edge.Target], edge)
+	}
+	g.sortEdges()
+	return g
+}
+
+// LoadNeighborhoodGraph builds the graph of the k-hop neighborhood of
+// serviceID. Edge endpoints are resolved by name against the neighborhood's
+// nodes and otherwise placed in the default namespace.
+func LoadNeighborhoodGraph(ctx context.Context, client NeighborhoodSource, serviceID string, k int) (*DependencyGraph, error) {
+	resp, err := client.GetNeighborhood(ctx, serviceID, k)
+	if errors.Is(err, graph.ErrNotFound) {
+		return nil, common.NewError(common.CodeServiceNotFound, "Service not found: %s", serviceID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	g := &DependencyGraph{
+		Nodes:    map[string]*DependencyNode{},
+		Outgoing: map[string][]DependencyEdge{},
+		Incoming: map[string][]DependencyEdge{},
+	}
+	ids := map[string]string{}
+	for _, n := range resp.Nodes {
+		ns := n.Namespace
+		if ns == "" {
+			ns = "default"
+		}
+		id := fmt.Sprintf("%s:%s", ns, n.Name)
+		g.Nodes[id] = &DependencyNode{ID: id, Name: n.Name, Namespace: ns, Availability: n.Availability, PodCount: n.PodCount}
+		ids[n.Name] = id
+		ids[id] = id
+	}
+	resolve := func(ref string) string {
+		if id, ok := ids[ref]; ok {
+			return id
+		}
+		if strings.Contains(ref, ":") {
+			ns, name, _ := strings.Cut(ref, ":")
+			return g.ensureNode(ns, name)
+		}
+		return g.ensureNode("default", ref)
+	}
+	for _, e := range resp.Edges {
+		edge := DependencyEdge{
+			Source:    resolve(e.From),
+			Target:    resolve(e.To),
+			RPS:       e.Rate,
+			ErrorRate: e.ErrorRate,
+			P50:       e.P50,
+			P95:       e.P95,
+			P99:       e.P99,
+		}
+		g.Outgoing[edge.Source] = append(g.Outgoing[edge.Source], edge)
+		g.Incoming[edge.Target] = append(g.Incoming[edge.Target], edge)
+	}
+	g.sortEdges()
+	return g, nil
+}
+
+func (g *DependencyGraph) sortEdges() {
+	for _, edges := range g.Outgoing {
+		sort.Slice(edges, func(i, j int) bool { return edges[i].Target < edges[j].Target })
+	}
+	for _, edges := range g.Incoming {
+		sort.Slice(edges, func(i, j int) bool { return edges[i].Source < edges[j].Source })
+	}
+}
+
+func (g *DependencyGraph) ensureNode(namespace, name string) string {
+	id := fmt.Sprintf("%s:%s", namespace, name)
+	if _, ok := g.Nodes[id]; !ok {
+		g.Nodes[id] = &DependencyNode{ID: id, Name: name, Namespace: namespace}
+	}
+	return id
+}
+
+// NodeIDs returns the IDs of all nodes in sorted order.
+func (g *DependencyGraph) NodeIDs() []string {
+	ids := make([]string, 0, len(g.Nodes))
+	for id := range g.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Reachable returns the set of nodes reachable from source along outgoing
+// edges, including source.
+func (g *DependencyGraph) Reachable(source string) map[string]bool {
+	seen := map[string]bool{source: true}
+	stack := []string{source}
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, e := range g.Outgoing[id] {
+			if !seen[e.Target] {
+				seen[e.Target] = true
+				stack = append(stack, e.Target)
+			}
+		}
+	}
+	return seen
+}
+
+// ShortestPath returns the fewest-hop path from source to target along
+// outgoing edges, or nil when target is unreachable.
+func (g *DependencyGraph) ShortestPath(source, target string) []string {
+	prev := map[string]string{source: ""}
+	queue := []string{source}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == target {
+			var path []string
+			for at := target; at != ""; at = prev[at] {
+				path = append([]string{at}, path...)
+			}
+			return path
+		}
+		for _, e := range g.Outgoing[id] {
+			if _, seen := prev[e.Target]; !seen {
+				prev[e.Target] = id
+				queue = append(queue, e.Target)
+			}
+		}
+	}
+	return nil
+}
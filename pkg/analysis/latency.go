@@ -0,0 +1,289 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"predictive-analysis-engine/pkg/common"
+)
+
+const (
+	ActionScale    = "scale"
+	ActionOptimize = "optimize"
+)
+
+// tailRatioForScaling is the p99/p50 ratio above which a hop's latency is
+// treated as queueing, which more replicas relieve, rather than work done
+// on every request, which only optimization reduces.
+const tailRatioForScaling = 3.0
+
+// LatencyMetrics lists the percentiles the decomposition covers.
+func LatencyMetrics() []string {
+	return []string{"p50", "p95", "p99"}
+}
+
+// LatencyHop is one service on a critical path. EdgeLatencyMs is the
+// latency its caller observes when calling it; SelfMs is the part of that
+// not explained by the next hop.
+type LatencyHop struct {
+	ServiceID     string   `json:"serviceId"`
+	EdgeLatencyMs *float64 `json:"edgeLatencyMs"`
+	SelfMs        float64  `json:"selfMs"`
+	Share         float64  `json:"share"`
+	CallRate      float64  `json:"callRate"`
+}
+
+// CriticalPath follows the slowest downstream call at each hop, assuming a
+// service's calls run in parallel so the slowest bounds its latency.
+// ServiceLatencyMs is the rate-weighted latency of the service's incoming
+// calls, when it has callers in the neighborhood.
+type CriticalPath struct {
+	Metric           string       `json:"metric"`
+	ServiceLatencyMs *float64     `json:"serviceLatencyMs"`
+	DownstreamMs     float64      `json:"downstreamMs"`
+	SelfMs           *float64     `json:"selfMs"`
+	Path             []string     `json:"path"`
+	Hops             []LatencyHop `json:"hops"`
+}
+
+// DownstreamCall is one direct outgoing call of the analyzed service.
+type DownstreamCall struct {
+	ServiceID      string   `json:"serviceId"`
+	CallRate       float64  `json:"callRate"`
+	P50            float64  `json:"p50"`
+	P95            float64  `json:"p95"`
+	P99            float64  `json:"p99"`
+	OnCriticalPath []string `json:"onCriticalPath"`
+}
+
+// OptimizationCandidate is a service on a critical path ranked by the p95
+// time spent in it. Action is "scale" when its tail is much slower than its
+// median, and "optimize" otherwise.
+type OptimizationCandidate struct {
+	ServiceID string  `json:"serviceId"`
+	SelfP95Ms float64 `json:"selfP95Ms"`
+	TailRatio float64 `json:"tailRatio,omitempty"`
+	PodCount  int     `json:"podCount,omitempty"`
+	Action    string  `json:"action"`
+	Reason    string  `json:"reason"`
+}
+
+type LatencyAnalysis struct {
+	ServiceID       string                  `json:"serviceId"`
+	Depth           int                     `json:"depth"`
+	DownstreamCalls []DownstreamCall        `json:"downstreamCalls"`
+	CriticalPaths   []CriticalPath          `json:"criticalPaths"`
+	Candidates      []OptimizationCandidate `json:"candidates"`
+	Warnings        []string                `json:"warnings"`
+	GeneratedAt     string                  `json:"generatedAt"`
+}
+
+// DecomposeLatency attributes the latency of serviceID to its downstream
+// calls within depth hops, per percentile. Percentiles do not add exactly,
+// so the decomposition is an approximation.
+func DecomposeLatency(ctx context.Context, client NeighborhoodSource, serviceID string, depth int) (*LatencyAnalysis, error) {
+	g, err := LoadNeighborhoodGraph(ctx, client, serviceID, depth)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := g.Nodes[serviceID]; !ok {
+		return nil, common.NewError(common.CodeServiceNotFound, "Service not found: %s", serviceID)
+	}
+
+	result := &LatencyAnalysis{
+		ServiceID:       serviceID,
+		Depth:           depth,
+		DownstreamCalls: []DownstreamCall{},
+		CriticalPaths:   []CriticalPath{},
+		Candidates:      []OptimizationCandidate{},
+		Warnings:        []string{},
+		GeneratedAt:     time.Now().Format(time.RFC3339),
+	}
+	if len(g.Outgoing[serviceID]) == 0 {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("%s has no downstream calls in the neighborhood; its latency is all its own.", serviceID))
+	}
+
+	clamped := 0
+	for _, metric := range LatencyMetrics() {
+		cp, n := criticalPath(g, serviceID, metric, depth)
+		clamped += n
+		result.CriticalPaths = append(result.CriticalPaths, cp)
+	}
+	if clamped > 0 {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("%d hop(s) reported a higher latency than the call they are made within; they were capped at their caller's latency.", clamped))
+	}
+
+	for _, e := range g.Outgoing[serviceID] {
+		if e.Target == serviceID {
+			continue
+		}
+		call := DownstreamCall{ServiceID: e.Target, CallRate: e.RPS, P50: e.P50, P95: e.P95, P99: e.P99, OnCriticalPath: []string{}}
+		for _, cp := range result.CriticalPaths {
+			if len(cp.Path) > 1 && cp.Path[1] == e.Target {
+				call.OnCriticalPath = append(call.OnCriticalPath, cp.Metric)
+			}
+		}
+		result.DownstreamCalls = append(result.DownstreamCalls, call)
+	}
+	sort.Slice(result.DownstreamCalls, func(i, j int) bool {
+		return result.DownstreamCalls[i].P95 > result.DownstreamCalls[j].P95
+	})
+
+	result.Candidates = optimizationCandidates(g, result.CriticalPaths)
+	return result, nil
+}
+
+// criticalPath walks the slowest outgoing edge by metric from serviceID for
+// at most depth hops, and returns the path with the number of hops whose
+// latency exceeded their caller's.
+func criticalPath(g *DependencyGraph, serviceID, metric string, depth int) (CriticalPath, int) {
+	cp := CriticalPath{Metric: metric, Path: []string{serviceID}, Hops: []LatencyHop{}}
+	if in := weightedIncomingLatency(g, serviceID, metric); in != nil {
+		cp.ServiceLatencyMs = in
+	}
+
+	visited := map[string]bool{serviceID: true}
+	var edges []DependencyEdge
+	for at := serviceID; len(edges) < depth; {
+		var next *DependencyEdge
+		for i, e := range g.Outgoing[at] {
+			if visited[e.Target] {
+				continue
+			}
+			if next == nil || edgeLatency(e, metric) > edgeLatency(*next, metric) {
+				next = &g.Outgoing[at][i]
+			}
+		}
+		if next == nil {
+			break
+		}
+		edges = append(edges, *next)
+		visited[next.Target] = true
+		cp.Path = append(cp.Path, next.Target)
+		at = next.Target
+	}
+
+	// A call cannot take longer than the call it is made within, so each
+	// hop is capped at its caller's latency and the self times add up to
+	// the first hop's latency.
+	clamped := 0
+	capped := make([]float64, len(edges))
+	for i, e := range edges {
+		capped[i] = edgeLatency(e, metric)
+		if i > 0 && capped[i] > capped[i-1] {
+			capped[i] = capped[i-1]
+			clamped++
+		}
+	}
+	if len(edges) > 0 {
+		cp.DownstreamMs = capped[0]
+	}
+	for i, e := range edges {
+		lat := edgeLatency(e, metric)
+		self := capped[i]
+		if i+1 < len(edges) {
+			self -= capped[i+1]
+		}
+		hop := LatencyHop{ServiceID: e.Target, EdgeLatencyMs: &lat, SelfMs: self, CallRate: e.RPS}
+		if cp.DownstreamMs > 0 {
+			hop.Share = self / cp.DownstreamMs
+		}
+		cp.Hops = append(cp.Hops, hop)
+	}
+
+	if cp.ServiceLatencyMs != nil {
+		self := math.Max(*cp.ServiceLatencyMs-cp.DownstreamMs, 0)
+		cp.SelfMs = &self
+	}
+	return cp, clamped
+}
+
+func optimizationCandidates(g *DependencyGraph, paths []CriticalPath) []OptimizationCandidate {
+	var p95 *CriticalPath
+	for i := range paths {
+		if paths[i].Metric == "p95" {
+			p95 = &paths[i]
+		}
+	}
+	candidates := []OptimizationCandidate{}
+	if p95 == nil {
+		return candidates
+	}
+
+	for i, hop := range p95.Hops {
+		if hop.SelfMs <= 0 {
+			continue
+		}
+		c := OptimizationCandidate{ServiceID: hop.ServiceID, SelfP95Ms: hop.SelfMs, Action: ActionOptimize}
+		if node := g.Nodes[hop.ServiceID]; node != nil {
+			c.PodCount = node.PodCount
+		}
+		// The hop's p50 and p99 self times come from the same edges, so the
+		// ratio reflects this service rather than the rest of the path.
+		caller := p95.Path[i]
+		var next string
+		if i+1 < len(p95.Hops) {
+			next = p95.Hops[i+1].ServiceID
+		}
+		p50 := selfLatency(g, caller, hop.ServiceID, next, "p50")
+		p99 := selfLatency(g, caller, hop.ServiceID, next, "p99")
+		if p50 > 0 {
+			c.TailRatio = p99 / p50
+		}
+		if c.TailRatio >= tailRatioForScaling {
+			c.Action = ActionScale
+			c.Reason = fmt.Sprintf("p99 is %.1fx p50 within %s, which points to queueing that more replicas would relieve", c.TailRatio, hop.ServiceID)
+		} else {
+			c.Reason = fmt.Sprintf("%s adds %.1fms at p95 even at a steady tail, so the time is spent doing work on each request", hop.ServiceID, hop.SelfMs)
+		}
+		candidates = append(candidates, c)
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].SelfP95Ms > candidates[j].SelfP95Ms })
+	return candidates
+}
+
+// selfLatency is the latency of the caller→service edge minus that of the
+// service→next edge, or the whole edge latency when next is empty.
+func selfLatency(g *DependencyGraph, caller, service, next, metric string) float64 {
+	var in, out float64
+	for _, e := range g.Outgoing[caller] {
+		if e.Target == service {
+			in = edgeLatency(e, metric)
+		}
+	}
+	for _, e := range g.Outgoing[service] {
+		if next != "" && e.Target == next {
+			out = edgeLatency(e, metric)
+		}
+	}
+	return math.Max(in-out, 0)
+}
+
+func weightedIncomingLatency(g *DependencyGraph, serviceID, metric string) *float64 {
+	var weighted, total float64
+	for _, e := range g.Incoming[serviceID] {
+		if e.RPS <= 0 || e.Source == serviceID {
+			continue
+		}
+		weighted += e.RPS * edgeLatency(e, metric)
+		total += e.RPS
+	}
+	if total == 0 {
+		return nil
+	}
+	v := weighted / total
+	return &v
+}
+
+func edgeLatency(e DependencyEdge, metric string) float64 {
+	switch metric {
+	case "p50":
+		return e.P50
+	case "p99":
+		return e.P99
+	}
+	return e.P95
+}
@@ -0,0 +1,300 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"predictive-analysis-engine/pkg/common"
+)
+
+const (
+	RankRPS     = "rps"
+	RankLatency = "latency"
+	RankHops    = "hops"
+)
+
+// PathRankings lists the supported path rankings.
+func PathRankings() []string {
+	return []string{RankRPS, RankLatency, RankHops}
+}
+
+// PathHop is one call along a path. Share is the fraction of the caller's
+// outgoing traffic that goes to this target.
+type PathHop struct {
+	Source    string  `json:"source"`
+	Target    string  `json:"target"`
+	RPS       float64 `json:"rps"`
+	ErrorRate float64 `json:"errorRate"`
+	P95Ms     float64 `json:"p95Ms"`
+	Share     float64 `json:"share"`
+}
+
+// RankedPath is one of the k best paths. LatencyMs sums the hop p95s,
+// BottleneckRPS is the rate of the least-used hop and RoutingShare is the
+// product of the hop shares: the fraction of traffic leaving the source that
+// would follow this path if callers route independently. Cost is the value
+// the ranking minimizes.
+type RankedPath struct {
+	Rank          int       `json:"rank"`
+	Path          []string  `json:"path"`
+	Hops          []PathHop `json:"hops"`
+	HopCount      int       `json:"hopCount"`
+	LatencyMs     float64   `json:"latencyMs"`
+	BottleneckRPS float64   `json:"bottleneckRps"`
+	RoutingShare  float64   `json:"routingShare"`
+	Cost          float64   `json:"cost"`
+}
+
+type PathQueryResult struct {
+	From        string       `json:"from"`
+	To          string       `json:"to"`
+	Rank        string       `json:"rank"`
+	K           int          `json:"k"`
+	Paths       []RankedPath `json:"paths"`
+	Warnings    []string     `json:"warnings"`
+	GeneratedAt string       `json:"generatedAt"`
+}
+
+// pathGraph holds one weighted edge per caller and target. Edges whose weight
+// is infinite, such as calls without traffic under the rps ranking, are left
+// out.
+type pathGraph struct {
+	edges  map[string][]weightedEdge
+	lookup map[[2]string]weightedEdge
+}
+
+type weightedEdge struct {
+	DependencyEdge
+	share  float64
+	weight float64
+}
+
+// FindPaths returns up to k loopless paths from one service to another over
+// the snapshot graph with Yen's algorithm, best first. The rps ranking
+// prefers the paths most traffic follows, latency the lowest summed p95 and
+// hops the fewest calls.
+func FindPaths(ctx context.Context, client SnapshotSource, from, to string, k int, rank string) (*PathQueryResult, error) {
+	g, err := LoadDependencyGraph(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range []string{from, to} {
+		if _, ok := g.Nodes[id]; !ok {
+			return nil, common.NewError(common.CodeServiceNotFound, "Service not found: %s", id)
+		}
+	}
+
+	pg := newPathGraph(g, rank)
+	result := &PathQueryResult{
+		From:        from,
+		To:          to,
+		Rank:        rank,
+		K:           k,
+		Paths:       []RankedPath{},
+		Warnings:    []string{},
+		GeneratedAt: time.Now().Format(time.RFC3339),
+	}
+	for i, p := range pg.kShortest(from, to, k) {
+		result.Paths = append(result.Paths, pg.describe(p, i+1))
+	}
+
+	switch {
+	case len(result.Paths) == 0 && rank == RankRPS && g.ShortestPath(from, to) != nil:
+		result.Warnings = append(result.Warnings, fmt.Sprintf("Every path from %s to %s has a call without traffic; rank by hops or latency to see them.", from, to))
+	case len(result.Paths) == 0:
+		result.Warnings = append(result.Warnings, fmt.Sprintf("%s does not call %s, directly or indirectly.", from, to))
+	case len(result.Paths) < k:
+		result.Warnings = append(result.Warnings, fmt.Sprintf("Only %d loopless path(s) exist.", len(result.Paths)))
+	}
+	return result, nil
+}
+
+func newPathGraph(g *DependencyGraph, rank string) *pathGraph {
+	pg := &pathGraph{edges: map[string][]weightedEdge{}, lookup: map[[2]string]weightedEdge{}}
+	for _, id := range g.NodeIDs() {
+		var total float64
+		for _, e := range g.Outgoing[id] {
+			total += e.RPS
+		}
+		for _, e := range g.Outgoing[id] {
+			if e.Target == id {
+				continue
+			}
+			we := weightedEdge{DependencyEdge: e}
+			if total > 0 {
+				we.share = e.RPS / total
+			}
+			switch rank {
+			case RankRPS:
+				// Summing -ln(share) ranks paths by the product of their
+				// shares, i.e. by how much traffic follows them.
+				we.weight = -math.Log(we.share)
+			case RankLatency:
+				we.weight = e.P95
+			default:
+				we.weight = 1
+			}
+			if math.IsInf(we.weight, 1) {
+				continue
+			}
+			key := [2]string{e.Source, e.Target}
+			if prev, ok := pg.lookup[key]; ok && prev.weight <= we.weight {
+				continue
+			}
+			pg.lookup[key] = we
+		}
+	}
+	for key, we := range pg.lookup {
+		pg.edges[key[0]] = append(pg.edges[key[0]], we)
+	}
+	for _, edges := range pg.edges {
+		sort.Slice(edges, func(i, j int) bool { return edges[i].Target < edges[j].Target })
+	}
+	return pg
+}
+
+type candidatePath struct {
+	nodes []string
+	cost  float64
+}
+
+// kShortest is Yen's algorithm: each next path deviates from an accepted
+// one at some spur node, with the edges already used from the same root
+// path and the root's own nodes removed.
+func (pg *pathGraph) kShortest(from, to string, k int) []candidatePath {
+	first, ok := pg.shortest(from, to, nil, nil)
+	if !ok {
+		return nil
+	}
+	accepted := []candidatePath{first}
+	var candidates []candidatePath
+	seen := map[string]bool{strings.Join(first.nodes, ">"): true}
+
+	for len(accepted) < k {
+		last := accepted[len(accepted)-1].nodes
+		for i := 0; i < len(last)-1; i++ {
+			spur := last[i]
+			root := last[:i+1]
+
+			removedEdges := map[[2]string]bool{}
+			for _, p := range accepted {
+				if len(p.nodes) > i+1 && slices.Equal(p.nodes[:i+1], root) {
+					removedEdges[[2]string{p.nodes[i], p.nodes[i+1]}] = true
+				}
+			}
+			removedNodes := map[string]bool{}
+			for _, n := range root[:i] {
+				removedNodes[n] = true
+			}
+
+			spurPath, ok := pg.shortest(spur, to, removedNodes, removedEdges)
+			if !ok {
+				continue
+			}
+			nodes := append(append([]string{}, root[:i]...), spurPath.nodes...)
+			key := strings.Join(nodes, ">")
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			candidates = append(candidates, candidatePath{nodes: nodes, cost: pg.cost(nodes)})
+		}
+		if len(candidates) == 0 {
+			break
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool { return lessPath(candidates[i], candidates[j]) })
+		accepted = append(accepted, candidates[0])
+		candidates = candidates[1:]
+	}
+	return accepted
+}
+
+// shortest is Dijkstra's algorithm from source to target, skipping the
+// removed nodes and edges. Ties go to fewer hops, then to the smaller IDs.
+func (pg *pathGraph) shortest(source, target string, removedNodes map[string]bool, removedEdges map[[2]string]bool) (candidatePath, bool) {
+	best := map[string]candidatePath{source: {nodes: []string{source}}}
+	settled := map[string]bool{}
+	for {
+		var at string
+		for id, p := range best {
+			if settled[id] {
+				continue
+			}
+			if at == "" || lessPath(p, best[at]) {
+				at = id
+			}
+		}
+		if at == "" {
+			return candidatePath{}, false
+		}
+		if at == target {
+			return best[at], true
+		}
+		settled[at] = true
+
+		for _, e := range pg.edges[at] {
+			if removedNodes[e.Target] || removedEdges[[2]string{at, e.Target}] || settled[e.Target] {
+				continue
+			}
+			next := candidatePath{
+				nodes: append(append([]string{}, best[at].nodes...), e.Target),
+				cost:  best[at].cost + e.weight,
+			}
+			if prev, ok := best[e.Target]; !ok || lessPath(next, prev) {
+				best[e.Target] = next
+			}
+		}
+	}
+}
+
+func (pg *pathGraph) cost(nodes []string) float64 {
+	var c float64
+	for i := 0; i < len(nodes)-1; i++ {
+		c += pg.lookup[[2]string{nodes[i], nodes[i+1]}].weight
+	}
+	return c
+}
+
+func (pg *pathGraph) describe(p candidatePath, rank int) RankedPath {
+	rp := RankedPath{
+		Rank:          rank,
+		Path:          p.nodes,
+		Hops:          []PathHop{},
+		HopCount:      len(p.nodes) - 1,
+		BottleneckRPS: math.Inf(1),
+		RoutingShare:  1,
+		Cost:          p.cost,
+	}
+	for i := 0; i < len(p.nodes)-1; i++ {
+		e := pg.lookup[[2]string{p.nodes[i], p.nodes[i+1]}]
+		rp.Hops = append(rp.Hops, PathHop{
+			Source:    e.Source,
+			Target:    e.Target,
+			RPS:       e.RPS,
+			ErrorRate: e.ErrorRate,
+			P95Ms:     e.P95,
+			Share:     e.share,
+		})
+		rp.LatencyMs += e.P95
+		rp.BottleneckRPS = math.Min(rp.BottleneckRPS, e.RPS)
+		rp.RoutingShare *= e.share
+	}
+	return rp
+}
+
+func lessPath(a, b candidatePath) bool {
+	// Costs are sums of floats, so near-equal costs are treated as ties.
+	if math.Abs(a.cost-b.cost) > 1e-9 {
+		return a.cost < b.cost
+	}
+	if len(a.nodes) != len(b.nodes) {
+		return len(a.nodes) < len(b.nodes)
+	}
+	return slices.Compare(a.nodes, b.nodes) < 0
+}
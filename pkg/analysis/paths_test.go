@@ -0,0 +1,77 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestKShortestPaths(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges []string
+		rank  string
+		k     int
+		want  []string
+	}{
+		{
+			name:  "equal costs break ties by hops, then IDs",
+			edges: []string{"a>c", "a>b", "b>d", "c>d", "a>d"},
+			rank:  RankHops,
+			k:     5,
+			want:  []string{"a>d", "a>b>d", "a>c>d"},
+		},
+		{
+			name:  "equal routing shares prefer the shorter path",
+			edges: []string{"a>b:1", "a>d:1", "b>d:1"},
+			rank:  RankRPS,
+			k:     2,
+			want:  []string{"a>d", "a>b>d"},
+		},
+		{
+			name:  "rps ranking follows the traffic",
+			edges: []string{"a>b:9", "a>c:1", "b>d:1", "c>d:1"},
+			rank:  RankRPS,
+			k:     2,
+			want:  []string{"a>b>d", "a>c>d"},
+		},
+		{
+			name:  "k stops the search",
+			edges: []string{"a>b", "a>c", "b>d", "c>d", "a>d"},
+			rank:  RankHops,
+			k:     1,
+			want:  []string{"a>d"},
+		},
+		{
+			name:  "paths are loopless",
+			edges: []string{"a>b", "b>a", "b>d"},
+			rank:  RankHops,
+			k:     3,
+			want:  []string{"a>b>d"},
+		},
+		{
+			name:  "disconnected target",
+			edges: []string{"a>b", "c>d"},
+			rank:  RankHops,
+			k:     3,
+		},
+		{
+			name:  "calls without traffic are unreachable by rps",
+			edges: []string{"a>b:0", "b>d:5"},
+			rank:  RankRPS,
+			k:     3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pg := newPathGraph(testGraph(t, tt.edges...), tt.rank)
+			var got []string
+			for _, p := range pg.kShortest("a", "d", tt.k) {
+				got = append(got, strings.Join(p.nodes, ">"))
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("paths = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
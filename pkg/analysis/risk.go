@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/common"
 )
 
 const (
@@ -16,12 +17,12 @@ const (
 func GetTopRiskServices(ctx context.Context, client *graph.Client, metric string, limit int) (*graph.TopCentralityResponse, error) {
 
 	if metric != "pagerank" && metric != "betweenness" {
-		return nil, fmt.Errorf("Invalid metric: %s. Allowed: pagerank, betweenness", metric)
+		return nil, common.NewError(common.CodeInvalidMetric, "Invalid metric: %s. Allowed: pagerank, betweenness", metric)
 	}
 
 	centralityResult, err := client.GetTopCentrality(ctx, metric, limit)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to fetch centrality data: %w", err)
+		return nil, common.WrapError(common.CodeOf(err), err, "Failed to fetch centrality data")
 	}
 
 	healthResult, err := client.CheckHealth(ctx)
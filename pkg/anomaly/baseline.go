@@ -0,0 +1,79 @@
+// Package anomaly flags service and edge metrics that deviate from rolling
+// baselines built from service_metrics and edge_metrics.
+package anomaly
+
+import (
+	"math"
+	"sort"
+)
+
+// madScale makes the median absolute deviation comparable to a standard
+// deviation for normally distributed values.
+const madScale = 1.4826
+
+// ewma tracks an exponentially weighted mean and variance.
+type ewma struct {
+	alpha    float64
+	mean     float64
+	variance float64
+	n        int
+}
+
+func (e *ewma) update(x float64) {
+	if e.n == 0 {
+		e.mean = x
+	} else {
+		diff := x - e.mean
+		incr := e.alpha * diff
+		e.mean += incr
+		e.variance = (1 - e.alpha) * (e.variance + diff*incr)
+	}
+	e.n++
+}
+
+func (e *ewma) stddev() float64 {
+	return math.Sqrt(e.variance)
+}
+
+// seasonal keeps the most recent values seen in each hour of the day, so a
+// value is compared with the same hour on previous days.
+type seasonal struct {
+	capacity int
+	buckets  [24][]float64
+	next     [24]int
+}
+
+func (s *seasonal) add(hour int, x float64) {
+	b := s.buckets[hour]
+	if len(b) < s.capacity {
+		s.buckets[hour] = append(b, x)
+		return
+	}
+	b[s.next[hour]] = x
+	s.next[hour] = (s.next[hour] + 1) % s.capacity
+}
+
+// stats returns the median and median absolute deviation of an hour's
+// values and how many values they were computed from.
+func (s *seasonal) stats(hour int) (median, mad float64, n int) {
+	b := s.buckets[hour]
+	if len(b) == 0 {
+		return 0, 0, 0
+	}
+	median = medianOf(b)
+	dev := make([]float64, len(b))
+	for i, v := range b {
+		dev[i] = math.Abs(v - median)
+	}
+	return median, medianOf(dev), len(b)
+}
+
+func medianOf(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
@@ -0,0 +1,383 @@
+package anomaly
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"predictive-analysis-engine/pkg/clients/telemetry"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+const (
+	MetricRPS       = "rps"
+	MetricErrorRate = "error_rate"
+	MetricP95       = "p95"
+)
+
+const (
+	SeverityLow      = "low"
+	SeverityMedium   = "medium"
+	SeverityHigh     = "high"
+	SeverityCritical = "critical"
+)
+
+const (
+	MethodEWMA     = "ewma"
+	MethodSeasonal = "seasonal"
+)
+
+const maxWarmup = 7 * 24 * time.Hour
+
+// minScale keeps near-constant series from turning tiny absolute changes
+// into large scores: 1 rps, one percentage point of errors, 5ms of p95.
+var minScale = map[string]float64{
+	MetricRPS:       1,
+	MetricErrorRate: 0.01,
+	MetricP95:       5,
+}
+
+// Metrics lists the metrics the detector scores.
+func Metrics() []string {
+	return []string{MetricRPS, MetricErrorRate, MetricP95}
+}
+
+func Severities() []string {
+	return []string{SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical}
+}
+
+// AnomalySink records detected anomalies. It is satisfied by
+// storage.AnomalyStore.
+type AnomalySink interface {
+	SaveAnomalies(events []storage.AnomalyEvent) error
+}
+
+// MetricsSource is the subset of the telemetry client the detector reads
+// from.
+type MetricsSource interface {
+	CheckStatus() (bool, string)
+	GetServiceMetrics(ctx context.Context, service string, from, to string, stepSeconds int) ([]telemetry.ServiceMetric, error)
+	GetEdgeMetrics(ctx context.Context, fromSvc, toSvc, from, to string, stepSeconds int) ([]telemetry.EdgeMetric, error)
+}
+
+// RunResult describes one detection pass. Warmup is set on the first pass,
+// which only builds baselines and reports no anomalies.
+type RunResult struct {
+	From      string                 `json:"from"`
+	To        string                 `json:"to"`
+	Warmup    bool                   `json:"warmup"`
+	Series    int                    `json:"series"`
+	Anomalies []storage.AnomalyEvent `json:"anomalies"`
+}
+
+type seriesKey struct {
+	kind      string
+	serviceID string
+	targetID  string
+	metric    string
+}
+
+type series struct {
+	ewma     ewma
+	seasonal seasonal
+	// active is the severity rank of the ongoing anomaly, 0 when the series
+	// is within its baseline.
+	active   int
+	lastSeen time.Time
+}
+
+func (s *series) clone() *series {
+	c := *s
+	for h, b := range s.seasonal.buckets {
+		c.seasonal.buckets[h] = append([]float64(nil), b...)
+	}
+	return &c
+}
+
+type sample struct {
+	at        time.Time
+	kind      string
+	serviceID string
+	targetID  string
+	rps       float64
+	errorRate float64
+	p95       float64
+}
+
+type metricValue struct {
+	metric string
+	value  float64
+}
+
+type score struct {
+	value    float64
+	expected float64
+	method   string
+}
+
+// Detector keeps per-series baselines in memory and scores the metrics
+// recorded since its previous run. A series reports an anomaly when it
+// leaves its baseline and again each time the severity escalates, not on
+// every anomalous sample.
+type Detector struct {
+	cfg       config.AnomalyConfig
+	store     AnomalySink
+	telemetry MetricsSource
+
+	mu     sync.Mutex
+	series map[seriesKey]*series
+	until  time.Time
+}
+
+func NewDetector(cfg config.AnomalyConfig, store AnomalySink, ts MetricsSource) *Detector {
+	return &Detector{cfg: cfg, store: store, telemetry: ts, series: map[seriesKey]*series{}}
+}
+
+// Run scores the buckets completed since the previous run and stores the
+// anomalies found. The first run reads WarmupHours of history, capped at 7
+// days, to build the baselines. Baselines and the processed window only
+// advance once the anomalies are saved, so a failed save is retried by the
+// next run.
+func (d *Detector) Run(ctx context.Context) (*RunResult, error) {
+	if d.store == nil {
+		return nil, common.NewError(common.CodeDecisionStoreUnavailable, "Decision store not available. Check DECISION_STORE_DRIVER configuration.")
+	}
+	if ok, reason := d.telemetry.CheckStatus(); !ok {
+		return nil, common.NewError(common.CodeTelemetryUnavailable, "%s", reason)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	stepSeconds := d.cfg.StepSeconds
+	if stepSeconds <= 0 {
+		stepSeconds = 60
+	}
+	step := time.Duration(stepSeconds) * time.Second
+	// Only completed buckets are scored; the current one is still filling.
+	to := time.Now().UTC().Truncate(step)
+	warmup := d.until.IsZero()
+	from := d.until
+	if warmup {
+		from = to.Add(-d.baselineWindow())
+	}
+
+	result := &RunResult{
+		From:      from.Format(time.RFC3339),
+		To:        to.Format(time.RFC3339),
+		Warmup:    warmup,
+		Anomalies: []storage.AnomalyEvent{},
+	}
+	if !to.After(from) {
+		result.Series = len(d.series)
+		return result, nil
+	}
+
+	samples, err := d.fetch(ctx, result.From, result.To, stepSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	detectedAt := time.Now().UTC().Format(time.RFC3339)
+	staged := map[seriesKey]*series{}
+	for _, smp := range samples {
+		for _, e := range d.observe(staged, smp) {
+			if !warmup {
+				e.DetectedAt = detectedAt
+				result.Anomalies = append(result.Anomalies, e)
+			}
+		}
+	}
+
+	if err := d.store.SaveAnomalies(result.Anomalies); err != nil {
+		return nil, err
+	}
+	d.commit(staged, to)
+	result.Series = len(d.series)
+	return result, nil
+}
+
+// baselineWindow is how much history the baselines are built from:
+// WarmupHours, capped at 7 days.
+func (d *Detector) baselineWindow() time.Duration {
+	window := time.Duration(d.cfg.WarmupHours) * time.Hour
+	if window <= 0 || window > maxWarmup {
+		window = maxWarmup
+	}
+	return window
+}
+
+// commit replaces the baselines with the ones updated by a run ending at
+// to, and drops series that have not reported within the baseline window.
+func (d *Detector) commit(staged map[seriesKey]*series, to time.Time) {
+	for key, s := range staged {
+		d.series[key] = s
+	}
+	cutoff := to.Add(-d.baselineWindow())
+	for key, s := range d.series {
+		if s.lastSeen.Before(cutoff) {
+			delete(d.series, key)
+		}
+	}
+	d.until = to
+}
+
+func (d *Detector) fetch(ctx context.Context, from, to string, stepSeconds int) ([]sample, error) {
+	services, err := d.telemetry.GetServiceMetrics(ctx, "", from, to, stepSeconds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeUpstreamError, err, "Service metrics query failed")
+	}
+	edges, err := d.telemetry.GetEdgeMetrics(ctx, "", "", from, to, stepSeconds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeUpstreamError, err, "Edge metrics query failed")
+	}
+
+	samples := make([]sample, 0, len(services)+len(edges))
+	for _, m := range services {
+		at, err := time.Parse(time.RFC3339, m.Timestamp)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, sample{
+			at:        at.UTC(),
+			kind:      storage.AnomalyKindService,
+			serviceID: simulation.CanonicalServiceId(m.Namespace + ":" + m.Service),
+			rps:       m.RequestRate,
+			errorRate: m.ErrorRate,
+			p95:       m.P95,
+		})
+	}
+	for _, m := range edges {
+		at, err := time.Parse(time.RFC3339, m.Timestamp)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, sample{
+			at:        at.UTC(),
+			kind:      storage.AnomalyKindEdge,
+			serviceID: simulation.CanonicalServiceId(m.Namespace + ":" + m.From),
+			targetID:  simulation.CanonicalServiceId(m.Namespace + ":" + m.To),
+			rps:       m.RequestRate,
+			errorRate: m.ErrorRate,
+			p95:       m.P95,
+		})
+	}
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].at.Before(samples[j].at) })
+	return samples, nil
+}
+
+// observe scores one sample against the baselines of its series, then adds
+// it to them. Updated series go to staged, copied from the committed ones on
+// first use. Error rate and p95 are only meaningful while the series serves
+// traffic, and only increases in them are reported.
+func (d *Detector) observe(staged map[seriesKey]*series, smp sample) []storage.AnomalyEvent {
+	values := []metricValue{{MetricRPS, smp.rps}}
+	if smp.rps > 0 {
+		values = append(values, metricValue{MetricErrorRate, smp.errorRate}, metricValue{MetricP95, smp.p95})
+	}
+
+	hour := smp.at.Hour()
+	var events []storage.AnomalyEvent
+	for _, v := range values {
+		key := seriesKey{kind: smp.kind, serviceID: smp.serviceID, targetID: smp.targetID, metric: v.metric}
+		s := staged[key]
+		if s == nil {
+			if committed := d.series[key]; committed != nil {
+				s = committed.clone()
+			} else {
+				s = &series{ewma: ewma{alpha: d.cfg.EWMAAlpha}, seasonal: seasonal{capacity: d.seasonalCapacity()}}
+			}
+			staged[key] = s
+		}
+
+		sc, ok := d.score(s, v.metric, v.value, hour)
+		anomalous := ok && math.Abs(sc.value) >= d.cfg.Threshold && (v.metric == MetricRPS || sc.value > 0)
+		if !anomalous {
+			s.active = 0
+		} else if rank := severityRank(math.Abs(sc.value), d.cfg.Threshold); rank > s.active {
+			s.active = rank
+			direction := "above"
+			if sc.value < 0 {
+				direction = "below"
+			}
+			events = append(events, storage.AnomalyEvent{
+				ObservedAt:     smp.at.Format(time.RFC3339),
+				Kind:           key.kind,
+				ServiceID:      key.serviceID,
+				TargetID:       key.targetID,
+				Metric:         key.metric,
+				Value:          v.value,
+				Expected:       sc.expected,
+				Direction:      direction,
+				DeviationScore: math.Round(math.Abs(sc.value)*100) / 100,
+				Severity:       Severities()[rank-1],
+				Method:         sc.method,
+			})
+		}
+
+		s.ewma.update(v.value)
+		s.seasonal.add(hour, v.value)
+		s.lastSeen = smp.at
+	}
+	return events
+}
+
+// score compares x with both baselines once they hold MinSamples values.
+// When both are ready the smaller deviation wins, so a daily peak that the
+// EWMA has not seen yet, or a level shift the seasonal baseline lags
+// behind, is not reported on its own.
+func (d *Detector) score(s *series, metric string, x float64, hour int) (score, bool) {
+	floor := minScale[metric]
+	var candidates []score
+	if s.ewma.n >= d.cfg.MinSamples {
+		candidates = append(candidates, score{
+			value:    (x - s.ewma.mean) / math.Max(s.ewma.stddev(), floor),
+			expected: s.ewma.mean,
+			method:   MethodEWMA,
+		})
+	}
+	if median, mad, n := s.seasonal.stats(hour); n > 0 && n >= d.cfg.MinSamples {
+		candidates = append(candidates, score{
+			value:    (x - median) / math.Max(madScale*mad, floor),
+			expected: median,
+			method:   MethodSeasonal,
+		})
+	}
+	if len(candidates) == 0 {
+		return score{}, false
+	}
+	best := candidates[0]
+	for _, c := range candidates[1:] {
+		if math.Abs(c.value) < math.Abs(best.value) {
+			best = c
+		}
+	}
+	return best, true
+}
+
+func (d *Detector) seasonalCapacity() int {
+	if d.cfg.SeasonalSamples <= 0 {
+		return 120
+	}
+	return d.cfg.SeasonalSamples
+}
+
+// severityRank maps a deviation score to 1 (low) through 4 (critical) at
+// 1x, 1.5x, 2x and 3x the threshold.
+func severityRank(score, threshold float64) int {
+	switch {
+	case score >= 3*threshold:
+		return 4
+	case score >= 2*threshold:
+		return 3
+	case score >= 1.5*threshold:
+		return 2
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"predictive-analysis-engine/pkg/analysis"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/simulation"
+)
+
+const (
+	defaultContributorLimit = 10
+	maxContributorLimit     = 100
+	defaultPathCount        = 3
+	maxPathCount            = 10
+)
+
+// AvailabilityHandler godoc
+// @Summary Composite Availability
+// @Description Composes the availability of a service across everything it transitively calls. Distinct dependencies are in series and multiply; instances of a dependency with the same name in other namespaces, and declared alternatives called by the same caller, are in parallel. Each service's reported availability is used as is unless replicaRedundancy is set. Contributors are ranked by how much the achievable availability would rise if they never failed.
+// @Tags analysis
+// @Produce json
+// @Param serviceId path string true "Service ID (namespace:name, or name in the default namespace)"
+// @Param limit query int false "Maximum number of contributors, at most 100" default(10)
+// @Param replicaRedundancy query bool false "Treat reported availability as per replica, with replicas failing independently" default(false)
+// @Param alternatives query []string false "Comma-separated group of interchangeable services; repeat for more groups" collectionFormat(multi)
+// @Success 200 {object} analysis.AvailabilityAnalysis
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /v2/analysis/availability/{serviceId} [get]
+func (h *Handler) AvailabilityHandler(w http.ResponseWriter, r *http.Request) {
+	limit, err := contributorLimit(r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	opts := analysis.AvailabilityOptions{Limit: limit}
+	if v := r.URL.Query().Get("replicaRedundancy"); v != "" {
+		opts.ReplicaRedundancy, err = strconv.ParseBool(v)
+		if err != nil {
+			respondError(w, r, common.NewError(common.CodeInvalidParameter, "replicaRedundancy must be true or false"))
+			return
+		}
+	}
+	for _, v := range r.URL.Query()["alternatives"] {
+		var group []string
+		for _, id := range splitList(v) {
+			group = append(group, simulation.CanonicalServiceId(id))
+		}
+		opts.Alternatives = append(opts.Alternatives, group)
+	}
+
+	serviceID := simulation.CanonicalServiceId(chi.URLParam(r, "serviceId"))
+	result, err := analysis.ComposeAvailability(r.Context(), h.GraphClient, serviceID, opts)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, result)
+}
+
+// LatencyHandler godoc
+// @Summary Latency Decomposition
+// @Description Attributes a service's p50, p95 and p99 to its downstream calls within the neighborhood. Each critical path follows the slowest call at every hop; a hop's self time is its edge latency minus the next hop's. Candidates are the services on the p95 critical path ranked by self time, marked "scale" when their tail is at least 3x their median and "optimize" otherwise.
+// @Tags analysis
+// @Produce json
+// @Param serviceId path string true "Service ID (namespace:name, or name in the default namespace)"
+// @Param depth query int false "Hops to follow downstream (1-3); defaults to MAX_TRAVERSAL_DEPTH"
+// @Success 200 {object} analysis.LatencyAnalysis
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 504 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /v2/analysis/latency/{serviceId} [get]
+func (h *Handler) LatencyHandler(w http.ResponseWriter, r *http.Request) {
+	depth := h.Config.Simulation.MaxTraversalDepth
+	if v := r.URL.Query().Get("depth"); v != "" {
+		d, err := strconv.Atoi(v)
+		if err != nil {
+			respondError(w, r, common.NewError(common.CodeInvalidParameter, "depth must be an integer"))
+			return
+		}
+		depth = d
+	}
+	if depth < 1 || depth > 3 {
+		respondError(w, r, common.NewError(common.CodeDepthOutOfRange, "depth must be integer 1, 2, or 3. Got: %d", depth))
+		return
+	}
+
+	serviceID := simulation.CanonicalServiceId(chi.URLParam(r, "serviceId"))
+	result, err := analysis.DecomposeLatency(r.Context(), h.GraphClient, serviceID, depth)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, result)
+}
+
+// CyclesHandler godoc
+// @Summary Dependency Cycles
+// @Description Runs Tarjan's strongly connected components algorithm over the full dependency graph and reports every group of services that can reach each other. Each cycle is listed with the traffic that can flow all the way around it (its least-used edge) and the edge carrying the most; a failure anywhere in a cycle can feed back into itself.
+// @Tags analysis
+// @Produce json
+// @Success 200 {object} analysis.CycleReport
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /v2/analysis/cycles [get]
+func (h *Handler) CyclesHandler(w http.ResponseWriter, r *http.Request) {
+	result, err := analysis.FindCycles(r.Context(), h.GraphClient)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, result)
+}
+
+// PathsHandler godoc
+// @Summary K Best Paths Between Services
+// @Description Returns up to k loopless call paths from one service to another over the dependency graph snapshot, found with Yen's k-shortest paths. rank=rps prefers the paths the most traffic follows (the product of each caller's share of traffic sent to the next hop), rank=latency the lowest summed p95 and rank=hops the fewest calls. Each hop carries its rate, error rate, p95 and share.
+// @Tags analysis
+// @Produce json
+// @Param from query string true "Source service ID (namespace:name, or name in the default namespace)"
+// @Param to query string true "Target service ID (namespace:name, or name in the default namespace)"
+// @Param k query int false "Number of paths, at most 10" default(3)
+// @Param rank query string false "Ranking: rps, latency or hops" default(hops)
+// @Success 200 {object} analysis.PathQueryResult
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /v2/paths [get]
+func (h *Handler) PathsHandler(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("from") == "" || q.Get("to") == "" {
+		respondError(w, r, common.NewError(common.CodeMissingParameter, "Missing required parameters: from, to"))
+		return
+	}
+	from := simulation.CanonicalServiceId(q.Get("from"))
+	to := simulation.CanonicalServiceId(q.Get("to"))
+	if from == to {
+		respondError(w, r, common.NewError(common.CodeInvalidParameter, "from and to must be different services"))
+		return
+	}
+
+	k := defaultPathCount
+	if v := q.Get("k"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPathCount {
+			respondError(w, r, common.NewError(common.CodeInvalidParameter, "k must be between 1 and %d", maxPathCount))
+			return
+		}
+		k = n
+	}
+	rank := analysis.RankHops
+	if v := q.Get("rank"); v != "" {
+		if !slices.Contains(analysis.PathRankings(), v) {
+			respondError(w, r, common.NewError(common.CodeInvalidParameter, "Invalid rank: %s. Allowed: %s", v, strings.Join(analysis.PathRankings(), ", ")))
+			return
+		}
+		rank = v
+	}
+
+	result, err := analysis.FindPaths(r.Context(), h.GraphClient, from, to, k, rank)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, result)
+}
+
+func contributorLimit(r *http.Request) (int, error) {
+	v := r.URL.Query().Get("limit")
+	if v == "" {
+		return defaultContributorLimit, nil
+	}
+	limit, err := strconv.Atoi(v)
+	if err != nil || limit < 1 || limit > maxContributorLimit {
+		return 0, common.NewError(common.CodeInvalidParameter, "limit must be between 1 and %d", maxContributorLimit)
+	}
+	return limit, nil
+}
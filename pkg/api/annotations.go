@@ -0,0 +1,338 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+const maxAnnotationLength = 10000
+
+// AnnotationsStore is what AnnotationsHandler needs from the decision store.
+type AnnotationsStore interface {
+	storage.DecisionReader
+	storage.AnnotationStore
+}
+
+type AnnotationsHandler struct {
+	Store AnnotationsStore
+}
+
+type TagsRequest struct {
+	Tags []string `json:"tags"`
+}
+
+type TagsResponse struct {
+	DecisionID int64    `json:"decisionId"`
+	Tags       []string `json:"tags"`
+}
+
+type AnnotationRequest struct {
+	Author   string `json:"author"`
+	Body     string `json:"body"`
+	ParentID *int64 `json:"parentId,omitempty"`
+}
+
+type AnnotationsResponse struct {
+	DecisionID  int64                `json:"decisionId"`
+	Annotations []storage.Annotation `json:"annotations"`
+}
+
+type ApprovalRequest struct {
+	State   string `json:"state"`
+	Actor   string `json:"actor"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type ApprovalResponse struct {
+	DecisionID int64                   `json:"decisionId"`
+	Approval   storage.Approval        `json:"approval"`
+	History    []storage.ApprovalEvent `json:"history"`
+}
+
+func (h *AnnotationsHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/decisions/{decisionId}/tags", h.AddTags)
+	r.Delete("/decisions/{decisionId}/tags/{tag}", h.RemoveTag)
+	r.Get("/decisions/{decisionId}/annotations", h.ListAnnotations)
+	r.Post("/decisions/{decisionId}/annotations", h.AddAnnotation)
+	r.Get("/decisions/{decisionId}/approval", h.GetApproval)
+	r.Put("/decisions/{decisionId}/approval", h.SetApproval)
+}
+
+// AddTags godoc
+// @Summary Tag a Decision
+// @Description Adds free-form tags such as a ticket ID or change name to a decision. Tags it already carries are ignored.
+// @Tags decisions
+// @Accept json
+// @Produce json
+// @Param decisionId path int true "Decision ID"
+// @Param request body TagsRequest true "Tags to add"
+// @Success 200 {object} TagsResponse
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/{decisionId}/tags [post]
+// @Router /v1/decisions/{decisionId}/tags [post]
+// @Router /v2/decisions/{decisionId}/tags [post]
+func (h *AnnotationsHandler) AddTags(w http.ResponseWriter, r *http.Request) {
+	var req TagsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
+		return
+	}
+	if len(req.Tags) == 0 {
+		respondError(w, r, common.NewError(common.CodeValidationFailed, "tags must not be empty"))
+		return
+	}
+	if err := validateTags(req.Tags); err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	rec, err := lookupDecision(h.Store, r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	tags, err := h.Store.AddTags(rec.ID, req.Tags)
+	if errors.Is(err, storage.ErrTooManyTags) {
+		respondError(w, r, common.NewError(common.CodeValidationFailed, "%s", err.Error()))
+		return
+	}
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, TagsResponse{DecisionID: rec.ID, Tags: tags})
+}
+
+// RemoveTag godoc
+// @Summary Remove a Decision Tag
+// @Description Removes one tag from a decision. Removing a tag the decision does not carry is not an error.
+// @Tags decisions
+// @Produce json
+// @Param decisionId path int true "Decision ID"
+// @Param tag path string true "Tag"
+// @Success 200 {object} TagsResponse
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/{decisionId}/tags/{tag} [delete]
+// @Router /v1/decisions/{decisionId}/tags/{tag} [delete]
+// @Router /v2/decisions/{decisionId}/tags/{tag} [delete]
+func (h *AnnotationsHandler) RemoveTag(w http.ResponseWriter, r *http.Request) {
+	rec, err := lookupDecision(h.Store, r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	tags, err := h.Store.RemoveTag(rec.ID, chi.URLParam(r, "tag"))
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, TagsResponse{DecisionID: rec.ID, Tags: tags})
+}
+
+// ListAnnotations godoc
+// @Summary List Decision Annotations
+// @Description Returns the annotations on a decision in the order they were written. Replies carry the parentId of the annotation they answer.
+// @Tags decisions
+// @Produce json
+// @Param decisionId path int true "Decision ID"
+// @Success 200 {object} AnnotationsResponse
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/{decisionId}/annotations [get]
+// @Router /v1/decisions/{decisionId}/annotations [get]
+// @Router /v2/decisions/{decisionId}/annotations [get]
+func (h *AnnotationsHandler) ListAnnotations(w http.ResponseWriter, r *http.Request) {
+	rec, err := lookupDecision(h.Store, r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	annotations, err := h.Store.ListAnnotations(rec.ID)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, AnnotationsResponse{DecisionID: rec.ID, Annotations: annotations})
+}
+
+// AddAnnotation godoc
+// @Summary Annotate a Decision
+// @Description Adds a comment to a decision, or a reply to one of its annotations when parentId is set
+// @Tags decisions
+// @Accept json
+// @Produce json
+// @Param decisionId path int true "Decision ID"
+// @Param request body AnnotationRequest true "Annotation"
+// @Success 201 {object} storage.Annotation
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/{decisionId}/annotations [post]
+// @Router /v1/decisions/{decisionId}/annotations [post]
+// @Router /v2/decisions/{decisionId}/annotations [post]
+func (h *AnnotationsHandler) AddAnnotation(w http.ResponseWriter, r *http.Request) {
+	var req AnnotationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
+		return
+	}
+	req.Author = strings.TrimSpace(req.Author)
+	if req.Author == "" || strings.TrimSpace(req.Body) == "" {
+		respondError(w, r, common.NewError(common.CodeValidationFailed, "Missing required fields: author, body"))
+		return
+	}
+	if len(req.Body) > maxAnnotationLength {
+		respondError(w, r, common.NewError(common.CodeValidationFailed, "body must be at most %d characters", maxAnnotationLength))
+		return
+	}
+
+	rec, err := lookupDecision(h.Store, r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	a, err := h.Store.AddAnnotation(storage.AnnotationInput{
+		DecisionID: rec.ID,
+		ParentID:   req.ParentID,
+		Author:     req.Author,
+		Body:       req.Body,
+	})
+	if errors.Is(err, storage.ErrAnnotationNotFound) {
+		respondError(w, r, common.NewError(common.CodeNotFound, "Annotation %d not found on decision %d", *req.ParentID, rec.ID))
+		return
+	}
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusCreated, a)
+}
+
+// GetApproval godoc
+// @Summary Get Decision Approval
+// @Description Returns the approval state of a decision and the history of changes to it
+// @Tags decisions
+// @Produce json
+// @Param decisionId path int true "Decision ID"
+// @Success 200 {object} ApprovalResponse
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/{decisionId}/approval [get]
+// @Router /v1/decisions/{decisionId}/approval [get]
+// @Router /v2/decisions/{decisionId}/approval [get]
+func (h *AnnotationsHandler) GetApproval(w http.ResponseWriter, r *http.Request) {
+	rec, err := lookupDecision(h.Store, r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	history, err := h.Store.ApprovalHistory(rec.ID)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, ApprovalResponse{DecisionID: rec.ID, Approval: rec.Approval, History: history})
+}
+
+// SetApproval godoc
+// @Summary Set Decision Approval
+// @Description Moves a decision to a new approval state. Allowed transitions: proposed to approved or rejected; approved to applied, rejected or proposed; rejected to proposed. Applied is final.
+// @Tags decisions
+// @Accept json
+// @Produce json
+// @Param decisionId path int true "Decision ID"
+// @Param request body ApprovalRequest true "New approval state"
+// @Success 200 {object} ApprovalResponse
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 409 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/{decisionId}/approval [put]
+// @Router /v1/decisions/{decisionId}/approval [put]
+// @Router /v2/decisions/{decisionId}/approval [put]
+func (h *AnnotationsHandler) SetApproval(w http.ResponseWriter, r *http.Request) {
+	var req ApprovalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
+		return
+	}
+	req.Actor = strings.TrimSpace(req.Actor)
+	if req.State == "" || req.Actor == "" {
+		respondError(w, r, common.NewError(common.CodeValidationFailed, "Missing required fields: state, actor"))
+		return
+	}
+	if !storage.IsApprovalState(req.State) {
+		respondError(w, r, errInvalidApprovalState(req.State))
+		return
+	}
+
+	rec, err := lookupDecision(h.Store, r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	approval, err := h.Store.SetApproval(storage.ApprovalInput{
+		DecisionID: rec.ID,
+		State:      req.State,
+		Actor:      req.Actor,
+		Comment:    req.Comment,
+	})
+	var te *storage.TransitionError
+	if errors.As(err, &te) {
+		respondError(w, r, common.NewError(common.CodeInvalidTransition, "Decision %d is %s and cannot move to %s", rec.ID, te.From, te.To))
+		return
+	}
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	history, err := h.Store.ApprovalHistory(rec.ID)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, ApprovalResponse{DecisionID: rec.ID, Approval: *approval, History: history})
+}
+
+func validateTags(tags []string) error {
+	if len(tags) > storage.MaxTags {
+		return common.NewError(common.CodeValidationFailed, "At most %d tags are allowed", storage.MaxTags)
+	}
+	for _, t := range tags {
+		if len(strings.TrimSpace(t)) > storage.MaxTagLength {
+			return common.NewError(common.CodeValidationFailed, "Tags must be at most %d characters", storage.MaxTagLength)
+		}
+	}
+	return nil
+}
+
+func errInvalidApprovalState(state string) error {
+	return common.NewError(common.CodeValidationFailed, "Invalid approval state: %s. Allowed: %s, %s, %s, %s",
+		state, storage.ApprovalProposed, storage.ApprovalApproved, storage.ApprovalRejected, storage.ApprovalApplied)
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"predictive-analysis-engine/pkg/anomaly"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+const (
+	defaultAnomalyLimit = 100
+	maxAnomalyLimit     = 1000
+)
+
+type AnomaliesHandler struct {
+	Store    storage.AnomalyStore
+	Detector *anomaly.Detector
+}
+
+type AnomaliesResponse struct {
+	Anomalies []storage.AnomalyEvent `json:"anomalies"`
+	Count     int                    `json:"count"`
+}
+
+func (h *AnomaliesHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/anomalies", h.ListAnomalies)
+	r.Post("/anomalies/run", h.RunDetection)
+}
+
+// ListAnomalies godoc
+// @Summary List Anomalies
+// @Description Returns detected anomalies in request rate, error rate and p95, most recent first. The service filter matches the service itself and edges on either side of it.
+// @Tags anomalies
+// @Produce json
+// @Param from query string false "Observed at or after (RFC3339)"
+// @Param to query string false "Observed at or before (RFC3339)"
+// @Param service query string false "Service ID (namespace:name, or name in the default namespace)"
+// @Param metric query string false "Comma-separated metrics: rps, error_rate, p95"
+// @Param severity query string false "Comma-separated severities: low, medium, high, critical"
+// @Param limit query int false "Maximum number of events, at most 1000" default(100)
+// @Success 200 {object} AnomaliesResponse
+// @Failure 400 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /anomalies [get]
+// @Router /v1/anomalies [get]
+// @Router /v2/anomalies [get]
+func (h *AnomaliesHandler) ListAnomalies(w http.ResponseWriter, r *http.Request) {
+	if h.Store == nil {
+		respondError(w, r, errStoreUnavailable)
+		return
+	}
+
+	f, err := parseAnomalyFilter(r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	events, err := h.Store.ListAnomalies(f)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, AnomaliesResponse{Anomalies: events, Count: len(events)})
+}
+
+// RunDetection godoc
+// @Summary Run Anomaly Detection
+// @Description Scores the service and edge metrics recorded since the previous run against their baselines and stores the anomalies found. The first run after startup only builds the baselines from ANOMALY_WARMUP_HOURS of history.
+// @Tags anomalies
+// @Produce json
+// @Success 200 {object} anomaly.RunResult
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /anomalies/run [post]
+// @Router /v1/anomalies/run [post]
+// @Router /v2/anomalies/run [post]
+func (h *AnomaliesHandler) RunDetection(w http.ResponseWriter, r *http.Request) {
+	res, err := h.Detector.Run(r.Context())
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, res)
+}
+
+func parseAnomalyFilter(r *http.Request) (storage.AnomalyFilter, error) {
+	q := r.URL.Query()
+	f := storage.AnomalyFilter{
+		Metrics:    splitList(q.Get("metric")),
+		Severities: splitList(q.Get("severity")),
+		Limit:      defaultAnomalyLimit,
+	}
+	if v := q.Get("service"); v != "" {
+		f.ServiceID = simulation.CanonicalServiceId(v)
+	}
+
+	for _, bound := range []struct {
+		name string
+		dest *string
+	}{{"from", &f.From}, {"to", &f.To}} {
+		v := q.Get(bound.name)
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return f, common.NewError(common.CodeInvalidTimestamp, "Invalid %s timestamp. Use ISO 8601 (e.g., 2026-01-04T10:00:00Z)", bound.name)
+		}
+		*bound.dest = t.UTC().Format(time.RFC3339)
+	}
+
+	for _, allowed := range []struct {
+		name   string
+		values []string
+		valid  []string
+	}{{"metric", f.Metrics, anomaly.Metrics()}, {"severity", f.Severities, anomaly.Severities()}} {
+		for _, v := range allowed.values {
+			if !slices.Contains(allowed.valid, v) {
+				return f, common.NewError(common.CodeInvalidParameter, "Invalid %s: %s. Allowed: %s", allowed.name, v, strings.Join(allowed.valid, ", "))
+			}
+		}
+	}
+
+	if v := q.Get("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l <= 0 || l > maxAnomalyLimit {
+			return f, common.NewError(common.CodeInvalidParameter, "limit must be between 1 and %d", maxAnomalyLimit)
+		}
+		f.Limit = l
+	}
+	return f, nil
+}
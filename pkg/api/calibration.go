@@ -0,0 +1,106 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"predictive-analysis-engine/pkg/calibration"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+type CalibrationHandler struct {
+	Store      storage.CalibrationStore
+	Calibrator *calibration.Calibrator
+}
+
+type CalibrationsResponse struct {
+	Calibrations []storage.ScalingCalibration `json:"calibrations"`
+}
+
+func (h *CalibrationHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/calibration/scaling", h.ListCalibrations)
+	r.Post("/calibration/scaling/run", h.RunCalibration)
+	r.Get("/calibration/scaling/{serviceId}", h.GetCalibration)
+}
+
+// ListCalibrations godoc
+// @Summary List Scaling Calibrations
+// @Description Lists the bounded_sqrt parameters fitted per service from historical pod-count changes. Scaling simulations use them when the request does not specify a model.
+// @Tags calibration
+// @Produce json
+// @Success 200 {object} CalibrationsResponse
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /calibration/scaling [get]
+// @Router /v1/calibration/scaling [get]
+// @Router /v2/calibration/scaling [get]
+func (h *CalibrationHandler) ListCalibrations(w http.ResponseWriter, r *http.Request) {
+	if h.Store == nil {
+		respondError(w, r, errStoreUnavailable)
+		return
+	}
+
+	calibrations, err := h.Store.ListCalibrations()
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, CalibrationsResponse{Calibrations: calibrations})
+}
+
+// GetCalibration godoc
+// @Summary Get Scaling Calibration
+// @Description Returns the bounded_sqrt parameters fitted for one service
+// @Tags calibration
+// @Produce json
+// @Param serviceId path string true "Service ID (namespace:name, or name in the default namespace)"
+// @Success 200 {object} storage.ScalingCalibration
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /calibration/scaling/{serviceId} [get]
+// @Router /v1/calibration/scaling/{serviceId} [get]
+// @Router /v2/calibration/scaling/{serviceId} [get]
+func (h *CalibrationHandler) GetCalibration(w http.ResponseWriter, r *http.Request) {
+	if h.Store == nil {
+		respondError(w, r, errStoreUnavailable)
+		return
+	}
+
+	serviceID := simulation.CanonicalServiceId(chi.URLParam(r, "serviceId"))
+	cal, err := h.Store.GetCalibration(serviceID)
+	if errors.Is(err, storage.ErrCalibrationNotFound) {
+		respondError(w, r, common.NewError(common.CodeNotFound, "No calibration for service: %s", serviceID))
+		return
+	}
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, cal)
+}
+
+// RunCalibration godoc
+// @Summary Run Scaling Calibration
+// @Description Refits per-service bounded_sqrt parameters from pod-count changes in service_metrics over the configured lookback window and returns the services that were calibrated
+// @Tags calibration
+// @Produce json
+// @Success 200 {object} CalibrationsResponse
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /calibration/scaling/run [post]
+// @Router /v1/calibration/scaling/run [post]
+// @Router /v2/calibration/scaling/run [post]
+func (h *CalibrationHandler) RunCalibration(w http.ResponseWriter, r *http.Request) {
+	fitted, err := h.Calibrator.Run(r.Context())
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, CalibrationsResponse{Calibrations: fitted})
+}
@@ -2,22 +2,57 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/export"
+	"predictive-analysis-engine/pkg/logger"
+	"predictive-analysis-engine/pkg/simulation"
 	"predictive-analysis-engine/pkg/storage"
 )
 
+var errStoreUnavailable = common.NewError(common.CodeDecisionStoreUnavailable, "Decision store not available. Check DECISION_STORE_DRIVER configuration.")
+
+// DecisionsStore is what DecisionsHandler needs from the decision store.
+type DecisionsStore interface {
+	storage.DecisionWriter
+	storage.DecisionReader
+	storage.DecisionSearcher
+}
+
 type DecisionsHandler struct {
-	Store *storage.DecisionStore
+	Store DecisionsStore
 }
 
-func (h *DecisionsHandler) RegisterRoutes(r *chi.Mux) {
+func (h *DecisionsHandler) RegisterRoutes(r chi.Router) {
 	r.Post("/decisions/log", h.LogDecision)
 	r.Get("/decisions/history", h.GetHistory)
+	r.Get("/decisions/search", h.SearchDecisions)
+	r.Get("/decisions/export", h.ExportDecisions)
+	r.Get("/decisions/diff", h.DiffDecisions)
+}
+
+type DecisionRef struct {
+	ID            int64  `json:"id"`
+	Timestamp     string `json:"timestamp"`
+	CorrelationID string `json:"correlationId,omitempty"`
+}
+
+// DecisionDiffResponse compares decision A (before) with decision B (after).
+// Exactly one of Failure and Scaling is set, matching Type.
+type DecisionDiffResponse struct {
+	Type    string                  `json:"type"`
+	A       DecisionRef             `json:"a"`
+	B       DecisionRef             `json:"b"`
+	Failure *simulation.FailureDiff `json:"failure,omitempty"`
+	Scaling *simulation.ScalingDiff `json:"scaling,omitempty"`
 }
 
 // LogDecision godoc
@@ -28,59 +63,60 @@ func (h *DecisionsHandler) RegisterRoutes(r *chi.Mux) {
 // @Produce json
 // @Param request body storage.LogDecisionInput true "Decision details"
 // @Success 201 {object} map[string]interface{}
-// @Failure 400 {object} map[string]string
-// @Failure 503 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
 // @Router /decisions/log [post]
+// @Router /v1/decisions/log [post]
+// @Router /v2/decisions/log [post]
 func (h *DecisionsHandler) LogDecision(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-
 	if h.Store == nil {
-		w.WriteHeader(http.StatusServiceUnavailable)
-		json.NewEncoder(w).Encode(map[string]string{"error": "Decision store not available. Check SQLite configuration."})
+		respondError(w, r, errStoreUnavailable)
 		return
 	}
 
 	var input storage.LogDecisionInput
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
 		return
 	}
 
 	if input.Timestamp == "" || input.Type == "" || input.Scenario == nil || input.Result == nil {
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]string{"error": "Missing required fields: timestamp, type, scenario, result"})
+		respondError(w, r, common.NewError(common.CodeValidationFailed, "Missing required fields: timestamp, type, scenario, result"))
+		return
+	}
+
+	ts, err := time.Parse(time.RFC3339, input.Timestamp)
+	if err != nil {
+		respondError(w, r, common.NewError(common.CodeInvalidTimestamp, "Invalid timestamp format. Use ISO 8601 (e.g., 2026-01-04T10:00:00Z)"))
 		return
 	}
+	// Timestamps are compared as text by search and retention, so store
+	// them in the same UTC layout as the bounds they are compared with.
+	input.Timestamp = ts.UTC().Format(time.RFC3339)
 
-	if _, err := time.Parse(time.RFC3339, input.Timestamp); err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid timestamp format. Use ISO 8601 (e.g., 2026-01-04T10:00:00Z)"})
+	if err := validateTags(input.Tags); err != nil {
+		respondError(w, r, err)
 		return
 	}
 
-	validTypes := map[string]bool{"failure": true, "scaling": true, "risk": true, "add": true}
+	validTypes := map[string]bool{"failure": true, "scaling": true, "risk": true, "add": true, "remove": true, "rewire": true, "drain": true}
 	if !validTypes[input.Type] {
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid type. Must be one of: failure, scaling, risk"})
+		respondError(w, r, common.NewError(common.CodeInvalidDecisionType, "Invalid type. Must be one of: failure, scaling, risk, add, remove, rewire, drain"))
 		return
 	}
 
 	record, err := h.Store.LogDecision(input)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		json.NewEncoder(w).Encode(map[string]string{"error": "Internal server error"})
+		respondError(w, r, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusCreated)
-
 	resp := map[string]interface{}{
 		"id":        record.ID,
 		"timestamp": record.Timestamp,
 	}
-	json.NewEncoder(w).Encode(resp)
+	respondJSON(w, http.StatusCreated, resp)
 }
 
 // GetHistory godoc
@@ -91,13 +127,18 @@ func (h *DecisionsHandler) LogDecision(w http.ResponseWriter, r *http.Request) {
 // @Param limit query int false "Limit number of records" default(50)
 // @Param offset query int false "Offset for pagination" default(0)
 // @Param type query string false "Filter by decision type"
+// @Param tag query string false "Only decisions carrying this tag"
+// @Param approvalState query string false "Filter by approval state (proposed, approved, rejected, applied)"
 // @Success 200 {object} map[string]interface{}
-// @Failure 500 {object} map[string]string
-// @Failure 503 {object} map[string]string
+// @Failure 400 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Failure 503 {object} api.Problem
 // @Router /decisions/history [get]
+// @Router /v1/decisions/history [get]
+// @Router /v2/decisions/history [get]
 func (h *DecisionsHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
 	if h.Store == nil {
-		http.Error(w, `{"error": "Decision store not available. Check SQLite configuration."}`, 503)
+		respondError(w, r, errStoreUnavailable)
 		return
 	}
 
@@ -115,24 +156,30 @@ func (h *DecisionsHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	decisionType := r.URL.Query().Get("type")
+	opts := storage.GetHistoryOptions{
+		Limit:         limit,
+		Offset:        offset,
+		Type:          r.URL.Query().Get("type"),
+		Tag:           strings.TrimSpace(r.URL.Query().Get("tag")),
+		ApprovalState: r.URL.Query().Get("approvalState"),
+	}
+	if opts.ApprovalState != "" && !storage.IsApprovalState(opts.ApprovalState) {
+		respondError(w, r, errInvalidApprovalState(opts.ApprovalState))
+		return
+	}
 
-	records, err := h.Store.GetHistory(storage.GetHistoryOptions{
-		Limit:  limit,
-		Offset: offset,
-		Type:   decisionType,
-	})
+	records, err := h.Store.GetHistory(opts)
 	if err != nil {
-		http.Error(w, `{"error": "Internal server error"}`, 500)
+		respondError(w, r, err)
 		return
 	}
 	if records == nil {
 		records = []storage.DecisionRecord{}
 	}
 
-	count, err := h.Store.GetCount(decisionType)
+	count, err := h.Store.GetCount(opts)
 	if err != nil {
-		http.Error(w, `{"error": "Internal server error"}`, 500)
+		respondError(w, r, err)
 		return
 	}
 
@@ -145,6 +192,264 @@ func (h *DecisionsHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	json.NewEncoder(w).Encode(resp)
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// SearchDecisions godoc
+// @Summary Search Decisions
+// @Description Filters logged decisions on fields extracted from their scenario and result, newest first. Pass nextCursor from a response as cursor to fetch the following page.
+// @Tags decisions
+// @Produce json
+// @Param type query string false "Decision type"
+// @Param serviceId query string false "Target service ID (namespace:name, or name in the default namespace)"
+// @Param correlationId query string false "Correlation ID of the request that produced the decision"
+// @Param from query string false "Decisions at or after this timestamp (ISO 8601)"
+// @Param to query string false "Decisions at or before this timestamp (ISO 8601)"
+// @Param confidence query string false "Comma-separated confidence levels (high, low, unknown)"
+// @Param minLostTrafficRps query number false "Minimum totalLostTrafficRps of failure decisions"
+// @Param recommendationType query string false "Comma-separated recommendation types; matches decisions with any of them"
+// @Param tag query string false "Comma-separated tags; matches decisions carrying all of them"
+// @Param approvalState query string false "Comma-separated approval states (proposed, approved, rejected, applied)"
+// @Param cursor query string false "Cursor returned as nextCursor by the previous page"
+// @Param limit query int false "Page size, at most 100" default(50)
+// @Success 200 {object} storage.SearchPage
+// @Failure 400 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/search [get]
+// @Router /v1/decisions/search [get]
+// @Router /v2/decisions/search [get]
+func (h *DecisionsHandler) SearchDecisions(w http.ResponseWriter, r *http.Request) {
+	if h.Store == nil {
+		respondError(w, r, errStoreUnavailable)
+		return
+	}
+
+	opts, err := parseSearchOptions(r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	page, err := h.Store.SearchDecisions(opts)
+	if errors.Is(err, storage.ErrInvalidCursor) {
+		respondError(w, r, common.NewError(common.CodeInvalidParameter, "Invalid cursor"))
+		return
+	}
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, page)
+}
+
+// ExportDecisions godoc
+// @Summary Export Decisions
+// @Description Streams every decision matching the search filters, oldest first, as NDJSON, CSV or Parquet. Decisions are read in batches and written one at a time, so exports are not limited in size. Each row carries the decision's recommendation types, tags, outcome, annotations and approval history; CSV writes scenario, result and the child rows as JSON text; Parquet keeps tags and recommendation types as lists.
+// @Tags decisions
+// @Produce application/x-ndjson,text/csv,application/vnd.apache.parquet
+// @Param format query string true "Export format: ndjson, csv or parquet"
+// @Param type query string false "Decision type"
+// @Param serviceId query string false "Target service ID (namespace:name, or name in the default namespace)"
+// @Param correlationId query string false "Correlation ID of the request that produced the decision"
+// @Param from query string false "Decisions at or after this timestamp (ISO 8601)"
+// @Param to query string false "Decisions at or before this timestamp (ISO 8601)"
+// @Param confidence query string false "Comma-separated confidence levels (high, low, unknown)"
+// @Param minLostTrafficRps query number false "Minimum totalLostTrafficRps of failure decisions"
+// @Param recommendationType query string false "Comma-separated recommendation types; matches decisions with any of them"
+// @Param tag query string false "Comma-separated tags; matches decisions carrying all of them"
+// @Param approvalState query string false "Comma-separated approval states (proposed, approved, rejected, applied)"
+// @Success 200 {string} string
+// @Failure 400 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/export [get]
+// @Router /v1/decisions/export [get]
+// @Router /v2/decisions/export [get]
+func (h *DecisionsHandler) ExportDecisions(w http.ResponseWriter, r *http.Request) {
+	if h.Store == nil {
+		respondError(w, r, errStoreUnavailable)
+		return
+	}
+
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if !export.IsDecisionFormat(format) {
+		respondError(w, r, common.NewError(common.CodeInvalidParameter, "Invalid format: %s. Allowed: %s", format, strings.Join(export.DecisionFormats(), ", ")))
+		return
+	}
+	opts, err := parseSearchOptions(r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", export.DecisionContentType(format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="decisions-%s.%s"`, time.Now().UTC().Format("20060102T150405Z"), format))
+	w.WriteHeader(http.StatusOK)
+
+	// Headers are already sent, so failures past this point can only be
+	// logged; the client sees a truncated body.
+	dw := export.NewDecisionWriter(w, format)
+	err = h.Store.StreamDecisions(r.Context(), opts, dw.Write)
+	if err == nil {
+		err = dw.Close()
+	}
+	if err != nil {
+		logger.Error("Decision export failed", err)
+	}
+}
+
+// DiffDecisions godoc
+// @Summary Diff Two Decisions
+// @Description Compares two stored failure or scaling simulations of the same type, treating a as before and b as after: added, removed and changed callers, unreachable services, critical or affected paths and recommendations.
+// @Tags decisions
+// @Produce json
+// @Param a query int true "Decision ID of the earlier simulation"
+// @Param b query int true "Decision ID of the later simulation"
+// @Success 200 {object} DecisionDiffResponse
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/diff [get]
+// @Router /v1/decisions/diff [get]
+// @Router /v2/decisions/diff [get]
+func (h *DecisionsHandler) DiffDecisions(w http.ResponseWriter, r *http.Request) {
+	var recs [2]*storage.DecisionRecord
+	for i, name := range []string{"a", "b"} {
+		id, err := strconv.ParseInt(r.URL.Query().Get(name), 10, 64)
+		if err != nil || id <= 0 {
+			respondError(w, r, common.NewError(common.CodeInvalidParameter, "%s must be a positive decision ID", name))
+			return
+		}
+		rec, err := getDecision(h.Store, id)
+		if err != nil {
+			respondError(w, r, err)
+			return
+		}
+		recs[i] = rec
+	}
+	a, b := recs[0], recs[1]
+
+	if a.Type != b.Type {
+		respondError(w, r, common.NewError(common.CodeValidationFailed, "Cannot diff a %s decision with a %s decision", a.Type, b.Type))
+		return
+	}
+
+	resp := DecisionDiffResponse{
+		Type: a.Type,
+		A:    DecisionRef{ID: a.ID, Timestamp: a.Timestamp, CorrelationID: a.CorrelationID},
+		B:    DecisionRef{ID: b.ID, Timestamp: b.Timestamp, CorrelationID: b.CorrelationID},
+	}
+	switch a.Type {
+	case "failure":
+		var ra, rb simulation.FailureSimulationResult
+		if err := decodeStored(a, &ra); err != nil {
+			respondError(w, r, err)
+			return
+		}
+		if err := decodeStored(b, &rb); err != nil {
+			respondError(w, r, err)
+			return
+		}
+		resp.Failure = simulation.DiffFailure(&ra, &rb)
+	case "scaling":
+		var ra, rb simulation.ScalingSimulationResult
+		if err := decodeStored(a, &ra); err != nil {
+			respondError(w, r, err)
+			return
+		}
+		if err := decodeStored(b, &rb); err != nil {
+			respondError(w, r, err)
+			return
+		}
+		resp.Scaling = simulation.DiffScaling(&ra, &rb)
+	default:
+		respondError(w, r, common.NewError(common.CodeValidationFailed, "Diff supports failure and scaling decisions, not %s", a.Type))
+		return
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// parseSearchOptions reads the decision filters shared by search and export.
+func parseSearchOptions(r *http.Request) (storage.SearchOptions, error) {
+	q := r.URL.Query()
+	opts := storage.SearchOptions{
+		Type:                q.Get("type"),
+		ServiceID:           q.Get("serviceId"),
+		CorrelationID:       q.Get("correlationId"),
+		Confidence:          splitList(q.Get("confidence")),
+		RecommendationTypes: splitList(q.Get("recommendationType")),
+		Tags:                splitList(q.Get("tag")),
+		ApprovalStates:      splitList(q.Get("approvalState")),
+		Cursor:              q.Get("cursor"),
+	}
+	for _, st := range opts.ApprovalStates {
+		if !storage.IsApprovalState(st) {
+			return opts, errInvalidApprovalState(st)
+		}
+	}
+
+	for _, bound := range []struct {
+		name string
+		dest *string
+	}{{"from", &opts.From}, {"to", &opts.To}} {
+		v := q.Get(bound.name)
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return opts, common.NewError(common.CodeInvalidTimestamp, "Invalid %s timestamp. Use ISO 8601 (e.g., 2026-01-04T10:00:00Z)", bound.name)
+		}
+		*bound.dest = t.UTC().Format(time.RFC3339)
+	}
+
+	if v := q.Get("minLostTrafficRps"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f < 0 {
+			return opts, common.NewError(common.CodeInvalidParameter, "minLostTrafficRps must be a non-negative number")
+		}
+		opts.MinLostTrafficRps = &f
+	}
+
+	if v := q.Get("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l <= 0 {
+			return opts, common.NewError(common.CodeInvalidParameter, "limit must be a positive integer")
+		}
+		opts.Limit = l
+	}
+
+	return opts, nil
+}
+
+func splitList(v string) []string {
+	var out []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// lookupDecision fetches the decision named by the decisionId URL parameter.
+func lookupDecision(store storage.DecisionReader, r *http.Request) (*storage.DecisionRecord, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "decisionId"), 10, 64)
+	if err != nil || id <= 0 {
+		return nil, common.NewError(common.CodeInvalidParameter, "decisionId must be a positive integer")
+	}
+	return getDecision(store, id)
+}
+
+func getDecision(store storage.DecisionReader, id int64) (*storage.DecisionRecord, error) {
+	if store == nil {
+		return nil, errStoreUnavailable
+	}
+	rec, err := store.GetByID(id)
+	if errors.Is(err, storage.ErrDecisionNotFound) {
+		return nil, common.NewError(common.CodeNotFound, "Decision not found: %d", id)
+	}
+	return rec, err
 }
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/export"
+	"predictive-analysis-engine/pkg/logger"
+	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/snapshot"
+)
+
+// exportFormat returns the requested graph export format, or "" for the
+// default JSON response.
+func exportFormat(r *http.Request) (string, error) {
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if format == "" || format == "json" {
+		return "", nil
+	}
+	if !export.IsSupported(format) {
+		return "", common.NewError(common.CodeInvalidParameter, "Invalid format: %s. Allowed: json, %s", format, strings.Join(export.Formats(), ", "))
+	}
+	return format, nil
+}
+
+func respondGraph(w http.ResponseWriter, format string, g *export.Graph) {
+	w.Header().Set("Content-Type", export.ContentType(format))
+	w.WriteHeader(http.StatusOK)
+	if err := export.Render(w, format, g); err != nil {
+		logger.Error("Failed to render graph export", err)
+	}
+}
+
+// snapshotGraph converts a snapshot for export. A namespace-filtered
+// snapshot keeps edges to services outside the namespace; those endpoints
+// become unstyled stub nodes so every format references declared nodes only.
+func snapshotGraph(title string, snap *snapshot.Response) *export.Graph {
+	g := &export.Graph{Title: title}
+	seen := map[string]bool{}
+	for _, n := range snap.Nodes {
+		g.Nodes = append(g.Nodes, export.Node{ID: n.ID, Label: n.Name, RiskLevel: n.RiskLevel})
+		seen[n.ID] = true
+	}
+	for _, e := range snap.Edges {
+		for _, id := range []string{e.Source, e.Target} {
+			if !seen[id] {
+				seen[id] = true
+				g.Nodes = append(g.Nodes, export.Node{ID: id, Label: id})
+			}
+		}
+		g.Edges = append(g.Edges, export.Edge{
+			Source:       e.Source,
+			Target:       e.Target,
+			ReqRate:      e.ReqRate,
+			LatencyP95Ms: e.LatencyP95Ms,
+		})
+	}
+	return g
+}
+
+// failureGraph renders the impact graph of a failure simulation, colored
+// with risk levels and edge latencies from the current snapshot when it is
+// available.
+func (h *Handler) failureGraph(ctx context.Context, res *simulation.FailureSimulationResult) *export.Graph {
+	riskLevels := map[string]string{}
+	latencies := map[string]float64{}
+	if snap, err := snapshot.Build(ctx, h.GraphClient, ""); err == nil {
+		for _, n := range snap.Nodes {
+			riskLevels[n.ID] = n.RiskLevel
+		}
+		for _, e := range snap.Edges {
+			latencies[e.Source+"->"+e.Target] = e.LatencyP95Ms
+		}
+	} else {
+		logger.Error("Failed to fetch snapshot for failure export; rendering without risk levels", err)
+	}
+	return export.FailureGraph(res, riskLevels, latencies)
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/forecast"
+)
+
+const (
+	defaultForecastHorizon = 24 * time.Hour
+	defaultForecastStep    = 3600
+	minForecastStep        = 60
+	maxForecastPoints      = 2000
+)
+
+type ForecastHandler struct {
+	Forecaster *forecast.Forecaster
+}
+
+func (h *ForecastHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/forecast/service", h.ForecastService)
+}
+
+// ForecastService godoc
+// @Summary Forecast Service Traffic and Latency
+// @Description Fits Holt-Winters with daily seasonality to the service's history in service_metrics and returns predicted request rate and p95 with 95% prediction intervals. Histories shorter than two days fall back to a damped trend without seasonality.
+// @Tags forecast
+// @Produce json
+// @Param service query string true "Service ID (namespace:name, or name in the default namespace)"
+// @Param horizon query string false "How far ahead to forecast, as a duration (e.g. 24h, 168h)" default(24h)
+// @Param step query int false "Step size in seconds, also used for the history" default(3600)
+// @Success 200 {object} forecast.ServiceForecast
+// @Failure 400 {object} api.Problem
+// @Failure 422 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Router /forecast/service [get]
+// @Router /v1/forecast/service [get]
+// @Router /v2/forecast/service [get]
+func (h *ForecastHandler) ForecastService(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	service := q.Get("service")
+	if service == "" {
+		respondError(w, r, common.NewError(common.CodeMissingParameter, "Missing required parameter: service"))
+		return
+	}
+
+	horizon := defaultForecastHorizon
+	if v := q.Get("horizon"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			respondError(w, r, common.NewError(common.CodeInvalidParameter, "horizon must be a positive duration (e.g. 24h)"))
+			return
+		}
+		horizon = d
+	}
+	if limit := h.Forecaster.MaxHorizon(); horizon > limit {
+		respondError(w, r, common.NewError(common.CodeInvalidParameter, "horizon must be at most %s", limit))
+		return
+	}
+
+	step := defaultForecastStep
+	if v := q.Get("step"); v != "" {
+		s, err := strconv.Atoi(v)
+		if err != nil || s < minForecastStep {
+			respondError(w, r, common.NewError(common.CodeInvalidParameter, "step must be an integer of at least %d seconds", minForecastStep))
+			return
+		}
+		step = s
+	}
+	if int(horizon/time.Second)/step > maxForecastPoints {
+		respondError(w, r, common.NewError(common.CodeInvalidParameter, "horizon/step must not exceed %d points", maxForecastPoints))
+		return
+	}
+
+	fc, err := h.Forecaster.ForecastService(r.Context(), service, horizon, step)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, fc)
+}
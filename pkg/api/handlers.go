@@ -4,11 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	"predictive-analysis-engine/pkg/analysis"
 	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/common"
 	"predictive-analysis-engine/pkg/config"
 	"predictive-analysis-engine/pkg/logger"
 	"predictive-analysis-engine/pkg/simulation"
@@ -94,8 +94,10 @@ func (h *Handler) HealthHandler(w http.ResponseWriter, r *http.Request) {
 // @Tags services
 // @Produce json
 // @Success 200 {object} map[string]interface{}
-// @Failure 503 {object} map[string]string
+// @Failure 503 {object} api.Problem
 // @Router /services [get]
+// @Router /v1/services [get]
+// @Router /v2/services [get]
 func (h *Handler) ServicesHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -136,14 +138,15 @@ func (h *Handler) ServicesHandler(w http.ResponseWriter, r *http.Request) {
 
 	if sRes.err != nil {
 		logger.Error("Failed to fetch services", sRes.err)
-		respondJSON(w, http.StatusServiceUnavailable, map[string]interface{}{
-			"error":                 "Failed to fetch services from Graph Engine",
+		p := newProblem(r, common.CodeUpstreamUnavailable, "Failed to fetch services from Graph Engine")
+		p.Extensions = map[string]interface{}{
 			"services":              []interface{}{},
 			"count":                 0,
 			"stale":                 true,
 			"lastUpdatedSecondsAgo": nil,
 			"windowMinutes":         windowMinutes,
-		})
+		}
+		respondProblem(w, p)
 		return
 	}
 
@@ -187,10 +190,14 @@ func (h *Handler) ServicesHandler(w http.ResponseWriter, r *http.Request) {
 // @Param metric query string false "Risk metric (pagerank, betweenness)" default(pagerank)
 // @Param limit query int false "Number of services to return (1-20)" default(5)
 // @Success 200 {object} graph.TopCentralityResponse
-// @Failure 400 {object} map[string]string
-// @Failure 503 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 504 {object} api.Problem
+// @Failure 500 {object} api.Problem
 // @Router /risk/services/top [get]
+// @Router /v1/risk/services/top [get]
+// @Router /v2/risk/services/top [get]
 func (h *Handler) TopRiskHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -212,24 +219,8 @@ func (h *Handler) TopRiskHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	result, err := analysis.GetTopRiskServices(ctx, h.GraphClient, metric, limit)
-
 	if err != nil {
-		errMsg := err.Error()
-		if strings.Contains(errMsg, "Invalid metric") {
-			respondError(w, http.StatusBadRequest, errMsg)
-			return
-		}
-		if strings.Contains(errMsg, "disabled") {
-			respondError(w, http.StatusServiceUnavailable, "Graph API is not enabled")
-			return
-		}
-		if strings.Contains(strings.ToLower(errMsg), "timeout") {
-			respondError(w, http.StatusGatewayTimeout, "Graph API timeout")
-			return
-		}
-
-		logger.Error("Risk analysis error", err)
-		respondError(w, http.StatusInternalServerError, "Internal server error")
+		respondError(w, r, err)
 		return
 	}
 
@@ -241,24 +232,40 @@ func (h *Handler) TopRiskHandler(w http.ResponseWriter, r *http.Request) {
 // @Description Simulates a failure of a specific service and analyzes the impact
 // @Tags simulation
 // @Accept json
-// @Produce json
+// @Produce json,text/vnd.graphviz,text/plain,application/graphml+xml
 // @Param request body simulation.FailureSimulationRequest true "Simulation parameters"
+// @Param format query string false "Export the impact graph instead of JSON: dot, mermaid, graphml or cytoscape"
 // @Success 200 {object} simulation.FailureSimulationResult
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 503 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 504 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Deprecated
 // @Router /simulate/failure [post]
+// @Router /v1/simulate/failure [post]
 func (h *Handler) SimulateFailureHandler(w http.ResponseWriter, r *http.Request) {
+	format, err := exportFormat(r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
 	var req simulation.FailureSimulationRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "Invalid request body")
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
 		return
 	}
 
 	result, err := h.SimulationService.RunFailureSimulation(r.Context(), req)
 	if err != nil {
-		handleSimulationError(w, err)
+		respondError(w, r, err)
+		return
+	}
+
+	if format != "" {
+		respondGraph(w, format, h.failureGraph(r.Context(), result))
 		return
 	}
 
@@ -273,83 +280,156 @@ func (h *Handler) SimulateFailureHandler(w http.ResponseWriter, r *http.Request)
 // @Produce json
 // @Param request body simulation.ScalingSimulationRequest true "Simulation parameters"
 // @Success 200 {object} simulation.ScalingSimulationResult
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 503 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 504 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Deprecated
 // @Router /simulate/scale [post]
+// @Router /v1/simulate/scale [post]
 func (h *Handler) SimulateScalingHandler(w http.ResponseWriter, r *http.Request) {
 	var req simulation.ScalingSimulationRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondError(w, http.StatusBadRequest, "Invalid request body")
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
 		return
 	}
 
 	result, err := h.SimulationService.RunScalingSimulation(r.Context(), req)
 	if err != nil {
-		handleSimulationError(w, err)
+		respondError(w, r, err)
 		return
 	}
 
 	respondJSON(w, http.StatusOK, result)
 }
 
-// SimulateAddHandler godoc
-// @Summary Simulate Adding Service
-// @Description Simulates adding a new service to the cluster (capacity planning)
+// SimulateRemoveHandler godoc
+// @Summary Simulate Removing Service
+// @Description Simulates a planned decommission. redirects maps each caller to the service that takes over its calls; replacements must exist in the cluster and differ from the caller. Projects the added load and latency on each replacement with the configured scaling model, using the parameters calibrated for the replacement when there are any, reports the traffic lost from callers without a replacement, and the node capacity freed by the removed pods.
 // @Tags simulation
 // @Accept json
 // @Produce json
-// @Param request body simulation.AddSimulationRequest true "Simulation parameters"
-// @Success 200 {object} simulation.AddSimulationResult
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
-// @Router /simulate/add [post]
-func (h *Handler) SimulateAddHandler(w http.ResponseWriter, r *http.Request) {
-	var req simulation.AddSimulationRequest
+// @Param request body simulation.RemoveSimulationRequest true "Simulation parameters"
+// @Success 200 {object} simulation.RemoveSimulationResult
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 504 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /v2/simulate/remove [post]
+func (h *Handler) SimulateRemoveHandler(w http.ResponseWriter, r *http.Request) {
+	var req simulation.RemoveSimulationRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		logger.Error("Invalid request body", err)
-		respondError(w, http.StatusInternalServerError, "Invalid request body")
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
 		return
 	}
-	if req.CPURequest <= 0 || req.RAMRequest <= 0 || req.Replicas <= 0 {
-		respondError(w, http.StatusInternalServerError, "Invalid resource requests: cpu, ram, and replicas must be positive")
+
+	result, err := h.SimulationService.RunRemoveSimulation(r.Context(), req)
+	if err != nil {
+		respondError(w, r, err)
 		return
 	}
 
-	result, err := h.SimulationService.RunAddSimulation(r.Context(), req)
+	respondJSON(w, http.StatusOK, result)
+}
+
+// SimulateRewireHandler godoc
+// @Summary Simulate Rewiring Edges
+// @Description Applies edge mutations to a copy of the graph around the mutated services and compares it with the original. add_edge moves percent of the traffic source sends to from onto a new edge (or adds rate), remove_edge drops an edge, set_rate changes an edge's rate. Reports the change in load per service, the latency of the mutated callers and their paths, and new cycles and single points of failure.
+// @Tags simulation
+// @Accept json
+// @Produce json
+// @Param request body simulation.RewireSimulationRequest true "Simulation parameters"
+// @Success 200 {object} simulation.RewireSimulationResult
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 504 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /v2/simulate/rewire [post]
+func (h *Handler) SimulateRewireHandler(w http.ResponseWriter, r *http.Request) {
+	var req simulation.RewireSimulationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
+		return
+	}
+
+	result, err := h.SimulationService.RunRewireSimulation(r.Context(), req)
 	if err != nil {
-		errMsg := err.Error()
-		if strings.Contains(errMsg, "connection refused") || strings.Contains(errMsg, "request failed") {
-			respondError(w, http.StatusInternalServerError, "Failed to fetch cluster state: ")
-			return
-		}
-		handleSimulationError(w, err)
+		respondError(w, r, err)
 		return
 	}
 
 	respondJSON(w, http.StatusOK, result)
 }
 
-func handleSimulationError(w http.ResponseWriter, err error) {
-	errMsg := err.Error()
-	if strings.Contains(errMsg, "Service not found") {
-		respondError(w, http.StatusNotFound, errMsg)
+// SimulateDrainHandler godoc
+// @Summary Simulate Draining Nodes
+// @Description Re-places every pod on the given nodes onto the remaining nodes, using each pod's observed CPU and RAM usage as its requests. Reports the pods that cannot be rescheduled and the resulting utilization of each surviving node.
+// @Tags simulation
+// @Accept json
+// @Produce json
+// @Param request body simulation.DrainSimulationRequest true "Simulation parameters"
+// @Success 200 {object} simulation.DrainSimulationResult
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 504 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /v2/simulate/drain [post]
+func (h *Handler) SimulateDrainHandler(w http.ResponseWriter, r *http.Request) {
+	var req simulation.DrainSimulationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
 		return
 	}
-	if strings.Contains(errMsg, "maxDepth") || strings.Contains(errMsg, "must be") || strings.Contains(errMsg, "Invalid") {
-		respondError(w, http.StatusBadRequest, errMsg)
+
+	result, err := h.SimulationService.RunDrainSimulation(r.Context(), req)
+	if err != nil {
+		respondError(w, r, err)
 		return
 	}
-	if strings.Contains(errMsg, "connection refused") || strings.Contains(errMsg, "request failed") {
-		respondError(w, http.StatusServiceUnavailable, "Graph API unavailable: ")
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// SimulateAddHandler godoc
+// @Summary Simulate Adding Service
+// @Description Simulates adding a new service to the cluster (capacity planning)
+// @Tags simulation
+// @Accept json
+// @Produce json
+// @Param request body simulation.AddSimulationRequest true "Simulation parameters"
+// @Success 200 {object} simulation.AddSimulationResult
+// @Failure 400 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 504 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Deprecated
+// @Router /simulate/add [post]
+// @Router /v1/simulate/add [post]
+func (h *Handler) SimulateAddHandler(w http.ResponseWriter, r *http.Request) {
+	var req simulation.AddSimulationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
+		return
+	}
+	if req.CPURequest <= 0 || req.RAMRequest <= 0 || req.Replicas <= 0 {
+		respondError(w, r, common.NewError(common.CodeInvalidResourceRequest, "Invalid resource requests: cpu, ram, and replicas must be positive"))
 		return
 	}
-	if strings.Contains(errMsg, "No nodes found") {
-		respondError(w, http.StatusInternalServerError, errMsg)
+
+	result, err := h.SimulationService.RunAddSimulation(r.Context(), req)
+	if err != nil {
+		respondError(w, r, err)
 		return
 	}
 
-	logger.Error("Simulation error", err)
-	respondError(w, http.StatusInternalServerError, "Internal server error")
+	respondJSON(w, http.StatusOK, result)
 }
@@ -2,7 +2,9 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"predictive-analysis-engine/pkg/common"
@@ -46,6 +48,17 @@ func CorrelationMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// DeprecationMiddleware marks v1 (and unversioned legacy) responses as
+// deprecated and links the equivalent v2 route as the successor.
+func DeprecationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		successor := "/v2" + strings.TrimPrefix(r.URL.Path, "/v1")
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, successor))
+		next.ServeHTTP(w, r)
+	})
+}
+
 type statusWriter struct {
 	http.ResponseWriter
 	status int
@@ -0,0 +1,184 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/outcome"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+type OutcomesHandler struct {
+	Store     storage.DecisionRepository
+	Evaluator *outcome.Evaluator
+}
+
+type RecordOutcomeRequest struct {
+	ObservedMs *float64 `json:"observedMs"`
+	Metric     string   `json:"metric,omitempty"`
+	Notes      string   `json:"notes,omitempty"`
+}
+
+type AccuracyResponse struct {
+	Since  string                  `json:"since,omitempty"`
+	Models []storage.ModelAccuracy `json:"models"`
+}
+
+func (h *OutcomesHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/decisions/accuracy", h.GetAccuracy)
+	r.Get("/decisions/{decisionId}/outcome", h.GetOutcome)
+	r.Post("/decisions/{decisionId}/outcome", h.RecordOutcome)
+	r.Post("/decisions/{decisionId}/outcome/evaluate", h.EvaluateOutcome)
+}
+
+// RecordOutcome godoc
+// @Summary Record Decision Outcome
+// @Description Attaches a manually observed latency to a decision, replacing any previous outcome. For scaling decisions the projected latency is copied from the stored result so the outcome counts towards model accuracy.
+// @Tags decisions
+// @Accept json
+// @Produce json
+// @Param decisionId path int true "Decision ID"
+// @Param request body RecordOutcomeRequest true "Observed outcome"
+// @Success 201 {object} storage.Outcome
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/{decisionId}/outcome [post]
+// @Router /v1/decisions/{decisionId}/outcome [post]
+// @Router /v2/decisions/{decisionId}/outcome [post]
+func (h *OutcomesHandler) RecordOutcome(w http.ResponseWriter, r *http.Request) {
+	var req RecordOutcomeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
+		return
+	}
+	if req.ObservedMs == nil || *req.ObservedMs < 0 {
+		respondError(w, r, common.NewError(common.CodeValidationFailed, "observedMs is required and must be non-negative"))
+		return
+	}
+	if req.Metric != "" && req.Metric != "p50" && req.Metric != "p95" && req.Metric != "p99" {
+		respondError(w, r, common.NewError(common.CodeInvalidLatencyMetric, "Invalid metric: %s. Allowed: p50, p95, p99", req.Metric))
+		return
+	}
+
+	rec, err := lookupDecision(h.Store, r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	o, err := h.Evaluator.RecordManual(rec, *req.ObservedMs, req.Metric, req.Notes)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusCreated, o)
+}
+
+// EvaluateOutcome godoc
+// @Summary Evaluate Decision Outcome
+// @Description Queries service_metrics for the target of a scaling decision after its change window and records the observed latency against the projected latency. Nothing is recorded (422 NO_OBSERVED_DATA) unless the target ran the simulated newPods during the window; record a manual outcome for changes made differently.
+// @Tags decisions
+// @Produce json
+// @Param decisionId path int true "Decision ID"
+// @Success 201 {object} storage.Outcome
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 409 {object} api.Problem
+// @Failure 422 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/{decisionId}/outcome/evaluate [post]
+// @Router /v1/decisions/{decisionId}/outcome/evaluate [post]
+// @Router /v2/decisions/{decisionId}/outcome/evaluate [post]
+func (h *OutcomesHandler) EvaluateOutcome(w http.ResponseWriter, r *http.Request) {
+	rec, err := lookupDecision(h.Store, r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	o, err := h.Evaluator.Evaluate(r.Context(), rec)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusCreated, o)
+}
+
+// GetOutcome godoc
+// @Summary Get Decision Outcome
+// @Description Returns the observed outcome attached to a decision
+// @Tags decisions
+// @Produce json
+// @Param decisionId path int true "Decision ID"
+// @Success 200 {object} storage.Outcome
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/{decisionId}/outcome [get]
+// @Router /v1/decisions/{decisionId}/outcome [get]
+// @Router /v2/decisions/{decisionId}/outcome [get]
+func (h *OutcomesHandler) GetOutcome(w http.ResponseWriter, r *http.Request) {
+	rec, err := lookupDecision(h.Store, r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	o, err := h.Store.GetOutcome(rec.ID)
+	if errors.Is(err, storage.ErrOutcomeNotFound) {
+		respondError(w, r, common.NewError(common.CodeNotFound, "No outcome recorded for decision %d", rec.ID))
+		return
+	}
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, o)
+}
+
+// GetAccuracy godoc
+// @Summary Get Prediction Accuracy
+// @Description Aggregates the error between projected and observed latency per scaling model type. errorMs is predicted minus observed, so a positive bias means the model over-estimates latency.
+// @Tags decisions
+// @Produce json
+// @Param since query string false "Only include outcomes recorded at or after this timestamp (ISO 8601)"
+// @Success 200 {object} AccuracyResponse
+// @Failure 400 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/accuracy [get]
+// @Router /v1/decisions/accuracy [get]
+// @Router /v2/decisions/accuracy [get]
+func (h *OutcomesHandler) GetAccuracy(w http.ResponseWriter, r *http.Request) {
+	if h.Store == nil {
+		respondError(w, r, errStoreUnavailable)
+		return
+	}
+
+	since := r.URL.Query().Get("since")
+	if since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			respondError(w, r, common.NewError(common.CodeInvalidTimestamp, "Invalid timestamp format. Use ISO 8601 (e.g., 2026-01-04T10:00:00Z)"))
+			return
+		}
+		since = t.UTC().Format(time.RFC3339)
+	}
+
+	models, err := h.Store.GetAccuracy(since)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, AccuracyResponse{Since: since, Models: models})
+}
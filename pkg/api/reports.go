@@ -0,0 +1,124 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/logger"
+	"predictive-analysis-engine/pkg/report"
+	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+type ReportsHandler struct {
+	Store storage.DecisionReader
+}
+
+func (h *ReportsHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/reports/failure/{decisionId}", h.FailureReport)
+	r.Get("/reports/scaling/{decisionId}", h.ScalingReport)
+}
+
+// FailureReport godoc
+// @Summary Failure Impact Report
+// @Description Renders a stored failure simulation decision as a standalone Markdown or self-contained HTML report
+// @Tags reports
+// @Produce text/markdown,text/html
+// @Param decisionId path int true "Decision ID"
+// @Param format query string false "Report format: markdown (default) or html"
+// @Success 200 {string} string
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /reports/failure/{decisionId} [get]
+// @Router /v1/reports/failure/{decisionId} [get]
+// @Router /v2/reports/failure/{decisionId} [get]
+func (h *ReportsHandler) FailureReport(w http.ResponseWriter, r *http.Request) {
+	format, meta, rec, ok := h.loadDecision(w, r, "failure")
+	if !ok {
+		return
+	}
+
+	var res simulation.FailureSimulationResult
+	if err := decodeStored(rec, &res); err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondReport(w, format, report.Failure(meta, &res))
+}
+
+// ScalingReport godoc
+// @Summary Scaling Impact Report
+// @Description Renders a stored scaling simulation decision as a standalone Markdown or self-contained HTML report
+// @Tags reports
+// @Produce text/markdown,text/html
+// @Param decisionId path int true "Decision ID"
+// @Param format query string false "Report format: markdown (default) or html"
+// @Success 200 {string} string
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /reports/scaling/{decisionId} [get]
+// @Router /v1/reports/scaling/{decisionId} [get]
+// @Router /v2/reports/scaling/{decisionId} [get]
+func (h *ReportsHandler) ScalingReport(w http.ResponseWriter, r *http.Request) {
+	format, meta, rec, ok := h.loadDecision(w, r, "scaling")
+	if !ok {
+		return
+	}
+
+	var res simulation.ScalingSimulationResult
+	if err := decodeStored(rec, &res); err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondReport(w, format, report.Scaling(meta, &res))
+}
+
+// loadDecision validates the request and fetches the decision of the given
+// type. It writes the error response itself and reports whether to continue.
+func (h *ReportsHandler) loadDecision(w http.ResponseWriter, r *http.Request, decisionType string) (string, report.Meta, *storage.DecisionRecord, bool) {
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if format == "" {
+		format = report.FormatMarkdown
+	}
+	if !report.IsSupported(format) {
+		respondError(w, r, common.NewError(common.CodeInvalidParameter, "Invalid format: %s. Allowed: markdown, html", format))
+		return "", report.Meta{}, nil, false
+	}
+
+	rec, err := lookupDecision(h.Store, r)
+	if err != nil {
+		respondError(w, r, err)
+		return "", report.Meta{}, nil, false
+	}
+	if rec.Type != decisionType {
+		respondError(w, r, common.NewError(common.CodeNotFound, "Decision %d is a %s decision, not %s", rec.ID, rec.Type, decisionType))
+		return "", report.Meta{}, nil, false
+	}
+
+	meta := report.Meta{DecisionID: rec.ID, Timestamp: rec.Timestamp, CorrelationID: rec.CorrelationID}
+	return format, meta, rec, true
+}
+
+// decodeStored converts a decision payload read back from the store into its
+// typed simulation result.
+func decodeStored(rec *storage.DecisionRecord, dest interface{}) error {
+	if err := rec.DecodeResult(dest); err != nil {
+		return common.WrapError(common.CodeInternal, err, "Stored decision could not be decoded")
+	}
+	return nil
+}
+
+func respondReport(w http.ResponseWriter, format string, rep *report.Report) {
+	w.Header().Set("Content-Type", report.ContentType(format))
+	w.WriteHeader(http.StatusOK)
+	if err := report.Render(w, format, rep); err != nil {
+		logger.Error("Failed to render report", err)
+	}
+}
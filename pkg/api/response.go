@@ -2,9 +2,86 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/logger"
 )
 
+// Problem is an RFC 7807 problem details body. Code and CorrelationID are
+// extension members; Extensions carries endpoint-specific extra members.
+type Problem struct {
+	Type          string                 `json:"type"`
+	Title         string                 `json:"title"`
+	Status        int                    `json:"status"`
+	Detail        string                 `json:"detail,omitempty"`
+	Instance      string                 `json:"instance,omitempty"`
+	Code          common.ErrorCode       `json:"code"`
+	CorrelationID string                 `json:"correlationId"`
+	Extensions    map[string]interface{} `json:"-"`
+}
+
+func (p Problem) MarshalJSON() ([]byte, error) {
+	type plain Problem
+	base, err := json.Marshal(plain(p))
+	if err != nil || len(p.Extensions) == 0 {
+		return base, err
+	}
+
+	merged := make(map[string]interface{}, len(p.Extensions)+7)
+	for k, v := range p.Extensions {
+		merged[k] = v
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(base, &fields); err != nil {
+		return nil, err
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return json.Marshal(merged)
+}
+
+var statusByCode = map[common.ErrorCode]int{
+	common.CodeInvalidRequestBody:       http.StatusBadRequest,
+	common.CodeValidationFailed:         http.StatusBadRequest,
+	common.CodeMissingParameter:         http.StatusBadRequest,
+	common.CodeInvalidParameter:         http.StatusBadRequest,
+	common.CodeInvalidTimestamp:         http.StatusBadRequest,
+	common.CodeTimeRangeTooLarge:        http.StatusBadRequest,
+	common.CodeDepthOutOfRange:          http.StatusBadRequest,
+	common.CodeInvalidLatencyMetric:     http.StatusBadRequest,
+	common.CodeInvalidPodCount:          http.StatusBadRequest,
+	common.CodeInvalidScalingModel:      http.StatusBadRequest,
+	common.CodeInvalidAlpha:             http.StatusBadRequest,
+	common.CodeInvalidResourceRequest:   http.StatusBadRequest,
+	common.CodeInvalidMetric:            http.StatusBadRequest,
+	common.CodeInvalidDecisionType:      http.StatusBadRequest,
+	common.CodeServiceNotFound:          http.StatusNotFound,
+	common.CodeNotFound:                 http.StatusNotFound,
+	common.CodeMethodNotAllowed:         http.StatusMethodNotAllowed,
+	common.CodeNoNodesFound:             http.StatusServiceUnavailable,
+	common.CodeUpstreamUnavailable:      http.StatusServiceUnavailable,
+	common.CodeUpstreamTimeout:          http.StatusGatewayTimeout,
+	common.CodeUpstreamError:            http.StatusBadGateway,
+	common.CodeTelemetryUnavailable:     http.StatusServiceUnavailable,
+	common.CodeDecisionStoreUnavailable: http.StatusServiceUnavailable,
+	common.CodeChangeWindowOpen:         http.StatusConflict,
+	common.CodeNoObservedData:           http.StatusUnprocessableEntity,
+	common.CodeInvalidTransition:        http.StatusConflict,
+	common.CodeAlreadyExists:            http.StatusConflict,
+	common.CodeInternal:                 http.StatusInternalServerError,
+}
+
+// statusForCode returns the HTTP status for an error code, defaulting to 500.
+func statusForCode(code common.ErrorCode) int {
+	if status, ok := statusByCode[code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
 // respondJSON writes a JSON response with the given status code.
 func respondJSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -14,7 +91,49 @@ func respondJSON(w http.ResponseWriter, status int, data interface{}) {
 	}
 }
 
-// respondError writes a structured error response.
-func respondError(w http.ResponseWriter, status int, message string) {
-	respondJSON(w, status, map[string]string{"error": message})
+// newProblem builds a problem for the request, deriving status and title from code.
+func newProblem(r *http.Request, code common.ErrorCode, detail string) Problem {
+	status := statusForCode(code)
+	return Problem{
+		Type:          "about:blank",
+		Title:         http.StatusText(status),
+		Status:        status,
+		Detail:        detail,
+		Instance:      r.URL.Path,
+		Code:          code,
+		CorrelationID: common.GetCorrelationID(r.Context()),
+	}
+}
+
+// respondProblem writes an application/problem+json response.
+func respondProblem(w http.ResponseWriter, p Problem) {
+	w.Header().Set("Content-Type", "application/problem+json; charset=utf-8")
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+// respondError writes err as a problem response. Typed errors keep their code
+// and client-safe message; anything else is logged and reported as INTERNAL_ERROR.
+func respondError(w http.ResponseWriter, r *http.Request, err error) {
+	var appErr *common.Error
+	if !errors.As(err, &appErr) {
+		logger.Error("Unhandled error", err)
+		respondProblem(w, newProblem(r, common.CodeInternal, "Internal server error"))
+		return
+	}
+
+	if statusForCode(appErr.Code) >= http.StatusInternalServerError {
+		logger.Error(appErr.Message, err)
+	}
+	respondProblem(w, newProblem(r, appErr.Code, appErr.Message))
+}
+
+// NotFoundHandler reports unknown routes as problem responses.
+func NotFoundHandler(w http.ResponseWriter, r *http.Request) {
+	respondProblem(w, newProblem(r, common.CodeNotFound, "No route matches "+r.URL.Path))
+}
+
+// MethodNotAllowedHandler reports unsupported methods as problem responses.
+func MethodNotAllowedHandler(w http.ResponseWriter, r *http.Request) {
+	respondProblem(w, newProblem(r, common.CodeMethodNotAllowed, r.Method+" is not supported for "+r.URL.Path))
 }
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"predictive-analysis-engine/pkg/retention"
+)
+
+type RetentionHandler struct {
+	Pruner *retention.Pruner
+}
+
+type RetentionPoliciesResponse struct {
+	Policies []retention.Policy `json:"policies"`
+}
+
+func (h *RetentionHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/decisions/retention", h.GetPolicies)
+	r.Post("/decisions/retention/run", h.RunPruner)
+}
+
+// GetPolicies godoc
+// @Summary Get Retention Policies
+// @Description Lists the active decision retention rules. Type "*" applies to every decision type without its own rule.
+// @Tags decisions
+// @Produce json
+// @Success 200 {object} RetentionPoliciesResponse
+// @Router /decisions/retention [get]
+// @Router /v1/decisions/retention [get]
+// @Router /v2/decisions/retention [get]
+func (h *RetentionHandler) GetPolicies(w http.ResponseWriter, r *http.Request) {
+	policies := h.Pruner.Policies()
+	if policies == nil {
+		policies = []retention.Policy{}
+	}
+	respondJSON(w, http.StatusOK, RetentionPoliciesResponse{Policies: policies})
+}
+
+// RunPruner godoc
+// @Summary Run Decision Pruner. Approved and applied decisions are kept regardless of age as the audit record of a change.
+// @Description Deletes decisions past their retention period, archiving them to a gzip-compressed NDJSON file in RETENTION_ARCHIVE_DIR first unless RETENTION_ARCHIVE_ENABLED=false
+// @Tags decisions
+// @Produce json
+// @Success 200 {object} retention.Result
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /decisions/retention/run [post]
+// @Router /v1/decisions/retention/run [post]
+// @Router /v2/decisions/retention/run [post]
+func (h *RetentionHandler) RunPruner(w http.ResponseWriter, r *http.Request) {
+	res, err := h.Pruner.Run(r.Context())
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	if res.Policies == nil {
+		res.Policies = []retention.Policy{}
+	}
+	respondJSON(w, http.StatusOK, res)
+}
@@ -0,0 +1,325 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/slo"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+const maxSLOWindowDays = 30
+
+type SLOsHandler struct {
+	Store             storage.SLOStore
+	Evaluator         *slo.Evaluator
+	DefaultWindowDays int
+}
+
+// SLORequest creates or replaces an SLO. At least one of availabilityTarget
+// and latencyTargetMs is required.
+type SLORequest struct {
+	ServiceID          string   `json:"serviceId"`
+	Name               string   `json:"name"`
+	AvailabilityTarget *float64 `json:"availabilityTarget,omitempty"`
+	LatencyMetric      string   `json:"latencyMetric,omitempty"`
+	LatencyTargetMs    *float64 `json:"latencyTargetMs,omitempty"`
+	LatencyObjective   *float64 `json:"latencyObjective,omitempty"`
+	WindowDays         int      `json:"windowDays,omitempty"`
+}
+
+type SLOsResponse struct {
+	SLOs  []storage.SLO `json:"slos"`
+	Count int           `json:"count"`
+}
+
+func (h *SLOsHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/slos", h.CreateSLO)
+	r.Get("/slos", h.ListSLOs)
+	r.Get("/slos/{sloId}", h.GetSLO)
+	r.Put("/slos/{sloId}", h.UpdateSLO)
+	r.Delete("/slos/{sloId}", h.DeleteSLO)
+	r.Get("/slos/{sloId}/status", h.GetSLOStatus)
+}
+
+// CreateSLO godoc
+// @Summary Create an SLO
+// @Description Registers an SLO for a service with an availability target, a latency target on p95 or p99, or both. The latency objective is the fraction of time the percentile must stay within the target (default 0.99).
+// @Tags slos
+// @Accept json
+// @Produce json
+// @Param request body SLORequest true "SLO"
+// @Success 201 {object} storage.SLO
+// @Failure 400 {object} api.Problem
+// @Failure 409 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /slos [post]
+// @Router /v1/slos [post]
+// @Router /v2/slos [post]
+func (h *SLOsHandler) CreateSLO(w http.ResponseWriter, r *http.Request) {
+	if h.Store == nil {
+		respondError(w, r, errStoreUnavailable)
+		return
+	}
+
+	s, err := h.decodeSLO(r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	created, err := h.Store.CreateSLO(s)
+	if err != nil {
+		respondError(w, r, sloError(err, 0))
+		return
+	}
+	respondJSON(w, http.StatusCreated, created)
+}
+
+// ListSLOs godoc
+// @Summary List SLOs
+// @Description Lists registered SLOs, optionally for one service
+// @Tags slos
+// @Produce json
+// @Param serviceId query string false "Service ID (namespace:name, or name in the default namespace)"
+// @Success 200 {object} SLOsResponse
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /slos [get]
+// @Router /v1/slos [get]
+// @Router /v2/slos [get]
+func (h *SLOsHandler) ListSLOs(w http.ResponseWriter, r *http.Request) {
+	if h.Store == nil {
+		respondError(w, r, errStoreUnavailable)
+		return
+	}
+
+	var serviceID string
+	if v := r.URL.Query().Get("serviceId"); v != "" {
+		serviceID = simulation.CanonicalServiceId(v)
+	}
+	slos, err := h.Store.ListSLOs(serviceID)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, SLOsResponse{SLOs: slos, Count: len(slos)})
+}
+
+// GetSLO godoc
+// @Summary Get an SLO
+// @Tags slos
+// @Produce json
+// @Param sloId path int true "SLO ID"
+// @Success 200 {object} storage.SLO
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /slos/{sloId} [get]
+// @Router /v1/slos/{sloId} [get]
+// @Router /v2/slos/{sloId} [get]
+func (h *SLOsHandler) GetSLO(w http.ResponseWriter, r *http.Request) {
+	s, err := h.lookupSLO(r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, s)
+}
+
+// UpdateSLO godoc
+// @Summary Replace an SLO
+// @Description Replaces every field of an SLO. Objectives left out of the request are removed.
+// @Tags slos
+// @Accept json
+// @Produce json
+// @Param sloId path int true "SLO ID"
+// @Param request body SLORequest true "SLO"
+// @Success 200 {object} storage.SLO
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 409 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /slos/{sloId} [put]
+// @Router /v1/slos/{sloId} [put]
+// @Router /v2/slos/{sloId} [put]
+func (h *SLOsHandler) UpdateSLO(w http.ResponseWriter, r *http.Request) {
+	if h.Store == nil {
+		respondError(w, r, errStoreUnavailable)
+		return
+	}
+
+	id, err := parseSLOID(r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	s, err := h.decodeSLO(r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	s.ID = id
+	updated, err := h.Store.UpdateSLO(s)
+	if err != nil {
+		respondError(w, r, sloError(err, id))
+		return
+	}
+	respondJSON(w, http.StatusOK, updated)
+}
+
+// DeleteSLO godoc
+// @Summary Delete an SLO
+// @Tags slos
+// @Param sloId path int true "SLO ID"
+// @Success 204
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /slos/{sloId} [delete]
+// @Router /v1/slos/{sloId} [delete]
+// @Router /v2/slos/{sloId} [delete]
+func (h *SLOsHandler) DeleteSLO(w http.ResponseWriter, r *http.Request) {
+	if h.Store == nil {
+		respondError(w, r, errStoreUnavailable)
+		return
+	}
+
+	id, err := parseSLOID(r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	if err := h.Store.DeleteSLO(id); err != nil {
+		respondError(w, r, sloError(err, id))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetSLOStatus godoc
+// @Summary Get SLO Compliance
+// @Description Computes compliance over the SLO window, remaining error budget and burn rates over trailing windows from service_metrics. An objective is at_risk when a fast or slow multi-window burn rate alert is firing and breached once its budget is spent.
+// @Tags slos
+// @Produce json
+// @Param sloId path int true "SLO ID"
+// @Success 200 {object} slo.Status
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /slos/{sloId}/status [get]
+// @Router /v1/slos/{sloId}/status [get]
+// @Router /v2/slos/{sloId}/status [get]
+func (h *SLOsHandler) GetSLOStatus(w http.ResponseWriter, r *http.Request) {
+	s, err := h.lookupSLO(r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	status, err := h.Evaluator.Evaluate(r.Context(), *s)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, status)
+}
+
+func (h *SLOsHandler) decodeSLO(r *http.Request) (storage.SLO, error) {
+	var req SLORequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return storage.SLO{}, common.NewError(common.CodeInvalidRequestBody, "Invalid request body")
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.ServiceID == "" || req.Name == "" {
+		return storage.SLO{}, common.NewError(common.CodeValidationFailed, "Missing required fields: serviceId, name")
+	}
+	if req.AvailabilityTarget == nil && req.LatencyTargetMs == nil {
+		return storage.SLO{}, common.NewError(common.CodeValidationFailed, "At least one of availabilityTarget and latencyTargetMs is required")
+	}
+	if t := req.AvailabilityTarget; t != nil && (*t <= 0 || *t >= 1) {
+		return storage.SLO{}, common.NewError(common.CodeValidationFailed, "availabilityTarget must be between 0 and 1 exclusive")
+	}
+
+	s := storage.SLO{
+		ServiceID:          simulation.CanonicalServiceId(req.ServiceID),
+		Name:               req.Name,
+		AvailabilityTarget: req.AvailabilityTarget,
+		WindowDays:         req.WindowDays,
+	}
+	if req.LatencyTargetMs != nil {
+		if *req.LatencyTargetMs <= 0 {
+			return storage.SLO{}, common.NewError(common.CodeValidationFailed, "latencyTargetMs must be positive")
+		}
+		metric := req.LatencyMetric
+		if metric == "" {
+			metric = "p95"
+		}
+		if !slices.Contains(slo.LatencyMetrics(), metric) {
+			return storage.SLO{}, common.NewError(common.CodeInvalidLatencyMetric, "Invalid latencyMetric: %s. Allowed: %s", metric, strings.Join(slo.LatencyMetrics(), ", "))
+		}
+		objective := slo.DefaultLatencyObjective
+		if req.LatencyObjective != nil {
+			objective = *req.LatencyObjective
+		}
+		if objective <= 0 || objective >= 1 {
+			return storage.SLO{}, common.NewError(common.CodeValidationFailed, "latencyObjective must be between 0 and 1 exclusive")
+		}
+		s.LatencyMetric, s.LatencyTargetMs, s.LatencyObjective = metric, req.LatencyTargetMs, &objective
+	} else if req.LatencyMetric != "" || req.LatencyObjective != nil {
+		return storage.SLO{}, common.NewError(common.CodeValidationFailed, "latencyMetric and latencyObjective require latencyTargetMs")
+	}
+
+	if s.WindowDays == 0 {
+		s.WindowDays = h.DefaultWindowDays
+	}
+	if s.WindowDays < 1 || s.WindowDays > maxSLOWindowDays {
+		return storage.SLO{}, common.NewError(common.CodeValidationFailed, "windowDays must be between 1 and %d", maxSLOWindowDays)
+	}
+	return s, nil
+}
+
+func (h *SLOsHandler) lookupSLO(r *http.Request) (*storage.SLO, error) {
+	if h.Store == nil {
+		return nil, errStoreUnavailable
+	}
+	id, err := parseSLOID(r)
+	if err != nil {
+		return nil, err
+	}
+	s, err := h.Store.GetSLO(id)
+	if err != nil {
+		return nil, sloError(err, id)
+	}
+	return s, nil
+}
+
+func parseSLOID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "sloId"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, common.NewError(common.CodeInvalidParameter, "sloId must be a positive integer")
+	}
+	return id, nil
+}
+
+func sloError(err error, id int64) error {
+	switch {
+	case errors.Is(err, storage.ErrSLONotFound):
+		return common.NewError(common.CodeNotFound, "SLO not found: %d", id)
+	case errors.Is(err, storage.ErrSLOExists):
+		return common.NewError(common.CodeAlreadyExists, "%s", err.Error())
+	}
+	return err
+}
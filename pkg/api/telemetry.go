@@ -2,12 +2,12 @@ package api
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 
 	"predictive-analysis-engine/pkg/clients/telemetry"
+	"predictive-analysis-engine/pkg/common"
 	"predictive-analysis-engine/pkg/config"
 
 	"github.com/go-chi/chi/v5"
@@ -37,14 +37,16 @@ func (h *TelemetryHandler) Routes() chi.Router {
 // @Param to query string true "End timestamp (ISO 8601)"
 // @Param step query int false "Step size in seconds" default(60)
 // @Success 200 {object} map[string]interface{}
-// @Failure 400 {object} map[string]string
-// @Failure 503 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
 // @Router /telemetry/service [get]
+// @Router /v1/telemetry/service [get]
+// @Router /v2/telemetry/service [get]
 func (h *TelemetryHandler) GetServiceMetrics(w http.ResponseWriter, r *http.Request) {
 	enabled, reason := h.Client.CheckStatus()
 	if !enabled {
-		http.Error(w, fmt.Sprintf(`{"error": "%s"}`, reason), http.StatusServiceUnavailable)
+		respondError(w, r, common.NewError(common.CodeTelemetryUnavailable, "%s", reason))
 		return
 	}
 
@@ -54,23 +56,23 @@ func (h *TelemetryHandler) GetServiceMetrics(w http.ResponseWriter, r *http.Requ
 	stepStr := r.URL.Query().Get("step")
 
 	if fromStr == "" || toStr == "" {
-		http.Error(w, `{"error": "Missing required parameters: from, to"}`, http.StatusBadRequest)
+		respondError(w, r, common.NewError(common.CodeMissingParameter, "Missing required parameters: from, to"))
 		return
 	}
 
 	from, err := time.Parse(time.RFC3339, fromStr)
 	if err != nil {
-		http.Error(w, `{"error": "Invalid timestamp format"}`, http.StatusBadRequest)
+		respondError(w, r, common.NewError(common.CodeInvalidTimestamp, "Invalid timestamp format"))
 		return
 	}
 	to, err := time.Parse(time.RFC3339, toStr)
 	if err != nil {
-		http.Error(w, `{"error": "Invalid timestamp format"}`, http.StatusBadRequest)
+		respondError(w, r, common.NewError(common.CodeInvalidTimestamp, "Invalid timestamp format"))
 		return
 	}
 
 	if to.Sub(from) > MaxTimeRange {
-		http.Error(w, `{"error": "Time range exceeds maximum of 7 days"}`, http.StatusBadRequest)
+		respondError(w, r, common.NewError(common.CodeTimeRangeTooLarge, "Time range exceeds maximum of 7 days"))
 		return
 	}
 
@@ -83,9 +85,7 @@ func (h *TelemetryHandler) GetServiceMetrics(w http.ResponseWriter, r *http.Requ
 
 	metrics, err := h.Client.GetServiceMetrics(r.Context(), service, fromStr, toStr, step)
 	if err != nil {
-		fmt.Printf("[Telemetry Error] Service metrics failed: %v\n", err)
-
-		http.Error(w, `{"error": "Internal server error"}`, http.StatusInternalServerError)
+		respondError(w, r, common.WrapError(common.CodeUpstreamError, err, "Service metrics query failed"))
 		return
 	}
 
@@ -115,14 +115,16 @@ func (h *TelemetryHandler) GetServiceMetrics(w http.ResponseWriter, r *http.Requ
 // @Param to query string true "End timestamp (ISO 8601)"
 // @Param step query int false "Step size in seconds" default(60)
 // @Success 200 {object} map[string]interface{}
-// @Failure 400 {object} map[string]string
-// @Failure 503 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
 // @Router /telemetry/edges [get]
+// @Router /v1/telemetry/edges [get]
+// @Router /v2/telemetry/edges [get]
 func (h *TelemetryHandler) GetEdgeMetrics(w http.ResponseWriter, r *http.Request) {
 	enabled, reason := h.Client.CheckStatus()
 	if !enabled {
-		http.Error(w, fmt.Sprintf(`{"error": "%s"}`, reason), http.StatusServiceUnavailable)
+		respondError(w, r, common.NewError(common.CodeTelemetryUnavailable, "%s", reason))
 		return
 	}
 
@@ -133,23 +135,23 @@ func (h *TelemetryHandler) GetEdgeMetrics(w http.ResponseWriter, r *http.Request
 	stepStr := r.URL.Query().Get("step")
 
 	if fromStr == "" || toStr == "" {
-		http.Error(w, `{"error": "Missing required parameters: from, to"}`, http.StatusBadRequest)
+		respondError(w, r, common.NewError(common.CodeMissingParameter, "Missing required parameters: from, to"))
 		return
 	}
 
 	from, err := time.Parse(time.RFC3339, fromStr)
 	if err != nil {
-		http.Error(w, `{"error": "Invalid timestamp format"}`, http.StatusBadRequest)
+		respondError(w, r, common.NewError(common.CodeInvalidTimestamp, "Invalid timestamp format"))
 		return
 	}
 	to, err := time.Parse(time.RFC3339, toStr)
 	if err != nil {
-		http.Error(w, `{"error": "Invalid timestamp format"}`, http.StatusBadRequest)
+		respondError(w, r, common.NewError(common.CodeInvalidTimestamp, "Invalid timestamp format"))
 		return
 	}
 
 	if to.Sub(from) > MaxTimeRange {
-		http.Error(w, `{"error": "Time range exceeds maximum of 7 days"}`, http.StatusBadRequest)
+		respondError(w, r, common.NewError(common.CodeTimeRangeTooLarge, "Time range exceeds maximum of 7 days"))
 		return
 	}
 
@@ -162,8 +164,7 @@ func (h *TelemetryHandler) GetEdgeMetrics(w http.ResponseWriter, r *http.Request
 
 	metrics, err := h.Client.GetEdgeMetrics(r.Context(), fromSvc, toSvc, fromStr, toStr, step)
 	if err != nil {
-		fmt.Printf("[Telemetry Error] Edge metrics failed: %v\n", err)
-		http.Error(w, `{"error": "Internal server error"}`, http.StatusInternalServerError)
+		respondError(w, r, common.WrapError(common.CodeUpstreamError, err, "Edge metrics query failed"))
 		return
 	}
 
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+const (
+	defaultTopologyEventLimit = 100
+	maxTopologyEventLimit     = 1000
+)
+
+var topologyEventKinds = []string{storage.TopologyKindCycleDetected, storage.TopologyKindCycleResolved}
+
+type TopologyHandler struct {
+	Store storage.TopologyStore
+}
+
+type TopologyEventsResponse struct {
+	Events []storage.TopologyEvent `json:"events"`
+	Count  int                     `json:"count"`
+}
+
+// ListEvents godoc
+// @Summary List Topology Risk Events
+// @Description Returns risky changes in the dependency topology found by the poll worker, most recent first. A cycle_detected event is raised the first time a dependency cycle appears in a snapshot, and again if it disappears and returns; cycle_resolved marks its disappearance. Components with too many cycles to enumerate are reported as a whole, keyed scc:<services>.
+// @Tags risk
+// @Produce json
+// @Param from query string false "Detected at or after (RFC3339)"
+// @Param to query string false "Detected at or before (RFC3339)"
+// @Param kind query string false "Comma-separated kinds: cycle_detected, cycle_resolved"
+// @Param limit query int false "Maximum number of events, at most 1000" default(100)
+// @Success 200 {object} TopologyEventsResponse
+// @Failure 400 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /risk/topology/events [get]
+// @Router /v1/risk/topology/events [get]
+// @Router /v2/risk/topology/events [get]
+func (h *TopologyHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	if h.Store == nil {
+		respondError(w, r, errStoreUnavailable)
+		return
+	}
+
+	q := r.URL.Query()
+	f := storage.TopologyEventFilter{Kinds: splitList(q.Get("kind")), Limit: defaultTopologyEventLimit}
+	for _, k := range f.Kinds {
+		if !slices.Contains(topologyEventKinds, k) {
+			respondError(w, r, common.NewError(common.CodeInvalidParameter, "Invalid kind: %s. Allowed: %s", k, strings.Join(topologyEventKinds, ", ")))
+			return
+		}
+	}
+	for _, bound := range []struct {
+		name string
+		dest *string
+	}{{"from", &f.From}, {"to", &f.To}} {
+		v := q.Get(bound.name)
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			respondError(w, r, common.NewError(common.CodeInvalidTimestamp, "Invalid %s timestamp. Use ISO 8601 (e.g., 2026-01-04T10:00:00Z)", bound.name))
+			return
+		}
+		*bound.dest = t.UTC().Format(time.RFC3339)
+	}
+	if v := q.Get("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l <= 0 || l > maxTopologyEventLimit {
+			respondError(w, r, common.NewError(common.CodeInvalidParameter, "limit must be between 1 and %d", maxTopologyEventLimit))
+			return
+		}
+		f.Limit = l
+	}
+
+	events, err := h.Store.ListTopologyEvents(f)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, TopologyEventsResponse{Events: events, Count: len(events)})
+}
@@ -0,0 +1,292 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/simulation"
+)
+
+// The v2 response types drop the legacy compatibility fields carried by the
+// v1 payloads and always report canonical "namespace:name" service IDs.
+
+type RecommendationV2 struct {
+	Type     string `json:"type"`
+	Priority string `json:"priority"`
+	Target   string `json:"target,omitempty"`
+	Reason   string `json:"reason"`
+	Action   string `json:"action,omitempty"`
+}
+
+type FailureSimulationResultV2 struct {
+	Target              simulation.ServiceRef           `json:"target"`
+	Neighborhood        simulation.NeighborhoodMeta     `json:"neighborhood"`
+	DataFreshness       *simulation.DataFreshness       `json:"dataFreshness"`
+	Confidence          string                          `json:"confidence"`
+	Explanation         string                          `json:"explanation"`
+	AffectedCallers     []simulation.AffectedCaller     `json:"affectedCallers"`
+	AffectedDownstream  []simulation.AffectedDownstream `json:"affectedDownstream"`
+	UnreachableServices []simulation.UnreachableService `json:"unreachableServices"`
+	CriticalPaths       []simulation.BrokenPath         `json:"criticalPaths"`
+	TotalLostTrafficRps float64                         `json:"totalLostTrafficRps"`
+	SLOBreaches         []simulation.SLOBreach          `json:"sloBreaches"`
+	Recommendations     []RecommendationV2              `json:"recommendations"`
+}
+
+type ScalingSimulationResultV2 struct {
+	Target           simulation.ServiceRef              `json:"target"`
+	Neighborhood     simulation.NeighborhoodMeta        `json:"neighborhood"`
+	DataFreshness    *simulation.DataFreshness          `json:"dataFreshness"`
+	Confidence       string                             `json:"confidence"`
+	Explanation      string                             `json:"explanation"`
+	Warnings         []string                           `json:"warnings"`
+	LatencyMetric    string                             `json:"latencyMetric"`
+	ScalingModel     simulation.ScalingModel            `json:"scalingModel"`
+	Baseline         *simulation.ScalingBaseline        `json:"baseline,omitempty"`
+	CurrentPods      int                                `json:"currentPods"`
+	NewPods          int                                `json:"newPods"`
+	ScalingDirection string                             `json:"scalingDirection"`
+	LatencyEstimate  simulation.ScalingLatencyEstimate  `json:"latencyEstimate"`
+	AffectedCallers  []simulation.AffectedCallerScaling `json:"affectedCallers"`
+	AffectedPaths    []simulation.AffectedPathScaling   `json:"affectedPaths"`
+	SLOBreaches      []simulation.SLOBreach             `json:"sloBreaches"`
+	Recommendations  []RecommendationV2                 `json:"recommendations"`
+}
+
+type NodeCapacityV2 struct {
+	Node           string  `json:"node"`
+	CPUAvailable   float64 `json:"cpuAvailable"`
+	RAMAvailableMB float64 `json:"ramAvailableMB"`
+	CPUTotal       float64 `json:"cpuTotal"`
+	RAMTotalMB     float64 `json:"ramTotalMB"`
+	MaxPods        int     `json:"maxPods"`
+	Score          int     `json:"score"`
+	Suitable       bool    `json:"suitable"`
+	Reason         string  `json:"reason,omitempty"`
+}
+
+type AddSimulationResultV2 struct {
+	ServiceName       string                             `json:"serviceName"`
+	Success           bool                               `json:"success"`
+	Confidence        string                             `json:"confidence"`
+	Explanation       string                             `json:"explanation"`
+	TotalCapacityPods int                                `json:"totalCapacityPods"`
+	Nodes             []NodeCapacityV2                   `json:"nodes"`
+	Placement         []simulation.PlacementDistribution `json:"placement"`
+	RiskAnalysis      simulation.AddRiskAnalysis         `json:"riskAnalysis"`
+	Recommendations   []RecommendationV2                 `json:"recommendations"`
+}
+
+func toRecommendationsV2(recs []simulation.FailureRecommendation) []RecommendationV2 {
+	out := make([]RecommendationV2, 0, len(recs))
+	for _, rec := range recs {
+		reason := rec.Reason
+		if reason == "" {
+			reason = rec.Description
+		}
+		out = append(out, RecommendationV2{
+			Type:     rec.Type,
+			Priority: rec.Priority,
+			Target:   rec.Target,
+			Reason:   reason,
+			Action:   rec.Action,
+		})
+	}
+	return out
+}
+
+func canonicalRef(ref simulation.ServiceRef) simulation.ServiceRef {
+	ref.ServiceId = simulation.CanonicalServiceId(ref.Namespace + ":" + ref.Name)
+	return ref
+}
+
+func toFailureResultV2(res *simulation.FailureSimulationResult) FailureSimulationResultV2 {
+	return FailureSimulationResultV2{
+		Target:              canonicalRef(res.Target),
+		Neighborhood:        res.Neighborhood,
+		DataFreshness:       res.DataFreshness,
+		Confidence:          res.Confidence,
+		Explanation:         res.Explanation,
+		AffectedCallers:     res.AffectedCallers,
+		AffectedDownstream:  res.AffectedDownstream,
+		UnreachableServices: res.UnreachableServices,
+		CriticalPaths:       res.CriticalPaths,
+		TotalLostTrafficRps: res.TotalLostTrafficRps,
+		SLOBreaches:         res.SLOBreaches,
+		Recommendations:     toRecommendationsV2(res.Recommendations),
+	}
+}
+
+func toScalingResultV2(res *simulation.ScalingSimulationResult) ScalingSimulationResultV2 {
+	warnings := res.Warnings
+	if warnings == nil {
+		warnings = []string{}
+	}
+	return ScalingSimulationResultV2{
+		Target:           canonicalRef(res.Target),
+		Neighborhood:     res.Neighborhood,
+		DataFreshness:    res.DataFreshness,
+		Confidence:       res.Confidence,
+		Explanation:      res.Explanation,
+		Warnings:         warnings,
+		LatencyMetric:    res.LatencyMetric,
+		ScalingModel:     res.ScalingModel,
+		Baseline:         res.Baseline,
+		CurrentPods:      res.CurrentPods,
+		NewPods:          res.NewPods,
+		ScalingDirection: res.ScalingDirection,
+		LatencyEstimate:  res.LatencyEstimate,
+		AffectedCallers:  res.AffectedCallers.Items,
+		AffectedPaths:    res.AffectedPaths,
+		SLOBreaches:      res.SLOBreaches,
+		Recommendations:  toRecommendationsV2(res.Recommendations),
+	}
+}
+
+func toAddResultV2(res *simulation.AddSimulationResult) AddSimulationResultV2 {
+	nodes := make([]NodeCapacityV2, 0, len(res.SuitableNodes))
+	for _, n := range res.SuitableNodes {
+		nodes = append(nodes, NodeCapacityV2{
+			Node:           n.Node,
+			CPUAvailable:   n.CPUAvailable,
+			RAMAvailableMB: n.RAMAvailableMB,
+			CPUTotal:       n.CPUTotal,
+			RAMTotalMB:     n.RAMTotalMB,
+			MaxPods:        n.MaxPods,
+			Score:          n.Score,
+			Suitable:       n.Suitable,
+			Reason:         n.Reason,
+		})
+	}
+
+	placement := []simulation.PlacementDistribution{}
+	if res.Recommendation != nil && res.Recommendation.Distribution != nil {
+		placement = res.Recommendation.Distribution
+	}
+
+	return AddSimulationResultV2{
+		ServiceName:       res.TargetServiceName,
+		Success:           res.Success,
+		Confidence:        res.Confidence,
+		Explanation:       res.Explanation,
+		TotalCapacityPods: res.TotalCapacityPods,
+		Nodes:             nodes,
+		Placement:         placement,
+		RiskAnalysis:      res.RiskAnalysis,
+		Recommendations:   toRecommendationsV2(res.Recommendations),
+	}
+}
+
+// SimulateFailureV2Handler godoc
+// @Summary Simulate Service Failure (v2)
+// @Description Simulates a failure of a specific service. Accepts "name" or "namespace:name" service IDs and always returns canonical IDs.
+// @Tags simulation-v2
+// @Accept json
+// @Produce json,text/vnd.graphviz,text/plain,application/graphml+xml
+// @Param request body simulation.FailureSimulationRequest true "Simulation parameters"
+// @Param format query string false "Export the impact graph instead of JSON: dot, mermaid, graphml or cytoscape"
+// @Success 200 {object} api.FailureSimulationResultV2
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 504 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /v2/simulate/failure [post]
+func (h *Handler) SimulateFailureV2Handler(w http.ResponseWriter, r *http.Request) {
+	format, err := exportFormat(r)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	var req simulation.FailureSimulationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
+		return
+	}
+	req.ServiceId = simulation.CanonicalServiceId(req.ServiceId)
+
+	result, err := h.SimulationService.RunFailureSimulation(r.Context(), req)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	if format != "" {
+		respondGraph(w, format, h.failureGraph(r.Context(), result))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toFailureResultV2(result))
+}
+
+// SimulateScalingV2Handler godoc
+// @Summary Simulate Scaling (v2)
+// @Description Simulates scaling a service. Accepts "name" or "namespace:name" service IDs and always returns canonical IDs.
+// @Tags simulation-v2
+// @Accept json
+// @Produce json
+// @Param request body simulation.ScalingSimulationRequest true "Simulation parameters"
+// @Success 200 {object} api.ScalingSimulationResultV2
+// @Failure 400 {object} api.Problem
+// @Failure 404 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 504 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /v2/simulate/scale [post]
+func (h *Handler) SimulateScalingV2Handler(w http.ResponseWriter, r *http.Request) {
+	var req simulation.ScalingSimulationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
+		return
+	}
+	req.ServiceId = simulation.CanonicalServiceId(req.ServiceId)
+
+	result, err := h.SimulationService.RunScalingSimulation(r.Context(), req)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toScalingResultV2(result))
+}
+
+// SimulateAddV2Handler godoc
+// @Summary Simulate Adding Service (v2)
+// @Description Simulates adding a new service to the cluster without the legacy recommendation and duplicated node fields
+// @Tags simulation-v2
+// @Accept json
+// @Produce json
+// @Param request body simulation.AddSimulationRequest true "Simulation parameters"
+// @Success 200 {object} api.AddSimulationResultV2
+// @Failure 400 {object} api.Problem
+// @Failure 502 {object} api.Problem
+// @Failure 503 {object} api.Problem
+// @Failure 504 {object} api.Problem
+// @Failure 500 {object} api.Problem
+// @Router /v2/simulate/add [post]
+func (h *Handler) SimulateAddV2Handler(w http.ResponseWriter, r *http.Request) {
+	var req simulation.AddSimulationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, r, common.NewError(common.CodeInvalidRequestBody, "Invalid request body"))
+		return
+	}
+	if req.CPURequest <= 0 || req.RAMRequest <= 0 || req.Replicas <= 0 {
+		respondError(w, r, common.NewError(common.CodeInvalidResourceRequest, "Invalid resource requests: cpu, ram, and replicas must be positive"))
+		return
+	}
+	for i, dep := range req.Dependencies {
+		req.Dependencies[i].ServiceId = simulation.CanonicalServiceId(dep.ServiceId)
+	}
+
+	result, err := h.SimulationService.RunAddSimulation(r.Context(), req)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toAddResultV2(result))
+}
@@ -0,0 +1,102 @@
+package calibration
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"predictive-analysis-engine/pkg/clients/telemetry"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+const maxLookback = 7 * 24 * time.Hour
+
+// CalibrationSink records fitted parameters. It is satisfied by
+// storage.CalibrationStore.
+type CalibrationSink interface {
+	SaveCalibration(c storage.ScalingCalibration) error
+}
+
+// MetricsSource is the subset of the telemetry client the calibration job
+// reads from.
+type MetricsSource interface {
+	CheckStatus() (bool, string)
+	GetServiceMetrics(ctx context.Context, service string, from, to string, stepSeconds int) ([]telemetry.ServiceMetric, error)
+}
+
+type Calibrator struct {
+	store     CalibrationSink
+	telemetry MetricsSource
+	cfg       *config.Config
+}
+
+func NewCalibrator(cfg *config.Config, store CalibrationSink, ts MetricsSource) *Calibrator {
+	return &Calibrator{store: store, telemetry: ts, cfg: cfg}
+}
+
+// Run fits every service with at least CALIBRATION_MIN_SAMPLES usable
+// pod-count changes in the lookback window, stores the fits and returns
+// them. Services with too few changes keep their previous calibration.
+func (c *Calibrator) Run(ctx context.Context) ([]storage.ScalingCalibration, error) {
+	if c.store == nil {
+		return nil, common.NewError(common.CodeDecisionStoreUnavailable, "Decision store not available. Check DECISION_STORE_DRIVER configuration.")
+	}
+	if ok, reason := c.telemetry.CheckStatus(); !ok {
+		return nil, common.NewError(common.CodeTelemetryUnavailable, "%s", reason)
+	}
+
+	lookback := time.Duration(c.cfg.Calibration.LookbackHours) * time.Hour
+	if lookback <= 0 || lookback > maxLookback {
+		lookback = maxLookback
+	}
+	step := c.cfg.Calibration.StepSeconds
+	if step <= 0 {
+		step = 300
+	}
+	to := time.Now().UTC()
+	from := to.Add(-lookback)
+	windowStart, windowEnd := from.Format(time.RFC3339), to.Format(time.RFC3339)
+
+	metrics, err := c.telemetry.GetServiceMetrics(ctx, "", windowStart, windowEnd, step)
+	if err != nil {
+		return nil, common.WrapError(common.CodeUpstreamError, err, "Service metrics query failed")
+	}
+
+	byService := map[string][]telemetry.ServiceMetric{}
+	for _, m := range metrics {
+		id := simulation.CanonicalServiceId(m.Namespace + ":" + m.Service)
+		byService[id] = append(byService[id], m)
+	}
+
+	metric := c.cfg.Simulation.DefaultLatencyMetric
+	fittedAt := time.Now().UTC().Format(time.RFC3339)
+	fitted := []storage.ScalingCalibration{}
+	for id, points := range byService {
+		obs := FindChanges(points, metric)
+		if len(obs) == 0 || len(obs) < c.cfg.Calibration.MinSamples {
+			continue
+		}
+		fit := FitBoundedSqrt(obs)
+		cal := storage.ScalingCalibration{
+			ServiceID:        id,
+			Metric:           metric,
+			Alpha:            fit.Alpha,
+			MinLatencyFactor: fit.MinLatencyFactor,
+			Samples:          fit.Samples,
+			RMSE:             fit.RMSE,
+			WindowStart:      windowStart,
+			WindowEnd:        windowEnd,
+			FittedAt:         fittedAt,
+		}
+		if err := c.store.SaveCalibration(cal); err != nil {
+			return nil, err
+		}
+		fitted = append(fitted, cal)
+	}
+
+	sort.Slice(fitted, func(i, j int) bool { return fitted[i].ServiceID < fitted[j].ServiceID })
+	return fitted, nil
+}
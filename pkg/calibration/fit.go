@@ -0,0 +1,123 @@
+// Package calibration fits per-service bounded_sqrt scaling parameters from
+// the latency changes observed when a service's pod count changed.
+package calibration
+
+import (
+	"math"
+	"sort"
+
+	"predictive-analysis-engine/pkg/clients/telemetry"
+	"predictive-analysis-engine/pkg/simulation"
+)
+
+const (
+	// maxLoadShift is the largest relative change in request rate between
+	// two buckets for a pod-count change to be used. Beyond it the latency
+	// change is dominated by load rather than by the new pod count.
+	maxLoadShift = 0.5
+	// podCountTolerance rejects buckets whose mean pod count is not close to
+	// a whole number, i.e. buckets in which the rollout was still running.
+	podCountTolerance = 0.05
+	gridStep          = 0.01
+)
+
+// Observation is one pod-count change seen between two consecutive
+// service_metrics buckets of the same service.
+type Observation struct {
+	At          string
+	CurrentPods int
+	NewPods     int
+	BeforeMs    float64
+	AfterMs     float64
+}
+
+// Fit is the result of fitting bounded_sqrt to a set of observations. RMSE
+// is measured on the latency ratio AfterMs/BeforeMs.
+type Fit struct {
+	Alpha            float64
+	MinLatencyFactor float64
+	RMSE             float64
+	Samples          int
+}
+
+// FindChanges returns the pod-count changes in points, which must all
+// belong to one service.
+func FindChanges(points []telemetry.ServiceMetric, metric string) []Observation {
+	sorted := append([]telemetry.ServiceMetric(nil), points...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Timestamp < sorted[j].Timestamp })
+
+	var obs []Observation
+	for i := 1; i < len(sorted); i++ {
+		prev, cur := sorted[i-1], sorted[i]
+		prevPods, ok1 := wholePods(prev.PodCount)
+		curPods, ok2 := wholePods(cur.PodCount)
+		if !ok1 || !ok2 || prevPods == curPods {
+			continue
+		}
+
+		before, after := latency(prev, metric), latency(cur, metric)
+		if before <= 0 || after <= 0 {
+			continue
+		}
+		if prev.RequestRate <= 0 || math.Abs(cur.RequestRate-prev.RequestRate)/prev.RequestRate > maxLoadShift {
+			continue
+		}
+
+		obs = append(obs, Observation{
+			At:          cur.Timestamp,
+			CurrentPods: prevPods,
+			NewPods:     curPods,
+			BeforeMs:    before,
+			AfterMs:     after,
+		})
+	}
+	return obs
+}
+
+// FitBoundedSqrt finds the alpha and minimum latency factor that minimise
+// the squared error between the observed and predicted latency ratios. The
+// model is piecewise, so the parameters are searched on a 0.01 grid rather
+// than solved in closed form. Among equally good fits the highest floor is
+// kept, which errs towards pessimistic projections.
+func FitBoundedSqrt(obs []Observation) Fit {
+	best := Fit{Samples: len(obs), RMSE: math.Inf(1)}
+	if len(obs) == 0 {
+		return best
+	}
+
+	steps := int(math.Round(1 / gridStep))
+	for m := steps; m >= 0; m-- {
+		minFactor := float64(m) * gridStep
+		for a := 0; a <= steps; a++ {
+			alpha := float64(a) * gridStep
+			var sse float64
+			for _, o := range obs {
+				predicted := simulation.BoundedSqrtFactor(o.CurrentPods, o.NewPods, alpha, minFactor)
+				diff := o.AfterMs/o.BeforeMs - predicted
+				sse += diff * diff
+			}
+			if rmse := math.Sqrt(sse / float64(len(obs))); rmse < best.RMSE {
+				best.Alpha, best.MinLatencyFactor, best.RMSE = alpha, minFactor, rmse
+			}
+		}
+	}
+	return best
+}
+
+func wholePods(mean float64) (int, bool) {
+	n := math.Round(mean)
+	if n < 1 || math.Abs(mean-n) > podCountTolerance {
+		return 0, false
+	}
+	return int(n), true
+}
+
+func latency(m telemetry.ServiceMetric, metric string) float64 {
+	switch metric {
+	case "p50":
+		return m.P50
+	case "p99":
+		return m.P99
+	}
+	return m.P95
+}
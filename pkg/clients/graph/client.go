@@ -3,7 +3,9 @@ package graph
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -14,6 +16,9 @@ import (
 	"predictive-analysis-engine/pkg/logger"
 )
 
+// ErrNotFound is wrapped by errors for upstream HTTP 404 responses.
+var ErrNotFound = errors.New("not found")
+
 type Client struct {
 	baseURL    string
 	httpClient *http.Client
@@ -98,18 +103,32 @@ func (c *Client) get(ctx context.Context, path string, dest interface{}) error {
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		logger.Error(fmt.Sprintf("[GraphClient] Request failed for %s", url), err)
-		return fmt.Errorf("request failed: %w", err)
+		if isTimeout(err) {
+			return common.WrapError(common.CodeUpstreamTimeout, err, "Graph API timeout")
+		}
+		return common.WrapError(common.CodeUpstreamUnavailable, err, "Graph API unavailable")
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		logger.Error(fmt.Sprintf("[GraphClient] HTTP %d for %s", resp.StatusCode, url), nil)
-		return fmt.Errorf("HTTP %d", resp.StatusCode)
+		if resp.StatusCode == http.StatusNotFound {
+			return common.WrapError(common.CodeUpstreamError, ErrNotFound, "Graph API returned HTTP %d", resp.StatusCode)
+		}
+		return common.NewError(common.CodeUpstreamError, "Graph API returned HTTP %d", resp.StatusCode)
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
-		return fmt.Errorf("invalid JSON response: %w", err)
+		return common.WrapError(common.CodeUpstreamError, err, "Graph API returned invalid JSON")
 	}
 
 	return nil
 }
+
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"context"
+	"strings"
+
+	"predictive-analysis-engine/pkg/common"
+)
+
+// Topology is a static description of a cluster in the same shapes the
+// Graph Engine returns from /services and /services/{name}/neighborhood.
+type Topology struct {
+	WindowMinutes int           `json:"windowMinutes"`
+	Services      []ServiceInfo `json:"services"`
+	Edges         []GraphEdge   `json:"edges"`
+}
+
+// TopologySource serves simulation reads from a Topology instead of a live
+// Graph Engine, e.g. for offline runs against a checked-in topology file.
+type TopologySource struct {
+	topology Topology
+}
+
+func NewTopologySource(t Topology) *TopologySource {
+	for i := range t.Services {
+		if t.Services[i].Namespace == "" {
+			t.Services[i].Namespace = "default"
+		}
+	}
+	if t.WindowMinutes == 0 {
+		t.WindowMinutes = 5
+	}
+	return &TopologySource{topology: t}
+}
+
+func (s *TopologySource) CheckHealth(ctx context.Context) (*HealthResponse, error) {
+	return &HealthResponse{
+		Status:        "ok",
+		WindowMinutes: s.topology.WindowMinutes,
+	}, nil
+}
+
+func (s *TopologySource) GetServices(ctx context.Context) ([]ServiceInfo, error) {
+	return s.topology.Services, nil
+}
+
+// GetNeighborhood returns every service within k hops of serviceName,
+// following edges in both directions like the Graph Engine does.
+func (s *TopologySource) GetNeighborhood(ctx context.Context, serviceName string, k int) (*NeighborhoodResponse, error) {
+	name := serviceName
+	if i := strings.Index(serviceName, ":"); i >= 0 {
+		name = serviceName[i+1:]
+	}
+
+	byName := make(map[string]ServiceInfo, len(s.topology.Services))
+	for _, svc := range s.topology.Services {
+		byName[svc.Name] = svc
+	}
+	if _, ok := byName[name]; !ok {
+		return nil, common.WrapError(common.CodeUpstreamError, ErrNotFound, "Service %s not in topology", name)
+	}
+
+	keep := map[string]bool{name: true}
+	frontier := []string{name}
+	for hop := 0; hop < k && len(frontier) > 0; hop++ {
+		var next []string
+		for _, e := range s.topology.Edges {
+			for _, pair := range [][2]string{{e.From, e.To}, {e.To, e.From}} {
+				if containsString(frontier, pair[0]) && !keep[pair[1]] {
+					keep[pair[1]] = true
+					next = append(next, pair[1])
+				}
+			}
+		}
+		frontier = next
+	}
+
+	resp := &NeighborhoodResponse{Center: name, K: k, Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+	for _, svc := range s.topology.Services {
+		if keep[svc.Name] {
+			resp.Nodes = append(resp.Nodes, GraphNode{
+				Name:         svc.Name,
+				Namespace:    svc.Namespace,
+				PodCount:     svc.PodCount,
+				Availability: svc.Availability,
+			})
+		}
+	}
+	for _, e := range s.topology.Edges {
+		if keep[e.From] && keep[e.To] {
+			resp.Edges = append(resp.Edges, e)
+		}
+	}
+	return resp, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
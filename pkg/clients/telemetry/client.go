@@ -33,6 +33,7 @@ type ServiceMetric struct {
 	P95          float64 `json:"p95"`
 	P99          float64 `json:"p99"`
 	Availability float64 `json:"availability"`
+	PodCount     float64 `json:"podCount"`
 }
 
 type EdgeMetric struct {
@@ -56,6 +57,7 @@ type ServicePoint struct {
 	P95          *float64
 	P99          *float64
 	Availability *float64
+	PodCount     *float64
 }
 
 type EdgePoint struct {
@@ -188,7 +190,8 @@ func (c *TelemetryClient) GetServiceMetrics(ctx context.Context, service string,
 		mean("p50") AS "avg_p50", 
 		mean("p95") AS "avg_p95", 
 		mean("p99") AS "avg_p99", 
-		mean("availability") AS "avg_availability" 
+		mean("availability") AS "avg_availability", 
+		mean("pod_count") AS "avg_pod_count" 
 		FROM "service_metrics" 
 		WHERE time >= '%s' AND time < '%s'`
 
@@ -264,6 +267,7 @@ func (c *TelemetryClient) GetServiceMetrics(ctx context.Context, service string,
 					P95:          getFloat("avg_p95"),
 					P99:          getFloat("avg_p99"),
 					Availability: getFloat("avg_availability"),
+					PodCount:     getFloat("avg_pod_count"),
 				}
 				metrics = append(metrics, m)
 			}
@@ -392,6 +396,9 @@ func (c *TelemetryClient) WriteServiceMetrics(ctx context.Context, points []Serv
 		if p.Availability != nil {
 			fields["availability"] = *p.Availability
 		}
+		if p.PodCount != nil {
+			fields["pod_count"] = *p.PodCount
+		}
 
 		if len(fields) == 0 {
 			continue
@@ -0,0 +1,77 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorCode is a stable, machine-readable identifier for an error condition.
+// Codes are part of the public API contract and must not be renamed.
+type ErrorCode string
+
+const (
+	CodeInvalidRequestBody       ErrorCode = "INVALID_REQUEST_BODY"
+	CodeValidationFailed         ErrorCode = "VALIDATION_FAILED"
+	CodeMissingParameter         ErrorCode = "MISSING_PARAMETER"
+	CodeInvalidParameter         ErrorCode = "INVALID_PARAMETER"
+	CodeInvalidTimestamp         ErrorCode = "INVALID_TIMESTAMP"
+	CodeTimeRangeTooLarge        ErrorCode = "TIME_RANGE_TOO_LARGE"
+	CodeDepthOutOfRange          ErrorCode = "DEPTH_OUT_OF_RANGE"
+	CodeInvalidLatencyMetric     ErrorCode = "INVALID_LATENCY_METRIC"
+	CodeInvalidPodCount          ErrorCode = "INVALID_POD_COUNT"
+	CodeInvalidScalingModel      ErrorCode = "INVALID_SCALING_MODEL"
+	CodeInvalidAlpha             ErrorCode = "INVALID_ALPHA"
+	CodeInvalidResourceRequest   ErrorCode = "INVALID_RESOURCE_REQUEST"
+	CodeInvalidMetric            ErrorCode = "INVALID_METRIC"
+	CodeInvalidDecisionType      ErrorCode = "INVALID_DECISION_TYPE"
+	CodeServiceNotFound          ErrorCode = "SERVICE_NOT_FOUND"
+	CodeNotFound                 ErrorCode = "NOT_FOUND"
+	CodeMethodNotAllowed         ErrorCode = "METHOD_NOT_ALLOWED"
+	CodeNoNodesFound             ErrorCode = "NO_NODES_FOUND"
+	CodeUpstreamUnavailable      ErrorCode = "UPSTREAM_UNAVAILABLE"
+	CodeUpstreamTimeout          ErrorCode = "UPSTREAM_TIMEOUT"
+	CodeUpstreamError            ErrorCode = "UPSTREAM_ERROR"
+	CodeTelemetryUnavailable     ErrorCode = "TELEMETRY_UNAVAILABLE"
+	CodeDecisionStoreUnavailable ErrorCode = "DECISION_STORE_UNAVAILABLE"
+	CodeChangeWindowOpen         ErrorCode = "CHANGE_WINDOW_OPEN"
+	CodeNoObservedData           ErrorCode = "NO_OBSERVED_DATA"
+	CodeInvalidTransition        ErrorCode = "INVALID_TRANSITION"
+	CodeAlreadyExists            ErrorCode = "ALREADY_EXISTS"
+	CodeInternal                 ErrorCode = "INTERNAL_ERROR"
+)
+
+// Error is a typed application error. Message is safe to return to clients;
+// Err carries the underlying cause for logging and is never exposed.
+type Error struct {
+	Code    ErrorCode
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func NewError(code ErrorCode, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func WrapError(code ErrorCode, err error, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...), Err: err}
+}
+
+// CodeOf returns the code of the first *Error in err's chain, or CodeInternal.
+func CodeOf(err error) ErrorCode {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Code
+	}
+	return CodeInternal
+}
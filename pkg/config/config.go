@@ -4,17 +4,26 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 type Config struct {
 	Simulation      SimulationConfig
 	Server          ServerConfig
+	GRPC            GRPCConfig
 	GraphAPI        GraphAPIConfig
 	RateLimit       RateLimitConfig
 	Influx          InfluxConfig
 	SQLite          SQLiteConfig
+	DecisionStore   DecisionStoreConfig
 	TelemetryWorker TelemetryWorkerConfig
 	Telemetry       TelemetryConfig
+	Outcome         OutcomeConfig
+	Calibration     CalibrationConfig
+	Retention       RetentionConfig
+	Anomaly         AnomalyConfig
+	Forecast        ForecastConfig
+	SLO             SLOConfig
 }
 
 type SimulationConfig struct {
@@ -31,6 +40,11 @@ type ServerConfig struct {
 	Port int
 }
 
+type GRPCConfig struct {
+	Enabled bool
+	Port    int
+}
+
 type GraphAPIConfig struct {
 	BaseURL   string
 	TimeoutMs int
@@ -51,6 +65,23 @@ type SQLiteConfig struct {
 	DBPath string
 }
 
+// DecisionStoreConfig selects the decision store backend. Driver is "sqlite"
+// (one file per replica, at SQLite.DBPath) or "postgres" (shared, at
+// PostgresDSN).
+type DecisionStoreConfig struct {
+	Driver      string
+	PostgresDSN string
+	AutoMigrate bool
+}
+
+// DecisionStoreDSN returns the data source for the configured driver.
+func (c *Config) DecisionStoreDSN() string {
+	if c.DecisionStore.Driver == "postgres" {
+		return c.DecisionStore.PostgresDSN
+	}
+	return c.SQLite.DBPath
+}
+
 type TelemetryWorkerConfig struct {
 	Enabled        bool
 	PollIntervalMs int
@@ -60,7 +91,76 @@ type TelemetryConfig struct {
 	Enabled bool
 }
 
+// OutcomeConfig controls automatic evaluation of scaling decisions. The
+// observed latency is averaged over [decision + Settle, decision + Settle + Window].
+type OutcomeConfig struct {
+	WorkerEnabled  bool
+	PollIntervalMs int
+	SettleMinutes  int
+	WindowMinutes  int
+}
+
+// CalibrationConfig controls the job that fits per-service bounded_sqrt
+// parameters from pod-count changes recorded in service_metrics.
+type CalibrationConfig struct {
+	WorkerEnabled bool
+	IntervalMs    int
+	LookbackHours int
+	StepSeconds   int
+	MinSamples    int
+}
+
+// RetentionConfig controls pruning of old decisions. MaxAgeDays applies to
+// every type without an entry in MaxAgeDaysByType; 0 keeps decisions forever.
+// Pruned rows are written to gzip-compressed NDJSON files in ArchiveDir when
+// ArchiveEnabled is set.
+type RetentionConfig struct {
+	WorkerEnabled    bool
+	IntervalMs       int
+	MaxAgeDays       int
+	MaxAgeDaysByType map[string]int
+	ArchiveEnabled   bool
+	ArchiveDir       string
+	BatchSize        int
+}
+
+// AnomalyConfig controls anomaly detection on service_metrics and
+// edge_metrics. Baselines are rebuilt from WarmupHours of history on start;
+// a value is anomalous when its deviation score reaches Threshold.
+type AnomalyConfig struct {
+	WorkerEnabled   bool
+	IntervalMs      int
+	StepSeconds     int
+	WarmupHours     int
+	EWMAAlpha       float64
+	Threshold       float64
+	MinSamples      int
+	SeasonalSamples int
+}
+
+// ForecastConfig controls traffic and latency forecasts. Models are fitted
+// to LookbackHours of history; StepSeconds is the bucket size used for the
+// edge forecasts behind forecast baselines in scaling simulations.
+type ForecastConfig struct {
+	LookbackHours   int
+	StepSeconds     int
+	MaxHorizonHours int
+}
+
+// SLOConfig controls SLO evaluation. Compliance and burn rates are computed
+// from service_metrics at StepSeconds; SLOs created without a window use
+// DefaultWindowDays.
+type SLOConfig struct {
+	StepSeconds       int
+	DefaultWindowDays int
+}
+
 func Load() (*Config, error) {
+	maxAgeByType, err := getEnvIntMap("RETENTION_MAX_AGE_DAYS_BY_TYPE")
+	if err != nil {
+		return nil, err
+	}
+
 	cfg := &Config{
 		Simulation: SimulationConfig{
 			DefaultLatencyMetric: getEnv("DEFAULT_LATENCY_METRIC", "p95"),
@@ -74,6 +174,10 @@ func Load() (*Config, error) {
 		Server: ServerConfig{
 			Port: getEnvInt("PORT", 5000),
 		},
+		GRPC: GRPCConfig{
+			Enabled: getEnv("GRPC_ENABLED", "true") != "false",
+			Port:    getEnvInt("GRPC_PORT", 50051),
+		},
 		GraphAPI: GraphAPIConfig{
 			BaseURL:   getGraphBaseURL(),
 			TimeoutMs: getEnvInt("GRAPH_API_TIMEOUT_MS", 5000),
@@ -90,6 +194,11 @@ func Load() (*Config, error) {
 		SQLite: SQLiteConfig{
 			DBPath: getEnv("SQLITE_DB_PATH", "./data/decisions.db"),
 		},
+		DecisionStore: DecisionStoreConfig{
+			Driver:      getEnv("DECISION_STORE_DRIVER", "sqlite"),
+			PostgresDSN: getEnv("POSTGRES_DSN", ""),
+			AutoMigrate: getEnv("DECISION_STORE_AUTO_MIGRATE", "true") != "false",
+		},
 		TelemetryWorker: TelemetryWorkerConfig{
 			Enabled:        getEnv("TELEMETRY_WORKER_ENABLED", "true") != "false",
 			PollIntervalMs: getEnvInt("TELEMETRY_POLL_INTERVAL_MS", 60000),
@@ -97,6 +206,47 @@ func Load() (*Config, error) {
 		Telemetry: TelemetryConfig{
 			Enabled: getEnv("TELEMETRY_ENABLED", "true") != "false",
 		},
+		Outcome: OutcomeConfig{
+			WorkerEnabled:  getEnv("OUTCOME_WORKER_ENABLED", "false") == "true",
+			PollIntervalMs: getEnvInt("OUTCOME_POLL_INTERVAL_MS", 300000),
+			SettleMinutes:  getEnvInt("OUTCOME_SETTLE_MINUTES", 5),
+			WindowMinutes:  getEnvInt("OUTCOME_WINDOW_MINUTES", 15),
+		},
+		Calibration: CalibrationConfig{
+			WorkerEnabled: getEnv("CALIBRATION_WORKER_ENABLED", "false") == "true",
+			IntervalMs:    getEnvInt("CALIBRATION_INTERVAL_MS", 3600000),
+			LookbackHours: getEnvInt("CALIBRATION_LOOKBACK_HOURS", 168),
+			StepSeconds:   getEnvInt("CALIBRATION_STEP_SECONDS", 300),
+			MinSamples:    getEnvInt("CALIBRATION_MIN_SAMPLES", 3),
+		},
+		Retention: RetentionConfig{
+			WorkerEnabled:    getEnv("RETENTION_WORKER_ENABLED", "false") == "true",
+			IntervalMs:       getEnvInt("RETENTION_INTERVAL_MS", 3600000),
+			MaxAgeDays:       getEnvInt("RETENTION_MAX_AGE_DAYS", 0),
+			MaxAgeDaysByType: maxAgeByType,
+			ArchiveEnabled:   getEnv("RETENTION_ARCHIVE_ENABLED", "true") != "false",
+			ArchiveDir:       getEnv("RETENTION_ARCHIVE_DIR", "./data/archive"),
+			BatchSize:        getEnvInt("RETENTION_BATCH_SIZE", 500),
+		},
+		Anomaly: AnomalyConfig{
+			WorkerEnabled:   getEnv("ANOMALY_WORKER_ENABLED", "false") == "true",
+			IntervalMs:      getEnvInt("ANOMALY_INTERVAL_MS", 60000),
+			StepSeconds:     getEnvInt("ANOMALY_STEP_SECONDS", 60),
+			WarmupHours:     getEnvInt("ANOMALY_WARMUP_HOURS", 24),
+			EWMAAlpha:       getEnvFloat("ANOMALY_EWMA_ALPHA", 0.1),
+			Threshold:       getEnvFloat("ANOMALY_THRESHOLD", 3.5),
+			MinSamples:      getEnvInt("ANOMALY_MIN_SAMPLES", 30),
+			SeasonalSamples: getEnvInt("ANOMALY_SEASONAL_SAMPLES", 120),
+		},
+		Forecast: ForecastConfig{
+			LookbackHours:   getEnvInt("FORECAST_LOOKBACK_HOURS", 336),
+			StepSeconds:     getEnvInt("FORECAST_STEP_SECONDS", 3600),
+			MaxHorizonHours: getEnvInt("FORECAST_MAX_HORIZON_HOURS", 168),
+		},
+		SLO: SLOConfig{
+			StepSeconds:       getEnvInt("SLO_STEP_SECONDS", 300),
+			DefaultWindowDays: getEnvInt("SLO_DEFAULT_WINDOW_DAYS", 28),
+		},
 	}
 
 	return cfg, nil
@@ -152,3 +302,29 @@ func getEnvFloat(key string, fallback float64) float64 {
 	}
 	return f
 }
+
+// getEnvIntMap parses "key=int,key=int". Malformed values are an error
+// rather than a silent fallback.
+func getEnvIntMap(key string) (map[string]int, error) {
+	out := map[string]int{}
+	v := os.Getenv(key)
+	if v == "" {
+		return out, nil
+	}
+	for _, pair := range strings.Split(v, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		k, val, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s: expected key=value, got %q", key, pair)
+		}
+		i, err := strconv.Atoi(strings.TrimSpace(val))
+		if err != nil || i < 0 {
+			return nil, fmt.Errorf("%s: invalid value for %s: %q", key, strings.TrimSpace(k), val)
+		}
+		out[strings.TrimSpace(k)] = i
+	}
+	return out, nil
+}
@@ -0,0 +1,231 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/parquet-go/parquet-go"
+
+	"predictive-analysis-engine/pkg/storage"
+)
+
+const (
+	FormatNDJSON  = "ndjson"
+	FormatCSV     = "csv"
+	FormatParquet = "parquet"
+)
+
+// parquetRowGroupSize bounds how many decisions the Parquet writer buffers
+// before flushing a row group.
+const parquetRowGroupSize = 1000
+
+// DecisionWriter writes decisions one at a time. Close flushes buffered rows
+// and any trailer the format needs; it does not close the underlying writer.
+type DecisionWriter interface {
+	Write(row *storage.DecisionRow) error
+	Close() error
+}
+
+var decisionWriters = map[string]func(io.Writer) DecisionWriter{
+	FormatNDJSON:  newNDJSONWriter,
+	FormatCSV:     newCSVWriter,
+	FormatParquet: newParquetWriter,
+}
+
+var decisionContentTypes = map[string]string{
+	FormatNDJSON:  "application/x-ndjson",
+	FormatCSV:     "text/csv; charset=utf-8",
+	FormatParquet: "application/vnd.apache.parquet",
+}
+
+// DecisionFormats lists the supported decision export formats.
+func DecisionFormats() []string {
+	out := make([]string, 0, len(decisionWriters))
+	for f := range decisionWriters {
+		out = append(out, f)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func IsDecisionFormat(format string) bool {
+	_, ok := decisionWriters[format]
+	return ok
+}
+
+func DecisionContentType(format string) string {
+	return decisionContentTypes[format]
+}
+
+// NewDecisionWriter returns a writer for the given format, or nil if the
+// format is not supported.
+func NewDecisionWriter(w io.Writer, format string) DecisionWriter {
+	newWriter, ok := decisionWriters[format]
+	if !ok {
+		return nil
+	}
+	return newWriter(w)
+}
+
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func newNDJSONWriter(w io.Writer) DecisionWriter {
+	return &ndjsonWriter{enc: json.NewEncoder(w)}
+}
+
+func (w *ndjsonWriter) Write(row *storage.DecisionRow) error {
+	return w.enc.Encode(row)
+}
+
+func (w *ndjsonWriter) Close() error {
+	return nil
+}
+
+// csvHeader lists the decision columns followed by its child rows. Lists
+// and objects are written as JSON.
+var csvHeader = []string{"id", "timestamp", "type", "service_id", "confidence", "lost_traffic_rps", "correlation_id", "approval_state", "approval_actor", "approval_updated_at", "created_at", "scenario", "result",
+	"recommendation_types", "tags", "outcome", "annotations", "approvals"}
+
+type csvWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func newCSVWriter(w io.Writer) DecisionWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (w *csvWriter) Write(row *storage.DecisionRow) error {
+	if !w.wroteHeader {
+		if err := w.w.Write(csvHeader); err != nil {
+			return err
+		}
+		w.wroteHeader = true
+	}
+	lost := ""
+	if row.LostTrafficRps != nil {
+		lost = strconv.FormatFloat(*row.LostTrafficRps, 'f', -1, 64)
+	}
+	children, err := childColumns(row)
+	if err != nil {
+		return err
+	}
+	return w.w.Write(append([]string{
+		strconv.FormatInt(row.ID, 10),
+		row.Timestamp,
+		row.Type,
+		row.ServiceID,
+		row.Confidence,
+		lost,
+		row.CorrelationID,
+		row.ApprovalState,
+		row.ApprovalActor,
+		row.ApprovalUpdatedAt,
+		row.CreatedAt,
+		string(row.Scenario),
+		string(row.Result),
+	}, children...))
+}
+
+// childColumns encodes the child rows of a decision as JSON, in csvHeader
+// order. A decision without an outcome gets an empty outcome column.
+func childColumns(row *storage.DecisionRow) ([]string, error) {
+	out := make([]string, 0, 5)
+	for _, v := range []interface{}{row.RecommendationTypes, row.Tags, row.Outcome, row.Annotations, row.Approvals} {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, string(b))
+	}
+	if row.Outcome == nil {
+		out[2] = ""
+	}
+	return out, nil
+}
+
+func (w *csvWriter) Close() error {
+	if !w.wroteHeader {
+		if err := w.w.Write(csvHeader); err != nil {
+			return err
+		}
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+type parquetDecision struct {
+	ID                  int64    `parquet:"id"`
+	Timestamp           string   `parquet:"timestamp"`
+	Type                string   `parquet:"type"`
+	ServiceID           *string  `parquet:"service_id,optional"`
+	Confidence          *string  `parquet:"confidence,optional"`
+	LostTrafficRps      *float64 `parquet:"lost_traffic_rps,optional"`
+	CorrelationID       *string  `parquet:"correlation_id,optional"`
+	ApprovalState       string   `parquet:"approval_state"`
+	ApprovalActor       *string  `parquet:"approval_actor,optional"`
+	ApprovalUpdatedAt   *string  `parquet:"approval_updated_at,optional"`
+	CreatedAt           string   `parquet:"created_at"`
+	Scenario            string   `parquet:"scenario,json"`
+	Result              string   `parquet:"result,json"`
+	RecommendationTypes []string `parquet:"recommendation_types,list"`
+	Tags                []string `parquet:"tags,list"`
+	Outcome             *string  `parquet:"outcome,optional,json"`
+	Annotations         string   `parquet:"annotations,json"`
+	Approvals           string   `parquet:"approvals,json"`
+}
+
+type parquetWriter struct {
+	w *parquet.GenericWriter[parquetDecision]
+}
+
+func newParquetWriter(w io.Writer) DecisionWriter {
+	return &parquetWriter{w: parquet.NewGenericWriter[parquetDecision](w,
+		parquet.Compression(&parquet.Snappy),
+		parquet.MaxRowsPerRowGroup(parquetRowGroupSize),
+	)}
+}
+
+func (w *parquetWriter) Write(row *storage.DecisionRow) error {
+	children, err := childColumns(row)
+	if err != nil {
+		return err
+	}
+	_, err = w.w.Write([]parquetDecision{{
+		ID:                  row.ID,
+		Timestamp:           row.Timestamp,
+		Type:                row.Type,
+		ServiceID:           optionalString(row.ServiceID),
+		Confidence:          optionalString(row.Confidence),
+		LostTrafficRps:      row.LostTrafficRps,
+		CorrelationID:       optionalString(row.CorrelationID),
+		ApprovalState:       row.ApprovalState,
+		ApprovalActor:       optionalString(row.ApprovalActor),
+		ApprovalUpdatedAt:   optionalString(row.ApprovalUpdatedAt),
+		CreatedAt:           row.CreatedAt,
+		Scenario:            string(row.Scenario),
+		Result:              string(row.Result),
+		RecommendationTypes: row.RecommendationTypes,
+		Tags:                row.Tags,
+		Outcome:             optionalString(children[2]),
+		Annotations:         children[3],
+		Approvals:           children[4],
+	}})
+	return err
+}
+
+func (w *parquetWriter) Close() error {
+	return w.w.Close()
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
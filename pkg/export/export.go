@@ -0,0 +1,119 @@
+// Package export renders dependency graphs as DOT, Mermaid, GraphML or
+// Cytoscape.js JSON for use in docs and design reviews, and writes stored
+// decisions as NDJSON, CSV or Parquet for analytics.
+package export
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	FormatDOT       = "dot"
+	FormatMermaid   = "mermaid"
+	FormatGraphML   = "graphml"
+	FormatCytoscape = "cytoscape"
+)
+
+// Node roles used to highlight failure simulation results.
+const (
+	RoleTarget      = "target"
+	RoleUnreachable = "unreachable"
+	RoleAffected    = "affected"
+)
+
+type Graph struct {
+	Title string
+	Nodes []Node
+	Edges []Edge
+}
+
+type Node struct {
+	ID        string
+	Label     string
+	RiskLevel string
+	Role      string
+}
+
+type Edge struct {
+	Source       string
+	Target       string
+	ReqRate      float64
+	LatencyP95Ms float64
+	// Critical marks edges on a critical path to a failed target.
+	Critical bool
+}
+
+var renderers = map[string]func(io.Writer, *Graph) error{
+	FormatDOT:       renderDOT,
+	FormatMermaid:   renderMermaid,
+	FormatGraphML:   renderGraphML,
+	FormatCytoscape: renderCytoscape,
+}
+
+var contentTypes = map[string]string{
+	FormatDOT:       "text/vnd.graphviz; charset=utf-8",
+	FormatMermaid:   "text/plain; charset=utf-8",
+	FormatGraphML:   "application/graphml+xml; charset=utf-8",
+	FormatCytoscape: "application/json; charset=utf-8",
+}
+
+// Formats lists the supported export formats.
+func Formats() []string {
+	out := make([]string, 0, len(renderers))
+	for f := range renderers {
+		out = append(out, f)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func IsSupported(format string) bool {
+	_, ok := renderers[format]
+	return ok
+}
+
+func ContentType(format string) string {
+	return contentTypes[format]
+}
+
+func Render(w io.Writer, format string, g *Graph) error {
+	render, ok := renderers[format]
+	if !ok {
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+	return render(w, g)
+}
+
+func riskColor(level string) string {
+	switch strings.ToUpper(level) {
+	case "CRITICAL":
+		return "#d32f2f"
+	case "HIGH":
+		return "#f57c00"
+	case "MEDIUM":
+		return "#fbc02d"
+	case "LOW":
+		return "#388e3c"
+	}
+	return "#9e9e9e"
+}
+
+// edgeWidths scales edge widths linearly by ReqRate into [1, 6].
+func edgeWidths(edges []Edge) []float64 {
+	maxRate := 0.0
+	for _, e := range edges {
+		maxRate = math.Max(maxRate, e.ReqRate)
+	}
+	widths := make([]float64, len(edges))
+	for i, e := range edges {
+		widths[i] = 1
+		if maxRate > 0 {
+			widths[i] = math.Round((1+5*e.ReqRate/maxRate)*10) / 10
+		}
+	}
+	return widths
+}
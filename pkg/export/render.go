@@ -0,0 +1,200 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const criticalColor = "#d32f2f"
+
+func renderDOT(w io.Writer, g *Graph) error {
+	bw := bufio.NewWriter(w)
+	quote := func(s string) string { return strconv.Quote(s) }
+
+	fmt.Fprintf(bw, "digraph %s {\n", quote(g.Title))
+	fmt.Fprintln(bw, "  rankdir=LR;")
+	fmt.Fprintln(bw, `  node [shape=box, style="rounded,filled", fontname="Helvetica"];`)
+	fmt.Fprintln(bw, `  edge [fontname="Helvetica", fontsize=10];`)
+
+	for _, n := range g.Nodes {
+		attrs := []string{
+			"label=" + quote(n.Label),
+			"fillcolor=" + quote(riskColor(n.RiskLevel)),
+		}
+		switch n.Role {
+		case RoleTarget:
+			attrs = append(attrs, "penwidth=3", "peripheries=2")
+		case RoleUnreachable:
+			attrs = append(attrs, `style="rounded,filled,dashed"`, "penwidth=2")
+		case RoleAffected:
+			attrs = append(attrs, "penwidth=2")
+		}
+		fmt.Fprintf(bw, "  %s [%s];\n", quote(n.ID), strings.Join(attrs, ", "))
+	}
+
+	widths := edgeWidths(g.Edges)
+	for i, e := range g.Edges {
+		attrs := []string{
+			"penwidth=" + strconv.FormatFloat(widths[i], 'f', -1, 64),
+			"label=" + quote(fmt.Sprintf("%.1f rps", e.ReqRate)),
+		}
+		if e.Critical {
+			attrs = append(attrs, "color="+quote(criticalColor), "fontcolor="+quote(criticalColor))
+		}
+		fmt.Fprintf(bw, "  %s -> %s [%s];\n", quote(e.Source), quote(e.Target), strings.Join(attrs, ", "))
+	}
+
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+func renderMermaid(w io.Writer, g *Graph) error {
+	bw := bufio.NewWriter(w)
+	label := strings.NewReplacer(`"`, "#quot;", "\n", " ")
+
+	// Mermaid IDs cannot contain ':' so nodes get positional aliases.
+	alias := make(map[string]string, len(g.Nodes))
+	fmt.Fprintln(bw, "graph LR")
+	for i, n := range g.Nodes {
+		alias[n.ID] = fmt.Sprintf("n%d", i)
+		fmt.Fprintf(bw, "  %s[\"%s\"]\n", alias[n.ID], label.Replace(n.Label))
+	}
+
+	// Edges to nodes outside the graph still need a declaration.
+	nodeRef := func(id string) string {
+		if a, ok := alias[id]; ok {
+			return a
+		}
+		a := fmt.Sprintf("n%d", len(alias))
+		alias[id] = a
+		fmt.Fprintf(bw, "  %s[\"%s\"]\n", a, label.Replace(id))
+		return a
+	}
+
+	widths := edgeWidths(g.Edges)
+	var linkStyles []string
+	for i, e := range g.Edges {
+		src, dst := nodeRef(e.Source), nodeRef(e.Target)
+		fmt.Fprintf(bw, "  %s -->|%.1f rps| %s\n", src, e.ReqRate, dst)
+		style := fmt.Sprintf("stroke-width:%spx", strconv.FormatFloat(widths[i], 'f', -1, 64))
+		if e.Critical {
+			style += ",stroke:" + criticalColor
+		}
+		linkStyles = append(linkStyles, fmt.Sprintf("  linkStyle %d %s", i, style))
+	}
+
+	for _, n := range g.Nodes {
+		style := "fill:" + riskColor(n.RiskLevel) + ",color:#fff"
+		switch n.Role {
+		case RoleTarget:
+			style += ",stroke:#000,stroke-width:4px"
+		case RoleUnreachable:
+			style += ",stroke:#000,stroke-width:2px,stroke-dasharray:5 5"
+		case RoleAffected:
+			style += ",stroke:#000,stroke-width:2px"
+		}
+		fmt.Fprintf(bw, "  style %s %s\n", alias[n.ID], style)
+	}
+	for _, s := range linkStyles {
+		fmt.Fprintln(bw, s)
+	}
+	return bw.Flush()
+}
+
+func renderGraphML(w io.Writer, g *Graph) error {
+	bw := bufio.NewWriter(w)
+	esc := func(s string) string {
+		var b strings.Builder
+		xml.EscapeText(&b, []byte(s))
+		return b.String()
+	}
+
+	fmt.Fprintln(bw, xml.Header+`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`)
+	keys := []struct{ id, scope, typ string }{
+		{"label", "node", "string"},
+		{"riskLevel", "node", "string"},
+		{"role", "node", "string"},
+		{"color", "node", "string"},
+		{"reqRate", "edge", "double"},
+		{"latencyP95Ms", "edge", "double"},
+		{"width", "edge", "double"},
+		{"critical", "edge", "boolean"},
+	}
+	for _, k := range keys {
+		fmt.Fprintf(bw, "  <key id=%q for=%q attr.name=%q attr.type=%q/>\n", k.id, k.scope, k.id, k.typ)
+	}
+
+	fmt.Fprintf(bw, "  <graph id=\"%s\" edgedefault=\"directed\">\n", esc(g.Title))
+	for _, n := range g.Nodes {
+		fmt.Fprintf(bw, "    <node id=\"%s\">\n", esc(n.ID))
+		fmt.Fprintf(bw, "      <data key=\"label\">%s</data>\n", esc(n.Label))
+		fmt.Fprintf(bw, "      <data key=\"riskLevel\">%s</data>\n", esc(n.RiskLevel))
+		fmt.Fprintf(bw, "      <data key=\"role\">%s</data>\n", esc(n.Role))
+		fmt.Fprintf(bw, "      <data key=\"color\">%s</data>\n", riskColor(n.RiskLevel))
+		fmt.Fprintln(bw, "    </node>")
+	}
+
+	widths := edgeWidths(g.Edges)
+	for i, e := range g.Edges {
+		fmt.Fprintf(bw, "    <edge id=\"e%d\" source=\"%s\" target=\"%s\">\n", i, esc(e.Source), esc(e.Target))
+		fmt.Fprintf(bw, "      <data key=\"reqRate\">%s</data>\n", strconv.FormatFloat(e.ReqRate, 'f', -1, 64))
+		fmt.Fprintf(bw, "      <data key=\"latencyP95Ms\">%s</data>\n", strconv.FormatFloat(e.LatencyP95Ms, 'f', -1, 64))
+		fmt.Fprintf(bw, "      <data key=\"width\">%s</data>\n", strconv.FormatFloat(widths[i], 'f', -1, 64))
+		fmt.Fprintf(bw, "      <data key=\"critical\">%t</data>\n", e.Critical)
+		fmt.Fprintln(bw, "    </edge>")
+	}
+	fmt.Fprintln(bw, "  </graph>")
+	fmt.Fprintln(bw, "</graphml>")
+	return bw.Flush()
+}
+
+type cytoscapeElement struct {
+	Data    map[string]interface{} `json:"data"`
+	Classes string                 `json:"classes,omitempty"`
+}
+
+func renderCytoscape(w io.Writer, g *Graph) error {
+	nodes := make([]cytoscapeElement, 0, len(g.Nodes))
+	for _, n := range g.Nodes {
+		nodes = append(nodes, cytoscapeElement{
+			Data: map[string]interface{}{
+				"id":        n.ID,
+				"label":     n.Label,
+				"riskLevel": n.RiskLevel,
+				"color":     riskColor(n.RiskLevel),
+			},
+			Classes: n.Role,
+		})
+	}
+
+	widths := edgeWidths(g.Edges)
+	edges := make([]cytoscapeElement, 0, len(g.Edges))
+	for i, e := range g.Edges {
+		el := cytoscapeElement{
+			Data: map[string]interface{}{
+				"id":           fmt.Sprintf("%s->%s", e.Source, e.Target),
+				"source":       e.Source,
+				"target":       e.Target,
+				"reqRate":      e.ReqRate,
+				"latencyP95Ms": e.LatencyP95Ms,
+				"width":        widths[i],
+			},
+		}
+		if e.Critical {
+			el.Classes = "critical"
+		}
+		edges = append(edges, el)
+	}
+
+	return json.NewEncoder(w).Encode(map[string]interface{}{
+		"elements": map[string]interface{}{
+			"nodes": nodes,
+			"edges": edges,
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	"predictive-analysis-engine/pkg/simulation"
+)
+
+// graphBuilder accumulates de-duplicated nodes and edges keyed by canonical
+// service ID.
+type graphBuilder struct {
+	g          *Graph
+	riskLevels map[string]string
+	latencies  map[string]float64
+	nodeIndex  map[string]int
+	edgeIndex  map[string]int
+}
+
+func newGraphBuilder(title string, riskLevels map[string]string, latencies map[string]float64) *graphBuilder {
+	return &graphBuilder{
+		g:          &Graph{Title: title},
+		riskLevels: riskLevels,
+		latencies:  latencies,
+		nodeIndex:  map[string]int{},
+		edgeIndex:  map[string]int{},
+	}
+}
+
+// addNode adds a node or upgrades the role of an existing one. Target and
+// unreachable roles take precedence over affected.
+func (b *graphBuilder) addNode(id, label, role string) {
+	id = simulation.CanonicalServiceId(id)
+	if i, ok := b.nodeIndex[id]; ok {
+		if b.g.Nodes[i].Role == "" || role == RoleTarget || role == RoleUnreachable {
+			if role != "" {
+				b.g.Nodes[i].Role = role
+			}
+		}
+		return
+	}
+	if label == "" {
+		label = id[strings.Index(id, ":")+1:]
+	}
+	b.nodeIndex[id] = len(b.g.Nodes)
+	b.g.Nodes = append(b.g.Nodes, Node{ID: id, Label: label, RiskLevel: b.riskLevels[id], Role: role})
+}
+
+func (b *graphBuilder) addEdge(src, dst string, rps float64, critical bool) {
+	src, dst = simulation.CanonicalServiceId(src), simulation.CanonicalServiceId(dst)
+	key := src + "->" + dst
+	if i, ok := b.edgeIndex[key]; ok {
+		if rps > b.g.Edges[i].ReqRate {
+			b.g.Edges[i].ReqRate = rps
+		}
+		b.g.Edges[i].Critical = b.g.Edges[i].Critical || critical
+		return
+	}
+	b.edgeIndex[key] = len(b.g.Edges)
+	b.g.Edges = append(b.g.Edges, Edge{Source: src, Target: dst, ReqRate: rps, LatencyP95Ms: b.latencies[key], Critical: critical})
+}
+
+// FailureGraph builds the impact graph of a failure simulation: the target,
+// its callers and downstream services, unreachable services and the critical
+// paths into the target. riskLevels and latencies are keyed by canonical
+// service ID and "source->target" respectively; either may be nil.
+func FailureGraph(res *simulation.FailureSimulationResult, riskLevels map[string]string, latencies map[string]float64) *Graph {
+	targetID := simulation.CanonicalServiceId(res.Target.Namespace + ":" + res.Target.Name)
+	b := newGraphBuilder(fmt.Sprintf("failure of %s", targetID), riskLevels, latencies)
+
+	b.addNode(targetID, res.Target.Name, RoleTarget)
+	for _, c := range res.AffectedCallers {
+		b.addNode(c.ServiceId, c.Name, RoleAffected)
+		b.addEdge(c.ServiceId, targetID, c.LostTrafficRps, false)
+	}
+	for _, d := range res.AffectedDownstream {
+		b.addNode(d.ServiceId, d.Name, RoleAffected)
+		b.addEdge(targetID, d.ServiceId, d.LostTrafficRps, false)
+	}
+	for _, u := range res.UnreachableServices {
+		b.addNode(u.ServiceId, u.Name, RoleUnreachable)
+	}
+	for _, p := range res.CriticalPaths {
+		for i, id := range p.Path {
+			b.addNode(id, "", "")
+			if i > 0 {
+				b.addEdge(p.Path[i-1], id, p.PathRps, true)
+			}
+		}
+	}
+	return b.g
+}
+
+// ScalingGraph builds the graph of a scaling simulation: the scaled target,
+// the callers whose latency changes and the paths through which they reach it.
+func ScalingGraph(res *simulation.ScalingSimulationResult, riskLevels map[string]string, latencies map[string]float64) *Graph {
+	targetID := simulation.CanonicalServiceId(res.Target.Namespace + ":" + res.Target.Name)
+	b := newGraphBuilder(fmt.Sprintf("scaling of %s", targetID), riskLevels, latencies)
+
+	b.addNode(targetID, res.Target.Name, RoleTarget)
+	for _, c := range res.AffectedCallers.Items {
+		b.addNode(c.ServiceId, c.Name, RoleAffected)
+	}
+	for _, p := range res.AffectedPaths {
+		for i, id := range p.Path {
+			b.addNode(id, "", "")
+			if i > 0 {
+				b.addEdge(p.Path[i-1], id, p.PathRps, false)
+			}
+		}
+	}
+	return b.g
+}
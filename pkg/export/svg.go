@@ -0,0 +1,168 @@
+package export
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	svgNodeWidth  = 170
+	svgNodeHeight = 40
+	svgColGap     = 90
+	svgRowGap     = 30
+	svgMargin     = 20
+)
+
+// RenderSVG draws g as a standalone SVG using a simple left-to-right layered
+// layout, so it can be inlined into self-contained HTML without scripts.
+func RenderSVG(w io.Writer, g *Graph) error {
+	layers := layerNodes(g)
+
+	type point struct{ x, y int }
+	pos := make(map[string]point, len(g.Nodes))
+	columns := map[int][]Node{}
+	maxLayer, maxRows := 0, 0
+	for _, n := range g.Nodes {
+		l := layers[n.ID]
+		columns[l] = append(columns[l], n)
+		if l > maxLayer {
+			maxLayer = l
+		}
+		if len(columns[l]) > maxRows {
+			maxRows = len(columns[l])
+		}
+	}
+	for l, col := range columns {
+		for i, n := range col {
+			pos[n.ID] = point{
+				x: svgMargin + l*(svgNodeWidth+svgColGap),
+				y: svgMargin + i*(svgNodeHeight+svgRowGap),
+			}
+		}
+	}
+
+	width := 2*svgMargin + (maxLayer+1)*svgNodeWidth + maxLayer*svgColGap
+	height := 2*svgMargin + maxRows*svgNodeHeight + (maxRows-1)*svgRowGap
+	if maxRows == 0 {
+		height = 2 * svgMargin
+	}
+
+	esc := func(s string) string {
+		var b strings.Builder
+		xml.EscapeText(&b, []byte(s))
+		return b.String()
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="Helvetica, Arial, sans-serif" font-size="12">`+"\n", width, height, width, height)
+	fmt.Fprintf(bw, "<title>%s</title>\n", esc(g.Title))
+	fmt.Fprintln(bw, `<defs>`)
+	fmt.Fprintln(bw, `<marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="6" markerHeight="6" orient="auto-start-reverse"><path d="M 0 0 L 10 5 L 0 10 z" fill="#555"/></marker>`)
+	fmt.Fprintf(bw, `<marker id="arrow-critical" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="6" markerHeight="6" orient="auto-start-reverse"><path d="M 0 0 L 10 5 L 0 10 z" fill="%s"/></marker>`+"\n", criticalColor)
+	fmt.Fprintln(bw, `</defs>`)
+
+	widths := edgeWidths(g.Edges)
+	for i, e := range g.Edges {
+		src, okSrc := pos[e.Source]
+		dst, okDst := pos[e.Target]
+		if !okSrc || !okDst {
+			continue
+		}
+		x1, y1 := src.x+svgNodeWidth, src.y+svgNodeHeight/2
+		x2, y2 := dst.x, dst.y+svgNodeHeight/2
+		path := fmt.Sprintf("M %d %d C %d %d, %d %d, %d %d", x1, y1, x1+svgColGap/2, y1, x2-svgColGap/2, y2, x2, y2)
+		if dst.x <= src.x {
+			// Back edge: loop above the nodes instead of crossing them.
+			top := min(src.y, dst.y) - svgRowGap/2
+			x2 = dst.x + svgNodeWidth/2
+			y2 = dst.y
+			path = fmt.Sprintf("M %d %d C %d %d, %d %d, %d %d", x1, y1, x1+svgColGap/2, top, x2, top, x2, y2)
+		}
+		color, marker := "#555", "arrow"
+		if e.Critical {
+			color, marker = criticalColor, "arrow-critical"
+		}
+		fmt.Fprintf(bw, `<path d="%s" fill="none" stroke="%s" stroke-width="%.1f" stroke-opacity="0.8" marker-end="url(#%s)"><title>%s → %s: %.1f rps</title></path>`+"\n",
+			path, color, widths[i], marker, esc(e.Source), esc(e.Target), e.ReqRate)
+	}
+
+	for _, n := range g.Nodes {
+		p := pos[n.ID]
+		stroke, strokeWidth, dash := "#333", 1, ""
+		switch n.Role {
+		case RoleTarget:
+			stroke, strokeWidth = "#000", 4
+		case RoleUnreachable:
+			strokeWidth, dash = 2, ` stroke-dasharray="6 4"`
+		case RoleAffected:
+			strokeWidth = 2
+		}
+		fmt.Fprintf(bw, `<g><title>%s (%s)</title><rect x="%d" y="%d" width="%d" height="%d" rx="8" fill="%s" stroke="%s" stroke-width="%d"%s/>`,
+			esc(n.ID), esc(riskLabel(n.RiskLevel)), p.x, p.y, svgNodeWidth, svgNodeHeight, riskColor(n.RiskLevel), stroke, strokeWidth, dash)
+		fmt.Fprintf(bw, `<text x="%d" y="%d" text-anchor="middle" dominant-baseline="middle" fill="#fff">%s</text></g>`+"\n",
+			p.x+svgNodeWidth/2, p.y+svgNodeHeight/2, esc(n.Label))
+	}
+
+	fmt.Fprintln(bw, "</svg>")
+	return bw.Flush()
+}
+
+func riskLabel(level string) string {
+	if level == "" {
+		return "risk unknown"
+	}
+	return strings.ToLower(level) + " risk"
+}
+
+// layerNodes assigns each node the length of the longest path reaching it
+// from a source node. Edges that close a cycle (DFS back edges) are ignored
+// for layering and drawn as back edges.
+func layerNodes(g *Graph) map[string]int {
+	adj := make(map[string][]string, len(g.Nodes))
+	for _, e := range g.Edges {
+		adj[e.Source] = append(adj[e.Source], e.Target)
+	}
+
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make(map[string]int, len(g.Nodes))
+	var order []string
+	back := map[[2]string]bool{}
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = inProgress
+		for _, next := range adj[id] {
+			switch state[next] {
+			case unvisited:
+				visit(next)
+			case inProgress:
+				back[[2]string{id, next}] = true
+			}
+		}
+		state[id] = done
+		order = append(order, id)
+	}
+	for _, n := range g.Nodes {
+		if state[n.ID] == unvisited {
+			visit(n.ID)
+		}
+	}
+
+	// order is a reverse topological order of the acyclic edge set.
+	layers := make(map[string]int, len(g.Nodes))
+	for i := len(order) - 1; i >= 0; i-- {
+		id := order[i]
+		for _, next := range adj[id] {
+			if !back[[2]string{id, next}] && layers[next] < layers[id]+1 {
+				layers[next] = layers[id] + 1
+			}
+		}
+	}
+	return layers
+}
@@ -0,0 +1,273 @@
+package forecast
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"predictive-analysis-engine/pkg/clients/telemetry"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/simulation"
+)
+
+// Forecasts carry 95% prediction intervals.
+const (
+	ConfidenceLevel = 0.95
+	zScore          = 1.96
+)
+
+const maxLookback = 28 * 24 * time.Hour
+
+// MetricsSource is the subset of the telemetry client the forecaster reads
+// from.
+type MetricsSource interface {
+	CheckStatus() (bool, string)
+	GetServiceMetrics(ctx context.Context, service string, from, to string, stepSeconds int) ([]telemetry.ServiceMetric, error)
+	GetEdgeMetrics(ctx context.Context, fromSvc, toSvc, from, to string, stepSeconds int) ([]telemetry.EdgeMetric, error)
+}
+
+type Point struct {
+	Timestamp string   `json:"timestamp"`
+	RPS       Interval `json:"rps"`
+	P95       Interval `json:"p95"`
+}
+
+type ServiceModels struct {
+	RPS *Model `json:"rps"`
+	P95 *Model `json:"p95"`
+}
+
+type ServiceForecast struct {
+	ServiceID       string        `json:"serviceId"`
+	StepSeconds     int           `json:"stepSeconds"`
+	Horizon         string        `json:"horizon"`
+	ConfidenceLevel float64       `json:"confidenceLevel"`
+	HistoryFrom     string        `json:"historyFrom"`
+	HistoryTo       string        `json:"historyTo"`
+	Models          ServiceModels `json:"models"`
+	Points          []Point       `json:"points"`
+}
+
+type Forecaster struct {
+	cfg       config.ForecastConfig
+	telemetry MetricsSource
+}
+
+func NewForecaster(cfg config.ForecastConfig, ts MetricsSource) *Forecaster {
+	return &Forecaster{cfg: cfg, telemetry: ts}
+}
+
+// MaxHorizon is the furthest ahead forecasts are made.
+func (f *Forecaster) MaxHorizon() time.Duration {
+	return time.Duration(f.cfg.MaxHorizonHours) * time.Hour
+}
+
+// ForecastService forecasts the request rate and p95 of a service every
+// stepSeconds from now until now+horizon, from FORECAST_LOOKBACK_HOURS of
+// service_metrics at the same step.
+func (f *Forecaster) ForecastService(ctx context.Context, serviceID string, horizon time.Duration, stepSeconds int) (*ServiceForecast, error) {
+	if ok, reason := f.telemetry.CheckStatus(); !ok {
+		return nil, common.NewError(common.CodeTelemetryUnavailable, "%s", reason)
+	}
+
+	serviceID = simulation.CanonicalServiceId(serviceID)
+	_, name, _ := strings.Cut(serviceID, ":")
+	step := time.Duration(stepSeconds) * time.Second
+	to := time.Now().UTC().Truncate(step)
+	from := to.Add(-f.lookback())
+
+	metrics, err := f.telemetry.GetServiceMetrics(ctx, name, from.Format(time.RFC3339), to.Format(time.RFC3339), stepSeconds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeUpstreamError, err, "Service metrics query failed")
+	}
+	var history []observation
+	for _, m := range metrics {
+		if simulation.CanonicalServiceId(m.Namespace+":"+m.Service) != serviceID {
+			continue
+		}
+		if at, err := time.Parse(time.RFC3339, m.Timestamp); err == nil {
+			history = append(history, observation{at: at.UTC(), values: []float64{m.RequestRate, m.P95}})
+		}
+	}
+
+	start, columns := regularize(history, step, 2)
+	if len(columns[0]) < MinSamples {
+		return nil, common.NewError(common.CodeNoObservedData, "Not enough service_metrics history to forecast %s: %d of %d buckets", serviceID, len(columns[0]), MinSamples)
+	}
+	period := seasonPeriod(stepSeconds)
+	rpsModel, err := Fit(columns[0], period)
+	if err != nil {
+		return nil, err
+	}
+	p95Model, err := Fit(columns[1], period)
+	if err != nil {
+		return nil, err
+	}
+
+	last := start.Add(time.Duration(len(columns[0])-1) * step)
+	end := to.Add(horizon)
+	points := []Point{}
+	for h := 1; ; h++ {
+		at := last.Add(time.Duration(h) * step)
+		if at.After(end) {
+			break
+		}
+		if !at.After(to) {
+			continue
+		}
+		points = append(points, Point{
+			Timestamp: at.Format(time.RFC3339),
+			RPS:       nonNegative(rpsModel.Forecast(h, zScore)),
+			P95:       nonNegative(p95Model.Forecast(h, zScore)),
+		})
+	}
+
+	return &ServiceForecast{
+		ServiceID:       serviceID,
+		StepSeconds:     stepSeconds,
+		Horizon:         horizon.String(),
+		ConfidenceLevel: ConfidenceLevel,
+		HistoryFrom:     start.Format(time.RFC3339),
+		HistoryTo:       last.Format(time.RFC3339),
+		Models:          ServiceModels{RPS: rpsModel, P95: p95Model},
+		Points:          points,
+	}, nil
+}
+
+// ForecastEdges predicts the request rate and latencyMetric of each edge at
+// the given time from edge_metrics history at FORECAST_STEP_SECONDS. Edges
+// with too little history are left out of the result.
+func (f *Forecaster) ForecastEdges(ctx context.Context, edges []simulation.EdgeKey, latencyMetric string, at time.Time) (map[simulation.EdgeKey]simulation.EdgeForecast, error) {
+	if ok, reason := f.telemetry.CheckStatus(); !ok {
+		return nil, common.NewError(common.CodeTelemetryUnavailable, "%s", reason)
+	}
+
+	stepSeconds := f.stepSeconds()
+	step := time.Duration(stepSeconds) * time.Second
+	to := time.Now().UTC().Truncate(step)
+	from := to.Add(-f.lookback())
+
+	metrics, err := f.telemetry.GetEdgeMetrics(ctx, "", "", from.Format(time.RFC3339), to.Format(time.RFC3339), stepSeconds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeUpstreamError, err, "Edge metrics query failed")
+	}
+
+	wanted := map[simulation.EdgeKey]bool{}
+	for _, e := range edges {
+		wanted[e] = true
+	}
+	history := map[simulation.EdgeKey][]observation{}
+	for _, m := range metrics {
+		key := simulation.EdgeKey{
+			Source: simulation.CanonicalServiceId(m.Namespace + ":" + m.From),
+			Target: simulation.CanonicalServiceId(m.Namespace + ":" + m.To),
+		}
+		if !wanted[key] {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339, m.Timestamp); err == nil {
+			history[key] = append(history[key], observation{at: t.UTC(), values: []float64{m.RequestRate, edgeLatency(m, latencyMetric)}})
+		}
+	}
+
+	period := seasonPeriod(stepSeconds)
+	out := map[simulation.EdgeKey]simulation.EdgeForecast{}
+	for key, obs := range history {
+		start, columns := regularize(obs, step, 2)
+		rateModel, err := Fit(columns[0], period)
+		if err != nil {
+			continue
+		}
+		latencyModel, err := Fit(columns[1], period)
+		if err != nil {
+			continue
+		}
+		last := start.Add(time.Duration(len(columns[0])-1) * step)
+		h := int(math.Ceil(float64(at.Sub(last)) / float64(step)))
+		if h < 1 {
+			h = 1
+		}
+		out[key] = simulation.EdgeForecast{
+			Rate:      math.Max(rateModel.Forecast(h, zScore).Value, 0),
+			LatencyMs: math.Max(latencyModel.Forecast(h, zScore).Value, 0),
+		}
+	}
+	return out, nil
+}
+
+func (f *Forecaster) lookback() time.Duration {
+	lookback := time.Duration(f.cfg.LookbackHours) * time.Hour
+	if lookback <= 0 || lookback > maxLookback {
+		return maxLookback
+	}
+	return lookback
+}
+
+func (f *Forecaster) stepSeconds() int {
+	if f.cfg.StepSeconds <= 0 {
+		return 3600
+	}
+	return f.cfg.StepSeconds
+}
+
+type observation struct {
+	at     time.Time
+	values []float64
+}
+
+// regularize places observations on a grid of step starting at the earliest
+// one and fills missing buckets by linear interpolation, returning the grid
+// start and one column per value.
+func regularize(obs []observation, step time.Duration, width int) (time.Time, [][]float64) {
+	columns := make([][]float64, width)
+	if len(obs) == 0 {
+		return time.Time{}, columns
+	}
+	sort.Slice(obs, func(i, j int) bool { return obs[i].at.Before(obs[j].at) })
+	start := obs[0].at
+	n := int(obs[len(obs)-1].at.Sub(start)/step) + 1
+	for c := range columns {
+		columns[c] = make([]float64, n)
+	}
+
+	prev := -1
+	for _, o := range obs {
+		idx := int(o.at.Sub(start) / step)
+		for c := range columns {
+			columns[c][idx] = o.values[c]
+			for gap := prev + 1; prev >= 0 && gap < idx; gap++ {
+				frac := float64(gap-prev) / float64(idx-prev)
+				columns[c][gap] = columns[c][prev] + frac*(o.values[c]-columns[c][prev])
+			}
+		}
+		prev = idx
+	}
+	return start, columns
+}
+
+// seasonPeriod is the number of steps in a day, or 0 when the step does not
+// divide a day into at least two buckets.
+func seasonPeriod(stepSeconds int) int {
+	const day = 86400
+	if stepSeconds <= 0 || day%stepSeconds != 0 || day/stepSeconds < 2 {
+		return 0
+	}
+	return day / stepSeconds
+}
+
+func edgeLatency(m telemetry.EdgeMetric, metric string) float64 {
+	switch metric {
+	case "p50":
+		return m.P50
+	case "p99":
+		return m.P99
+	}
+	return m.P95
+}
+
+func nonNegative(iv Interval) Interval {
+	return Interval{Value: math.Max(iv.Value, 0), Lower: math.Max(iv.Lower, 0), Upper: math.Max(iv.Upper, 0)}
+}
@@ -0,0 +1,188 @@
+// Package forecast fits exponential smoothing models to service_metrics and
+// edge_metrics history and projects them forward with prediction intervals.
+package forecast
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	MethodHoltWinters = "holt_winters"
+	MethodHolt        = "holt"
+)
+
+// damping flattens the trend as the forecast moves away from the data, so a
+// short-lived ramp in the history is not extrapolated across a whole week.
+const damping = 0.98
+
+// MinSamples is the shortest history a model is fitted to.
+const MinSamples = 12
+
+var ErrTooFewSamples = errors.New("too few samples to fit a forecast model")
+
+var (
+	alphaGrid = []float64{0.05, 0.1, 0.2, 0.3, 0.5, 0.7, 0.9}
+	betaGrid  = []float64{0, 0.01, 0.05, 0.1, 0.2}
+	gammaGrid = []float64{0.05, 0.1, 0.2, 0.3, 0.5}
+)
+
+// Model is a fitted additive Holt-Winters model, or Holt's damped linear
+// trend when the history is shorter than two seasons. RMSE is the
+// one-step-ahead error on the history and sets the interval width.
+type Model struct {
+	Method  string  `json:"method"`
+	Alpha   float64 `json:"alpha"`
+	Beta    float64 `json:"beta"`
+	Gamma   float64 `json:"gamma,omitempty"`
+	Period  int     `json:"period,omitempty"`
+	RMSE    float64 `json:"rmse"`
+	Samples int     `json:"samples"`
+
+	level    float64
+	trend    float64
+	seasonal []float64
+}
+
+// Interval is a predicted value with the bounds of its prediction interval.
+type Interval struct {
+	Value float64 `json:"value"`
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+}
+
+// Fit fits a model to evenly spaced values with a season of period steps,
+// choosing the smoothing parameters with the lowest one-step-ahead squared
+// error. A period below 2 fits Holt's trend without seasonality.
+func Fit(values []float64, period int) (*Model, error) {
+	if len(values) < MinSamples {
+		return nil, ErrTooFewSamples
+	}
+	seasonal := period >= 2 && len(values) >= 2*period
+
+	var best *Model
+	bestSSE := math.Inf(1)
+	gammas := gammaGrid
+	if !seasonal {
+		gammas = []float64{0}
+	}
+	for _, a := range alphaGrid {
+		for _, b := range betaGrid {
+			for _, g := range gammas {
+				var m *Model
+				var sse float64
+				if seasonal {
+					m, sse = fitHoltWinters(values, period, a, b, g)
+				} else {
+					m, sse = fitHolt(values, a, b)
+				}
+				if sse < bestSSE {
+					best, bestSSE = m, sse
+				}
+			}
+		}
+	}
+	if best == nil {
+		return nil, ErrTooFewSamples
+	}
+	return best, nil
+}
+
+func fitHoltWinters(values []float64, period int, alpha, beta, gamma float64) (*Model, float64) {
+	first, second := mean(values[:period]), mean(values[period:2*period])
+	level := first
+	trend := (second - first) / float64(period)
+	seasonal := make([]float64, period)
+	for i := 0; i < period; i++ {
+		seasonal[i] = values[i] - first
+	}
+
+	var sse float64
+	for t := period; t < len(values); t++ {
+		s := seasonal[t%period]
+		err := values[t] - (level + damping*trend + s)
+		sse += err * err
+
+		next := alpha*(values[t]-s) + (1-alpha)*(level+damping*trend)
+		trend = beta*(next-level) + (1-beta)*damping*trend
+		seasonal[t%period] = gamma*(values[t]-next) + (1-gamma)*s
+		level = next
+	}
+
+	// Rotate so seasonal[0] belongs to the step after the last value.
+	n := len(values)
+	rotated := make([]float64, period)
+	for i := range rotated {
+		rotated[i] = seasonal[(n+i)%period]
+	}
+	steps := n - period
+	return &Model{
+		Method:   MethodHoltWinters,
+		Alpha:    alpha,
+		Beta:     beta,
+		Gamma:    gamma,
+		Period:   period,
+		RMSE:     math.Sqrt(sse / float64(steps)),
+		Samples:  n,
+		level:    level,
+		trend:    trend,
+		seasonal: rotated,
+	}, sse
+}
+
+func fitHolt(values []float64, alpha, beta float64) (*Model, float64) {
+	level := values[0]
+	trend := values[1] - values[0]
+
+	var sse float64
+	for t := 1; t < len(values); t++ {
+		err := values[t] - (level + damping*trend)
+		sse += err * err
+
+		next := alpha*values[t] + (1-alpha)*(level+damping*trend)
+		trend = beta*(next-level) + (1-beta)*damping*trend
+		level = next
+	}
+	return &Model{
+		Method:  MethodHolt,
+		Alpha:   alpha,
+		Beta:    beta,
+		RMSE:    math.Sqrt(sse / float64(len(values)-1)),
+		Samples: len(values),
+		level:   level,
+		trend:   trend,
+	}, sse
+}
+
+// Forecast returns the prediction h steps after the last fitted value, with
+// an interval of z standard errors. The error grows with h as in the
+// standard additive Holt-Winters variance approximation.
+func (m *Model) Forecast(h int, z float64) Interval {
+	var trend float64
+	for i := 1; i <= h; i++ {
+		trend += math.Pow(damping, float64(i)) * m.trend
+	}
+	value := m.level + trend
+	if m.Period > 0 {
+		value += m.seasonal[(h-1)%m.Period]
+	}
+
+	variance := 1.0
+	for k := 1; k < h; k++ {
+		c := m.Alpha * (1 + float64(k)*m.Beta)
+		if m.Period > 0 && k%m.Period == 0 {
+			c += m.Gamma
+		}
+		variance += c * c
+	}
+	width := z * m.RMSE * math.Sqrt(variance)
+	return Interval{Value: value, Lower: value - width, Upper: value + width}
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
@@ -0,0 +1,89 @@
+package graphqlapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// Request is the standard GraphQL-over-HTTP request body.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+type Handler struct {
+	schema      *graphql.Schema
+	graphClient *graph.Client
+}
+
+func NewHandler(graphClient *graph.Client, store storage.DecisionReader) *Handler {
+	schema := graphql.MustParseSchema(schemaSDL, &resolver{graphClient: graphClient, store: store},
+		graphql.MaxDepth(10),
+		graphql.MaxParallelism(10),
+	)
+	return &Handler{schema: schema, graphClient: graphClient}
+}
+
+// ServeHTTP godoc
+// @Summary GraphQL Query
+// @Description Executes a GraphQL query over services, edges, centrality, risk and decisions. GET accepts query, operationName and variables (JSON) as query parameters.
+// @Tags graphql
+// @Accept json
+// @Produce json
+// @Param request body graphqlapi.Request true "GraphQL request"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Router /graphql [post]
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	switch r.Method {
+	case http.MethodGet:
+		q := r.URL.Query()
+		req.Query = q.Get("query")
+		req.OperationName = q.Get("operationName")
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				respondGraphQLError(w, http.StatusBadRequest, "Invalid variables parameter")
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondGraphQLError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		respondGraphQLError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	if req.Query == "" {
+		respondGraphQLError(w, http.StatusBadRequest, "Missing query")
+		return
+	}
+
+	ctx := withLoader(r.Context(), h.graphClient)
+	resp := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func respondGraphQLError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]string{{"message": message}},
+	})
+}
@@ -0,0 +1,65 @@
+package graphqlapi
+
+import (
+	"context"
+	"sync"
+
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/snapshot"
+)
+
+type loaderKey struct{}
+
+// snapshotLoader fetches the dependency snapshot at most once per request so
+// that nested fields (callers, callees, edge endpoints) resolve from the same
+// view of the graph without extra round-trips to the Graph Engine.
+type snapshotLoader struct {
+	client *graph.Client
+
+	once     sync.Once
+	snapshot *snapshot.Response
+	err      error
+
+	nodesByID map[string]*snapshot.Node
+	callers   map[string][]*snapshot.Edge
+	callees   map[string][]*snapshot.Edge
+}
+
+func withLoader(ctx context.Context, client *graph.Client) context.Context {
+	return context.WithValue(ctx, loaderKey{}, &snapshotLoader{client: client})
+}
+
+func loaderFrom(ctx context.Context) *snapshotLoader {
+	return ctx.Value(loaderKey{}).(*snapshotLoader)
+}
+
+func (l *snapshotLoader) load(ctx context.Context) error {
+	l.once.Do(func() {
+		l.snapshot, l.err = snapshot.Build(ctx, l.client, "")
+		if l.err != nil {
+			return
+		}
+
+		l.nodesByID = make(map[string]*snapshot.Node, len(l.snapshot.Nodes))
+		for i := range l.snapshot.Nodes {
+			n := &l.snapshot.Nodes[i]
+			l.nodesByID[n.ID] = n
+		}
+
+		l.callers = make(map[string][]*snapshot.Edge)
+		l.callees = make(map[string][]*snapshot.Edge)
+		for i := range l.snapshot.Edges {
+			e := &l.snapshot.Edges[i]
+			l.callees[e.Source] = append(l.callees[e.Source], e)
+			l.callers[e.Target] = append(l.callers[e.Target], e)
+		}
+	})
+	return l.err
+}
+
+func (l *snapshotLoader) node(ctx context.Context, id string) (*snapshot.Node, error) {
+	if err := l.load(ctx); err != nil {
+		return nil, err
+	}
+	return l.nodesByID[id], nil
+}
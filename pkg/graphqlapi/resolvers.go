@@ -0,0 +1,305 @@
+package graphqlapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	graphql "github.com/graph-gophers/graphql-go"
+
+	"predictive-analysis-engine/pkg/analysis"
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/logger"
+	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/snapshot"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+var errStoreUnavailable = common.NewError(common.CodeDecisionStoreUnavailable, "Decision store not available. Check DECISION_STORE_DRIVER configuration.")
+
+type resolver struct {
+	graphClient *graph.Client
+	store       storage.DecisionReader
+}
+
+func (r *resolver) Services(ctx context.Context, args struct{ Namespace *string }) ([]*serviceResolver, error) {
+	l := loaderFrom(ctx)
+	if err := l.load(ctx); err != nil {
+		return nil, toResolverError(err)
+	}
+
+	out := []*serviceResolver{}
+	for i := range l.snapshot.Nodes {
+		n := &l.snapshot.Nodes[i]
+		if args.Namespace != nil && *args.Namespace != "" && n.Namespace != *args.Namespace {
+			continue
+		}
+		out = append(out, &serviceResolver{root: r, node: n})
+	}
+	return out, nil
+}
+
+func (r *resolver) Service(ctx context.Context, args struct{ ID graphql.ID }) (*serviceResolver, error) {
+	n, err := loaderFrom(ctx).node(ctx, simulation.CanonicalServiceId(string(args.ID)))
+	if err != nil {
+		return nil, toResolverError(err)
+	}
+	if n == nil {
+		return nil, nil
+	}
+	return &serviceResolver{root: r, node: n}, nil
+}
+
+func (r *resolver) Edges(ctx context.Context, args struct{ Namespace *string }) ([]*edgeResolver, error) {
+	l := loaderFrom(ctx)
+	if err := l.load(ctx); err != nil {
+		return nil, toResolverError(err)
+	}
+
+	out := []*edgeResolver{}
+	for i := range l.snapshot.Edges {
+		e := &l.snapshot.Edges[i]
+		if args.Namespace != nil && *args.Namespace != "" {
+			src, dst := l.nodesByID[e.Source], l.nodesByID[e.Target]
+			if (src == nil || src.Namespace != *args.Namespace) && (dst == nil || dst.Namespace != *args.Namespace) {
+				continue
+			}
+		}
+		out = append(out, &edgeResolver{root: r, edge: e})
+	}
+	return out, nil
+}
+
+func (r *resolver) TopRisk(ctx context.Context, args struct {
+	Metric string
+	Limit  int32
+}) ([]*riskServiceResolver, error) {
+	limit := int(args.Limit)
+	if limit < 1 {
+		limit = 1
+	}
+	if limit > 20 {
+		limit = 20
+	}
+
+	result, err := analysis.GetTopRiskServices(ctx, r.graphClient, args.Metric, limit)
+	if err != nil {
+		return nil, toResolverError(err)
+	}
+
+	out := make([]*riskServiceResolver, 0, len(result.Services))
+	for _, s := range result.Services {
+		out = append(out, &riskServiceResolver{root: r, info: s})
+	}
+	return out, nil
+}
+
+func (r *resolver) Decisions(ctx context.Context, args struct {
+	Type          *string
+	ServiceID     *graphql.ID
+	Tag           *string
+	ApprovalState *string
+	Limit         int32
+	Offset        int32
+}) ([]*decisionResolver, error) {
+	opts := storage.GetHistoryOptions{
+		Limit:  int(args.Limit),
+		Offset: int(args.Offset),
+	}
+	if args.Type != nil {
+		opts.Type = *args.Type
+	}
+	if args.ServiceID != nil {
+		opts.ServiceID = simulation.CanonicalServiceId(string(*args.ServiceID))
+	}
+	if args.Tag != nil {
+		opts.Tag = *args.Tag
+	}
+	if args.ApprovalState != nil {
+		opts.ApprovalState = *args.ApprovalState
+	}
+	return r.decisions(opts)
+}
+
+func (r *resolver) decisions(opts storage.GetHistoryOptions) ([]*decisionResolver, error) {
+	if r.store == nil {
+		return nil, toResolverError(errStoreUnavailable)
+	}
+
+	records, err := r.store.GetHistory(opts)
+	if err != nil {
+		return nil, toResolverError(err)
+	}
+
+	out := make([]*decisionResolver, 0, len(records))
+	for _, rec := range records {
+		out = append(out, &decisionResolver{record: rec})
+	}
+	return out, nil
+}
+
+type serviceResolver struct {
+	root *resolver
+	node *snapshot.Node
+}
+
+func (s *serviceResolver) ID() graphql.ID         { return graphql.ID(s.node.ID) }
+func (s *serviceResolver) Name() string           { return s.node.Name }
+func (s *serviceResolver) Namespace() string      { return s.node.Namespace }
+func (s *serviceResolver) RiskLevel() string      { return s.node.RiskLevel }
+func (s *serviceResolver) RiskReason() string     { return s.node.RiskReason }
+func (s *serviceResolver) ReqRate() *float64      { return s.node.ReqRate }
+func (s *serviceResolver) ErrorRatePct() *float64 { return s.node.ErrorRatePct }
+func (s *serviceResolver) LatencyP95Ms() *float64 { return s.node.LatencyP95Ms }
+func (s *serviceResolver) AvailabilityPct() *float64 {
+	return s.node.AvailabilityPct
+}
+func (s *serviceResolver) PageRank() *float64    { return s.node.PageRank }
+func (s *serviceResolver) Betweenness() *float64 { return s.node.Betweenness }
+
+func (s *serviceResolver) PodCount() *int32 {
+	if s.node.PodCount == nil {
+		return nil
+	}
+	v := int32(*s.node.PodCount)
+	return &v
+}
+
+func (s *serviceResolver) Callers(ctx context.Context) ([]*edgeResolver, error) {
+	l := loaderFrom(ctx)
+	if err := l.load(ctx); err != nil {
+		return nil, toResolverError(err)
+	}
+	return s.root.edgeResolvers(l.callers[s.node.ID]), nil
+}
+
+func (s *serviceResolver) Callees(ctx context.Context) ([]*edgeResolver, error) {
+	l := loaderFrom(ctx)
+	if err := l.load(ctx); err != nil {
+		return nil, toResolverError(err)
+	}
+	return s.root.edgeResolvers(l.callees[s.node.ID]), nil
+}
+
+func (s *serviceResolver) Decisions(args struct {
+	Last int32
+	Type *string
+}) ([]*decisionResolver, error) {
+	opts := storage.GetHistoryOptions{
+		Limit:     int(args.Last),
+		ServiceID: s.node.ID,
+	}
+	if args.Type != nil {
+		opts.Type = *args.Type
+	}
+	return s.root.decisions(opts)
+}
+
+func (r *resolver) edgeResolvers(edges []*snapshot.Edge) []*edgeResolver {
+	out := make([]*edgeResolver, 0, len(edges))
+	for _, e := range edges {
+		out = append(out, &edgeResolver{root: r, edge: e})
+	}
+	return out
+}
+
+type edgeResolver struct {
+	root *resolver
+	edge *snapshot.Edge
+}
+
+func (e *edgeResolver) ID() graphql.ID       { return graphql.ID(e.edge.ID) }
+func (e *edgeResolver) SourceID() graphql.ID { return graphql.ID(e.edge.Source) }
+func (e *edgeResolver) TargetID() graphql.ID { return graphql.ID(e.edge.Target) }
+func (e *edgeResolver) ReqRate() float64     { return e.edge.ReqRate }
+func (e *edgeResolver) LatencyP95Ms() float64 {
+	return e.edge.LatencyP95Ms
+}
+
+func (e *edgeResolver) Source(ctx context.Context) (*serviceResolver, error) {
+	return e.root.Service(ctx, struct{ ID graphql.ID }{ID: graphql.ID(e.edge.Source)})
+}
+
+func (e *edgeResolver) Target(ctx context.Context) (*serviceResolver, error) {
+	return e.root.Service(ctx, struct{ ID graphql.ID }{ID: graphql.ID(e.edge.Target)})
+}
+
+type riskServiceResolver struct {
+	root *resolver
+	info graph.CentralityServiceInfo
+}
+
+func (r *riskServiceResolver) ServiceID() graphql.ID    { return graphql.ID(r.info.ServiceId) }
+func (r *riskServiceResolver) Name() string             { return r.info.Name }
+func (r *riskServiceResolver) Namespace() string        { return r.info.Namespace }
+func (r *riskServiceResolver) CentralityScore() float64 { return r.info.CentralityScore }
+func (r *riskServiceResolver) RiskLevel() string        { return r.info.RiskLevel }
+func (r *riskServiceResolver) Explanation() string      { return r.info.Explanation }
+
+func (r *riskServiceResolver) Service(ctx context.Context) (*serviceResolver, error) {
+	return r.root.Service(ctx, struct{ ID graphql.ID }{ID: graphql.ID(r.info.ServiceId)})
+}
+
+type decisionResolver struct {
+	record storage.DecisionRecord
+}
+
+func (d *decisionResolver) ID() graphql.ID        { return graphql.ID(strconv.FormatInt(d.record.ID, 10)) }
+func (d *decisionResolver) Timestamp() string     { return d.record.Timestamp }
+func (d *decisionResolver) Type() string          { return d.record.Type }
+func (d *decisionResolver) CorrelationID() string { return d.record.CorrelationID }
+func (d *decisionResolver) CreatedAt() string     { return d.record.CreatedAt }
+func (d *decisionResolver) Scenario() JSON        { return JSON{Value: d.record.Scenario} }
+func (d *decisionResolver) Result() JSON          { return JSON{Value: d.record.Result} }
+func (d *decisionResolver) Tags() []string        { return d.record.Tags }
+func (d *decisionResolver) ApprovalState() string { return d.record.Approval.State }
+
+// JSON is an opaque scalar used for the free-form decision scenario and result.
+type JSON struct {
+	Value interface{}
+}
+
+func (JSON) ImplementsGraphQLType(name string) bool {
+	return name == "JSON"
+}
+
+func (j *JSON) UnmarshalGraphQL(input interface{}) error {
+	j.Value = input
+	return nil
+}
+
+func (j JSON) MarshalJSON() ([]byte, error) {
+	return json.Marshal(j.Value)
+}
+
+// resolverError exposes only the client-safe message of an application error
+// and carries its stable code in the GraphQL error extensions.
+type resolverError struct {
+	code    common.ErrorCode
+	message string
+}
+
+func (e *resolverError) Error() string {
+	return e.message
+}
+
+func (e *resolverError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+func toResolverError(err error) error {
+	var appErr *common.Error
+	if !errors.As(err, &appErr) {
+		logger.Error("Unhandled GraphQL resolver error", err)
+		return &resolverError{code: common.CodeInternal, message: "Internal server error"}
+	}
+
+	switch appErr.Code {
+	case common.CodeInternal, common.CodeUpstreamUnavailable, common.CodeUpstreamTimeout,
+		common.CodeUpstreamError, common.CodeDecisionStoreUnavailable:
+		logger.Error(appErr.Message, err)
+	}
+	return &resolverError{code: appErr.Code, message: appErr.Message}
+}
@@ -0,0 +1,3801 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: analysis/v1/analysis.proto
+
+package analysisv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ServiceRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceRef) Reset() {
+	*x = ServiceRef{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRef) ProtoMessage() {}
+
+func (x *ServiceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRef.ProtoReflect.Descriptor instead.
+func (*ServiceRef) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServiceRef) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServiceRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceRef) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type NeighborhoodMeta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	ServiceCount  int32                  `protobuf:"varint,2,opt,name=service_count,json=serviceCount,proto3" json:"service_count,omitempty"`
+	EdgeCount     int32                  `protobuf:"varint,3,opt,name=edge_count,json=edgeCount,proto3" json:"edge_count,omitempty"`
+	DepthUsed     int32                  `protobuf:"varint,4,opt,name=depth_used,json=depthUsed,proto3" json:"depth_used,omitempty"`
+	GeneratedAt   string                 `protobuf:"bytes,5,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NeighborhoodMeta) Reset() {
+	*x = NeighborhoodMeta{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NeighborhoodMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NeighborhoodMeta) ProtoMessage() {}
+
+func (x *NeighborhoodMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NeighborhoodMeta.ProtoReflect.Descriptor instead.
+func (*NeighborhoodMeta) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NeighborhoodMeta) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NeighborhoodMeta) GetServiceCount() int32 {
+	if x != nil {
+		return x.ServiceCount
+	}
+	return 0
+}
+
+func (x *NeighborhoodMeta) GetEdgeCount() int32 {
+	if x != nil {
+		return x.EdgeCount
+	}
+	return 0
+}
+
+func (x *NeighborhoodMeta) GetDepthUsed() int32 {
+	if x != nil {
+		return x.DepthUsed
+	}
+	return 0
+}
+
+func (x *NeighborhoodMeta) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+type DataFreshness struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Source                string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Stale                 bool                   `protobuf:"varint,2,opt,name=stale,proto3" json:"stale,omitempty"`
+	LastUpdatedSecondsAgo int32                  `protobuf:"varint,3,opt,name=last_updated_seconds_ago,json=lastUpdatedSecondsAgo,proto3" json:"last_updated_seconds_ago,omitempty"`
+	WindowMinutes         int32                  `protobuf:"varint,4,opt,name=window_minutes,json=windowMinutes,proto3" json:"window_minutes,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DataFreshness) Reset() {
+	*x = DataFreshness{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataFreshness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataFreshness) ProtoMessage() {}
+
+func (x *DataFreshness) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataFreshness.ProtoReflect.Descriptor instead.
+func (*DataFreshness) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DataFreshness) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DataFreshness) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *DataFreshness) GetLastUpdatedSecondsAgo() int32 {
+	if x != nil {
+		return x.LastUpdatedSecondsAgo
+	}
+	return 0
+}
+
+func (x *DataFreshness) GetWindowMinutes() int32 {
+	if x != nil {
+		return x.WindowMinutes
+	}
+	return 0
+}
+
+type Recommendation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Priority      string                 `protobuf:"bytes,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommendation) ProtoMessage() {}
+
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Recommendation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Recommendation) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *Recommendation) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Recommendation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Recommendation) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Recommendation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type FailureSimulationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Depth         int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailureSimulationRequest) Reset() {
+	*x = FailureSimulationRequest{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailureSimulationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailureSimulationRequest) ProtoMessage() {}
+
+func (x *FailureSimulationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailureSimulationRequest.ProtoReflect.Descriptor instead.
+func (*FailureSimulationRequest) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FailureSimulationRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *FailureSimulationRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type AffectedCaller struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId      string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace      string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	LostTrafficRps float64                `protobuf:"fixed64,4,opt,name=lost_traffic_rps,json=lostTrafficRps,proto3" json:"lost_traffic_rps,omitempty"`
+	EdgeErrorRate  float64                `protobuf:"fixed64,5,opt,name=edge_error_rate,json=edgeErrorRate,proto3" json:"edge_error_rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AffectedCaller) Reset() {
+	*x = AffectedCaller{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AffectedCaller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AffectedCaller) ProtoMessage() {}
+
+func (x *AffectedCaller) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AffectedCaller.ProtoReflect.Descriptor instead.
+func (*AffectedCaller) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AffectedCaller) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *AffectedCaller) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AffectedCaller) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AffectedCaller) GetLostTrafficRps() float64 {
+	if x != nil {
+		return x.LostTrafficRps
+	}
+	return 0
+}
+
+func (x *AffectedCaller) GetEdgeErrorRate() float64 {
+	if x != nil {
+		return x.EdgeErrorRate
+	}
+	return 0
+}
+
+type AffectedDownstream struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId      string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace      string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	LostTrafficRps float64                `protobuf:"fixed64,4,opt,name=lost_traffic_rps,json=lostTrafficRps,proto3" json:"lost_traffic_rps,omitempty"`
+	EdgeErrorRate  float64                `protobuf:"fixed64,5,opt,name=edge_error_rate,json=edgeErrorRate,proto3" json:"edge_error_rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AffectedDownstream) Reset() {
+	*x = AffectedDownstream{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AffectedDownstream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AffectedDownstream) ProtoMessage() {}
+
+func (x *AffectedDownstream) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AffectedDownstream.ProtoReflect.Descriptor instead.
+func (*AffectedDownstream) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AffectedDownstream) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *AffectedDownstream) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AffectedDownstream) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AffectedDownstream) GetLostTrafficRps() float64 {
+	if x != nil {
+		return x.LostTrafficRps
+	}
+	return 0
+}
+
+func (x *AffectedDownstream) GetEdgeErrorRate() float64 {
+	if x != nil {
+		return x.EdgeErrorRate
+	}
+	return 0
+}
+
+type UnreachableService struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId                string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Name                     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace                string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	LostTrafficRps           float64                `protobuf:"fixed64,4,opt,name=lost_traffic_rps,json=lostTrafficRps,proto3" json:"lost_traffic_rps,omitempty"`
+	LostFromTargetRps        float64                `protobuf:"fixed64,5,opt,name=lost_from_target_rps,json=lostFromTargetRps,proto3" json:"lost_from_target_rps,omitempty"`
+	LostFromReachableCutsRps float64                `protobuf:"fixed64,6,opt,name=lost_from_reachable_cuts_rps,json=lostFromReachableCutsRps,proto3" json:"lost_from_reachable_cuts_rps,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *UnreachableService) Reset() {
+	*x = UnreachableService{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreachableService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreachableService) ProtoMessage() {}
+
+func (x *UnreachableService) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreachableService.ProtoReflect.Descriptor instead.
+func (*UnreachableService) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnreachableService) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *UnreachableService) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UnreachableService) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UnreachableService) GetLostTrafficRps() float64 {
+	if x != nil {
+		return x.LostTrafficRps
+	}
+	return 0
+}
+
+func (x *UnreachableService) GetLostFromTargetRps() float64 {
+	if x != nil {
+		return x.LostFromTargetRps
+	}
+	return 0
+}
+
+func (x *UnreachableService) GetLostFromReachableCutsRps() float64 {
+	if x != nil {
+		return x.LostFromReachableCutsRps
+	}
+	return 0
+}
+
+type BrokenPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          []string               `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	PathRps       float64                `protobuf:"fixed64,2,opt,name=path_rps,json=pathRps,proto3" json:"path_rps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrokenPath) Reset() {
+	*x = BrokenPath{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrokenPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrokenPath) ProtoMessage() {}
+
+func (x *BrokenPath) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrokenPath.ProtoReflect.Descriptor instead.
+func (*BrokenPath) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BrokenPath) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *BrokenPath) GetPathRps() float64 {
+	if x != nil {
+		return x.PathRps
+	}
+	return 0
+}
+
+type FailureSimulationResult struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Target                *ServiceRef            `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Neighborhood          *NeighborhoodMeta      `protobuf:"bytes,2,opt,name=neighborhood,proto3" json:"neighborhood,omitempty"`
+	DataFreshness         *DataFreshness         `protobuf:"bytes,3,opt,name=data_freshness,json=dataFreshness,proto3" json:"data_freshness,omitempty"`
+	Confidence            string                 `protobuf:"bytes,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Explanation           string                 `protobuf:"bytes,5,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	AffectedCallers       []*AffectedCaller      `protobuf:"bytes,6,rep,name=affected_callers,json=affectedCallers,proto3" json:"affected_callers,omitempty"`
+	AffectedDownstream    []*AffectedDownstream  `protobuf:"bytes,7,rep,name=affected_downstream,json=affectedDownstream,proto3" json:"affected_downstream,omitempty"`
+	UnreachableServices   []*UnreachableService  `protobuf:"bytes,8,rep,name=unreachable_services,json=unreachableServices,proto3" json:"unreachable_services,omitempty"`
+	CriticalPathsToTarget []*BrokenPath          `protobuf:"bytes,9,rep,name=critical_paths_to_target,json=criticalPathsToTarget,proto3" json:"critical_paths_to_target,omitempty"`
+	TotalLostTrafficRps   float64                `protobuf:"fixed64,10,opt,name=total_lost_traffic_rps,json=totalLostTrafficRps,proto3" json:"total_lost_traffic_rps,omitempty"`
+	Recommendations       []*Recommendation      `protobuf:"bytes,11,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	SloBreaches           []*SLOBreach           `protobuf:"bytes,12,rep,name=slo_breaches,json=sloBreaches,proto3" json:"slo_breaches,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *FailureSimulationResult) Reset() {
+	*x = FailureSimulationResult{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailureSimulationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailureSimulationResult) ProtoMessage() {}
+
+func (x *FailureSimulationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailureSimulationResult.ProtoReflect.Descriptor instead.
+func (*FailureSimulationResult) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FailureSimulationResult) GetTarget() *ServiceRef {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *FailureSimulationResult) GetNeighborhood() *NeighborhoodMeta {
+	if x != nil {
+		return x.Neighborhood
+	}
+	return nil
+}
+
+func (x *FailureSimulationResult) GetDataFreshness() *DataFreshness {
+	if x != nil {
+		return x.DataFreshness
+	}
+	return nil
+}
+
+func (x *FailureSimulationResult) GetConfidence() string {
+	if x != nil {
+		return x.Confidence
+	}
+	return ""
+}
+
+func (x *FailureSimulationResult) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *FailureSimulationResult) GetAffectedCallers() []*AffectedCaller {
+	if x != nil {
+		return x.AffectedCallers
+	}
+	return nil
+}
+
+func (x *FailureSimulationResult) GetAffectedDownstream() []*AffectedDownstream {
+	if x != nil {
+		return x.AffectedDownstream
+	}
+	return nil
+}
+
+func (x *FailureSimulationResult) GetUnreachableServices() []*UnreachableService {
+	if x != nil {
+		return x.UnreachableServices
+	}
+	return nil
+}
+
+func (x *FailureSimulationResult) GetCriticalPathsToTarget() []*BrokenPath {
+	if x != nil {
+		return x.CriticalPathsToTarget
+	}
+	return nil
+}
+
+func (x *FailureSimulationResult) GetTotalLostTrafficRps() float64 {
+	if x != nil {
+		return x.TotalLostTrafficRps
+	}
+	return 0
+}
+
+func (x *FailureSimulationResult) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *FailureSimulationResult) GetSloBreaches() []*SLOBreach {
+	if x != nil {
+		return x.SloBreaches
+	}
+	return nil
+}
+
+// SLOBreach is an SLO objective a scenario would push past its target.
+// objective is "availability" (target and projected are fractions) or
+// "latency" (milliseconds).
+type SLOBreach struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SloId         int64                  `protobuf:"varint,1,opt,name=slo_id,json=sloId,proto3" json:"slo_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ServiceId     string                 `protobuf:"bytes,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Objective     string                 `protobuf:"bytes,4,opt,name=objective,proto3" json:"objective,omitempty"`
+	Target        float64                `protobuf:"fixed64,5,opt,name=target,proto3" json:"target,omitempty"`
+	Projected     float64                `protobuf:"fixed64,6,opt,name=projected,proto3" json:"projected,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLOBreach) Reset() {
+	*x = SLOBreach{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLOBreach) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLOBreach) ProtoMessage() {}
+
+func (x *SLOBreach) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLOBreach.ProtoReflect.Descriptor instead.
+func (*SLOBreach) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SLOBreach) GetSloId() int64 {
+	if x != nil {
+		return x.SloId
+	}
+	return 0
+}
+
+func (x *SLOBreach) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SLOBreach) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *SLOBreach) GetObjective() string {
+	if x != nil {
+		return x.Objective
+	}
+	return ""
+}
+
+func (x *SLOBreach) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *SLOBreach) GetProjected() float64 {
+	if x != nil {
+		return x.Projected
+	}
+	return 0
+}
+
+func (x *SLOBreach) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// source and calibration are only set on results.
+type ScalingModel struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Type             string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Alpha            *float64               `protobuf:"fixed64,2,opt,name=alpha,proto3,oneof" json:"alpha,omitempty"`
+	MinLatencyFactor *float64               `protobuf:"fixed64,3,opt,name=min_latency_factor,json=minLatencyFactor,proto3,oneof" json:"min_latency_factor,omitempty"`
+	Source           string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Calibration      *ModelCalibration      `protobuf:"bytes,5,opt,name=calibration,proto3" json:"calibration,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScalingModel) Reset() {
+	*x = ScalingModel{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingModel) ProtoMessage() {}
+
+func (x *ScalingModel) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingModel.ProtoReflect.Descriptor instead.
+func (*ScalingModel) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ScalingModel) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ScalingModel) GetAlpha() float64 {
+	if x != nil && x.Alpha != nil {
+		return *x.Alpha
+	}
+	return 0
+}
+
+func (x *ScalingModel) GetMinLatencyFactor() float64 {
+	if x != nil && x.MinLatencyFactor != nil {
+		return *x.MinLatencyFactor
+	}
+	return 0
+}
+
+func (x *ScalingModel) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ScalingModel) GetCalibration() *ModelCalibration {
+	if x != nil {
+		return x.Calibration
+	}
+	return nil
+}
+
+type ModelCalibration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Samples       int32                  `protobuf:"varint,1,opt,name=samples,proto3" json:"samples,omitempty"`
+	Rmse          float64                `protobuf:"fixed64,2,opt,name=rmse,proto3" json:"rmse,omitempty"`
+	FittedAt      string                 `protobuf:"bytes,3,opt,name=fitted_at,json=fittedAt,proto3" json:"fitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelCalibration) Reset() {
+	*x = ModelCalibration{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelCalibration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelCalibration) ProtoMessage() {}
+
+func (x *ModelCalibration) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelCalibration.ProtoReflect.Descriptor instead.
+func (*ModelCalibration) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ModelCalibration) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *ModelCalibration) GetRmse() float64 {
+	if x != nil {
+		return x.Rmse
+	}
+	return 0
+}
+
+func (x *ModelCalibration) GetFittedAt() string {
+	if x != nil {
+		return x.FittedAt
+	}
+	return ""
+}
+
+type ScalingSimulationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	CurrentPods   int32                  `protobuf:"varint,2,opt,name=current_pods,json=currentPods,proto3" json:"current_pods,omitempty"`
+	NewPods       int32                  `protobuf:"varint,3,opt,name=new_pods,json=newPods,proto3" json:"new_pods,omitempty"`
+	LatencyMetric string                 `protobuf:"bytes,4,opt,name=latency_metric,json=latencyMetric,proto3" json:"latency_metric,omitempty"`
+	Model         *ScalingModel          `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
+	MaxDepth      int32                  `protobuf:"varint,6,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	TimeWindow    string                 `protobuf:"bytes,7,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	// "current" (default) or "forecast@<RFC3339 time>".
+	Baseline      string `protobuf:"bytes,8,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScalingSimulationRequest) Reset() {
+	*x = ScalingSimulationRequest{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingSimulationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingSimulationRequest) ProtoMessage() {}
+
+func (x *ScalingSimulationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingSimulationRequest.ProtoReflect.Descriptor instead.
+func (*ScalingSimulationRequest) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ScalingSimulationRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ScalingSimulationRequest) GetCurrentPods() int32 {
+	if x != nil {
+		return x.CurrentPods
+	}
+	return 0
+}
+
+func (x *ScalingSimulationRequest) GetNewPods() int32 {
+	if x != nil {
+		return x.NewPods
+	}
+	return 0
+}
+
+func (x *ScalingSimulationRequest) GetLatencyMetric() string {
+	if x != nil {
+		return x.LatencyMetric
+	}
+	return ""
+}
+
+func (x *ScalingSimulationRequest) GetModel() *ScalingModel {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *ScalingSimulationRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *ScalingSimulationRequest) GetTimeWindow() string {
+	if x != nil {
+		return x.TimeWindow
+	}
+	return ""
+}
+
+func (x *ScalingSimulationRequest) GetBaseline() string {
+	if x != nil {
+		return x.Baseline
+	}
+	return ""
+}
+
+type ScalingBaseline struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	At            string                 `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	ForecastEdges int32                  `protobuf:"varint,3,opt,name=forecast_edges,json=forecastEdges,proto3" json:"forecast_edges,omitempty"`
+	CurrentEdges  int32                  `protobuf:"varint,4,opt,name=current_edges,json=currentEdges,proto3" json:"current_edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScalingBaseline) Reset() {
+	*x = ScalingBaseline{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingBaseline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingBaseline) ProtoMessage() {}
+
+func (x *ScalingBaseline) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingBaseline.ProtoReflect.Descriptor instead.
+func (*ScalingBaseline) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ScalingBaseline) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ScalingBaseline) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *ScalingBaseline) GetForecastEdges() int32 {
+	if x != nil {
+		return x.ForecastEdges
+	}
+	return 0
+}
+
+func (x *ScalingBaseline) GetCurrentEdges() int32 {
+	if x != nil {
+		return x.CurrentEdges
+	}
+	return 0
+}
+
+type ScalingLatencyEstimate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	BaselineMs    *float64               `protobuf:"fixed64,2,opt,name=baseline_ms,json=baselineMs,proto3,oneof" json:"baseline_ms,omitempty"`
+	ProjectedMs   *float64               `protobuf:"fixed64,3,opt,name=projected_ms,json=projectedMs,proto3,oneof" json:"projected_ms,omitempty"`
+	DeltaMs       *float64               `protobuf:"fixed64,4,opt,name=delta_ms,json=deltaMs,proto3,oneof" json:"delta_ms,omitempty"`
+	Unit          string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScalingLatencyEstimate) Reset() {
+	*x = ScalingLatencyEstimate{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingLatencyEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingLatencyEstimate) ProtoMessage() {}
+
+func (x *ScalingLatencyEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingLatencyEstimate.ProtoReflect.Descriptor instead.
+func (*ScalingLatencyEstimate) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ScalingLatencyEstimate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScalingLatencyEstimate) GetBaselineMs() float64 {
+	if x != nil && x.BaselineMs != nil {
+		return *x.BaselineMs
+	}
+	return 0
+}
+
+func (x *ScalingLatencyEstimate) GetProjectedMs() float64 {
+	if x != nil && x.ProjectedMs != nil {
+		return *x.ProjectedMs
+	}
+	return 0
+}
+
+func (x *ScalingLatencyEstimate) GetDeltaMs() float64 {
+	if x != nil && x.DeltaMs != nil {
+		return *x.DeltaMs
+	}
+	return 0
+}
+
+func (x *ScalingLatencyEstimate) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type AffectedCallerScaling struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId        string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace        string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	HopDistance      int32                  `protobuf:"varint,4,opt,name=hop_distance,json=hopDistance,proto3" json:"hop_distance,omitempty"`
+	BeforeMs         *float64               `protobuf:"fixed64,5,opt,name=before_ms,json=beforeMs,proto3,oneof" json:"before_ms,omitempty"`
+	AfterMs          *float64               `protobuf:"fixed64,6,opt,name=after_ms,json=afterMs,proto3,oneof" json:"after_ms,omitempty"`
+	DeltaMs          *float64               `protobuf:"fixed64,7,opt,name=delta_ms,json=deltaMs,proto3,oneof" json:"delta_ms,omitempty"`
+	EndToEndBeforeMs *float64               `protobuf:"fixed64,8,opt,name=end_to_end_before_ms,json=endToEndBeforeMs,proto3,oneof" json:"end_to_end_before_ms,omitempty"`
+	EndToEndAfterMs  *float64               `protobuf:"fixed64,9,opt,name=end_to_end_after_ms,json=endToEndAfterMs,proto3,oneof" json:"end_to_end_after_ms,omitempty"`
+	EndToEndDeltaMs  *float64               `protobuf:"fixed64,10,opt,name=end_to_end_delta_ms,json=endToEndDeltaMs,proto3,oneof" json:"end_to_end_delta_ms,omitempty"`
+	ViaPath          []string               `protobuf:"bytes,11,rep,name=via_path,json=viaPath,proto3" json:"via_path,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AffectedCallerScaling) Reset() {
+	*x = AffectedCallerScaling{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AffectedCallerScaling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AffectedCallerScaling) ProtoMessage() {}
+
+func (x *AffectedCallerScaling) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AffectedCallerScaling.ProtoReflect.Descriptor instead.
+func (*AffectedCallerScaling) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AffectedCallerScaling) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *AffectedCallerScaling) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AffectedCallerScaling) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AffectedCallerScaling) GetHopDistance() int32 {
+	if x != nil {
+		return x.HopDistance
+	}
+	return 0
+}
+
+func (x *AffectedCallerScaling) GetBeforeMs() float64 {
+	if x != nil && x.BeforeMs != nil {
+		return *x.BeforeMs
+	}
+	return 0
+}
+
+func (x *AffectedCallerScaling) GetAfterMs() float64 {
+	if x != nil && x.AfterMs != nil {
+		return *x.AfterMs
+	}
+	return 0
+}
+
+func (x *AffectedCallerScaling) GetDeltaMs() float64 {
+	if x != nil && x.DeltaMs != nil {
+		return *x.DeltaMs
+	}
+	return 0
+}
+
+func (x *AffectedCallerScaling) GetEndToEndBeforeMs() float64 {
+	if x != nil && x.EndToEndBeforeMs != nil {
+		return *x.EndToEndBeforeMs
+	}
+	return 0
+}
+
+func (x *AffectedCallerScaling) GetEndToEndAfterMs() float64 {
+	if x != nil && x.EndToEndAfterMs != nil {
+		return *x.EndToEndAfterMs
+	}
+	return 0
+}
+
+func (x *AffectedCallerScaling) GetEndToEndDeltaMs() float64 {
+	if x != nil && x.EndToEndDeltaMs != nil {
+		return *x.EndToEndDeltaMs
+	}
+	return 0
+}
+
+func (x *AffectedCallerScaling) GetViaPath() []string {
+	if x != nil {
+		return x.ViaPath
+	}
+	return nil
+}
+
+type AffectedPathScaling struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Path           []string               `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	PathRps        float64                `protobuf:"fixed64,2,opt,name=path_rps,json=pathRps,proto3" json:"path_rps,omitempty"`
+	BeforeMs       *float64               `protobuf:"fixed64,3,opt,name=before_ms,json=beforeMs,proto3,oneof" json:"before_ms,omitempty"`
+	AfterMs        *float64               `protobuf:"fixed64,4,opt,name=after_ms,json=afterMs,proto3,oneof" json:"after_ms,omitempty"`
+	DeltaMs        *float64               `protobuf:"fixed64,5,opt,name=delta_ms,json=deltaMs,proto3,oneof" json:"delta_ms,omitempty"`
+	IncompleteData bool                   `protobuf:"varint,6,opt,name=incomplete_data,json=incompleteData,proto3" json:"incomplete_data,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AffectedPathScaling) Reset() {
+	*x = AffectedPathScaling{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AffectedPathScaling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AffectedPathScaling) ProtoMessage() {}
+
+func (x *AffectedPathScaling) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AffectedPathScaling.ProtoReflect.Descriptor instead.
+func (*AffectedPathScaling) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AffectedPathScaling) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *AffectedPathScaling) GetPathRps() float64 {
+	if x != nil {
+		return x.PathRps
+	}
+	return 0
+}
+
+func (x *AffectedPathScaling) GetBeforeMs() float64 {
+	if x != nil && x.BeforeMs != nil {
+		return *x.BeforeMs
+	}
+	return 0
+}
+
+func (x *AffectedPathScaling) GetAfterMs() float64 {
+	if x != nil && x.AfterMs != nil {
+		return *x.AfterMs
+	}
+	return 0
+}
+
+func (x *AffectedPathScaling) GetDeltaMs() float64 {
+	if x != nil && x.DeltaMs != nil {
+		return *x.DeltaMs
+	}
+	return 0
+}
+
+func (x *AffectedPathScaling) GetIncompleteData() bool {
+	if x != nil {
+		return x.IncompleteData
+	}
+	return false
+}
+
+type ScalingSimulationResult struct {
+	state            protoimpl.MessageState   `protogen:"open.v1"`
+	Target           *ServiceRef              `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Neighborhood     *NeighborhoodMeta        `protobuf:"bytes,2,opt,name=neighborhood,proto3" json:"neighborhood,omitempty"`
+	DataFreshness    *DataFreshness           `protobuf:"bytes,3,opt,name=data_freshness,json=dataFreshness,proto3" json:"data_freshness,omitempty"`
+	Confidence       string                   `protobuf:"bytes,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Explanation      string                   `protobuf:"bytes,5,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	Warnings         []string                 `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	LatencyMetric    string                   `protobuf:"bytes,7,opt,name=latency_metric,json=latencyMetric,proto3" json:"latency_metric,omitempty"`
+	ScalingModel     *ScalingModel            `protobuf:"bytes,8,opt,name=scaling_model,json=scalingModel,proto3" json:"scaling_model,omitempty"`
+	CurrentPods      int32                    `protobuf:"varint,9,opt,name=current_pods,json=currentPods,proto3" json:"current_pods,omitempty"`
+	NewPods          int32                    `protobuf:"varint,10,opt,name=new_pods,json=newPods,proto3" json:"new_pods,omitempty"`
+	LatencyEstimate  *ScalingLatencyEstimate  `protobuf:"bytes,11,opt,name=latency_estimate,json=latencyEstimate,proto3" json:"latency_estimate,omitempty"`
+	ScalingDirection string                   `protobuf:"bytes,12,opt,name=scaling_direction,json=scalingDirection,proto3" json:"scaling_direction,omitempty"`
+	AffectedCallers  []*AffectedCallerScaling `protobuf:"bytes,13,rep,name=affected_callers,json=affectedCallers,proto3" json:"affected_callers,omitempty"`
+	AffectedPaths    []*AffectedPathScaling   `protobuf:"bytes,14,rep,name=affected_paths,json=affectedPaths,proto3" json:"affected_paths,omitempty"`
+	Recommendations  []*Recommendation        `protobuf:"bytes,15,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	Baseline         *ScalingBaseline         `protobuf:"bytes,16,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	SloBreaches      []*SLOBreach             `protobuf:"bytes,17,rep,name=slo_breaches,json=sloBreaches,proto3" json:"slo_breaches,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScalingSimulationResult) Reset() {
+	*x = ScalingSimulationResult{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingSimulationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingSimulationResult) ProtoMessage() {}
+
+func (x *ScalingSimulationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingSimulationResult.ProtoReflect.Descriptor instead.
+func (*ScalingSimulationResult) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ScalingSimulationResult) GetTarget() *ServiceRef {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ScalingSimulationResult) GetNeighborhood() *NeighborhoodMeta {
+	if x != nil {
+		return x.Neighborhood
+	}
+	return nil
+}
+
+func (x *ScalingSimulationResult) GetDataFreshness() *DataFreshness {
+	if x != nil {
+		return x.DataFreshness
+	}
+	return nil
+}
+
+func (x *ScalingSimulationResult) GetConfidence() string {
+	if x != nil {
+		return x.Confidence
+	}
+	return ""
+}
+
+func (x *ScalingSimulationResult) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *ScalingSimulationResult) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ScalingSimulationResult) GetLatencyMetric() string {
+	if x != nil {
+		return x.LatencyMetric
+	}
+	return ""
+}
+
+func (x *ScalingSimulationResult) GetScalingModel() *ScalingModel {
+	if x != nil {
+		return x.ScalingModel
+	}
+	return nil
+}
+
+func (x *ScalingSimulationResult) GetCurrentPods() int32 {
+	if x != nil {
+		return x.CurrentPods
+	}
+	return 0
+}
+
+func (x *ScalingSimulationResult) GetNewPods() int32 {
+	if x != nil {
+		return x.NewPods
+	}
+	return 0
+}
+
+func (x *ScalingSimulationResult) GetLatencyEstimate() *ScalingLatencyEstimate {
+	if x != nil {
+		return x.LatencyEstimate
+	}
+	return nil
+}
+
+func (x *ScalingSimulationResult) GetScalingDirection() string {
+	if x != nil {
+		return x.ScalingDirection
+	}
+	return ""
+}
+
+func (x *ScalingSimulationResult) GetAffectedCallers() []*AffectedCallerScaling {
+	if x != nil {
+		return x.AffectedCallers
+	}
+	return nil
+}
+
+func (x *ScalingSimulationResult) GetAffectedPaths() []*AffectedPathScaling {
+	if x != nil {
+		return x.AffectedPaths
+	}
+	return nil
+}
+
+func (x *ScalingSimulationResult) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *ScalingSimulationResult) GetBaseline() *ScalingBaseline {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *ScalingSimulationResult) GetSloBreaches() []*SLOBreach {
+	if x != nil {
+		return x.SloBreaches
+	}
+	return nil
+}
+
+type DependencyRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencyRef) Reset() {
+	*x = DependencyRef{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyRef) ProtoMessage() {}
+
+func (x *DependencyRef) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyRef.ProtoReflect.Descriptor instead.
+func (*DependencyRef) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DependencyRef) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type AddSimulationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	CpuRequest    float64                `protobuf:"fixed64,2,opt,name=cpu_request,json=cpuRequest,proto3" json:"cpu_request,omitempty"`
+	RamRequest    int32                  `protobuf:"varint,3,opt,name=ram_request,json=ramRequest,proto3" json:"ram_request,omitempty"`
+	Replicas      int32                  `protobuf:"varint,4,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	TimeWindow    string                 `protobuf:"bytes,5,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	Dependencies  []*DependencyRef       `protobuf:"bytes,6,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSimulationRequest) Reset() {
+	*x = AddSimulationRequest{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSimulationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSimulationRequest) ProtoMessage() {}
+
+func (x *AddSimulationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSimulationRequest.ProtoReflect.Descriptor instead.
+func (*AddSimulationRequest) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddSimulationRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *AddSimulationRequest) GetCpuRequest() float64 {
+	if x != nil {
+		return x.CpuRequest
+	}
+	return 0
+}
+
+func (x *AddSimulationRequest) GetRamRequest() int32 {
+	if x != nil {
+		return x.RamRequest
+	}
+	return 0
+}
+
+func (x *AddSimulationRequest) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *AddSimulationRequest) GetTimeWindow() string {
+	if x != nil {
+		return x.TimeWindow
+	}
+	return ""
+}
+
+func (x *AddSimulationRequest) GetDependencies() []*DependencyRef {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+type NodeCapacity struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Node           string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	CpuAvailable   float64                `protobuf:"fixed64,2,opt,name=cpu_available,json=cpuAvailable,proto3" json:"cpu_available,omitempty"`
+	RamAvailableMb float64                `protobuf:"fixed64,3,opt,name=ram_available_mb,json=ramAvailableMb,proto3" json:"ram_available_mb,omitempty"`
+	CpuTotal       float64                `protobuf:"fixed64,4,opt,name=cpu_total,json=cpuTotal,proto3" json:"cpu_total,omitempty"`
+	RamTotalMb     float64                `protobuf:"fixed64,5,opt,name=ram_total_mb,json=ramTotalMb,proto3" json:"ram_total_mb,omitempty"`
+	CanFit         bool                   `protobuf:"varint,6,opt,name=can_fit,json=canFit,proto3" json:"can_fit,omitempty"`
+	MaxPods        int32                  `protobuf:"varint,7,opt,name=max_pods,json=maxPods,proto3" json:"max_pods,omitempty"`
+	Score          int32                  `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`
+	Suitable       bool                   `protobuf:"varint,9,opt,name=suitable,proto3" json:"suitable,omitempty"`
+	Reason         string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NodeCapacity) Reset() {
+	*x = NodeCapacity{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeCapacity) ProtoMessage() {}
+
+func (x *NodeCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeCapacity.ProtoReflect.Descriptor instead.
+func (*NodeCapacity) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NodeCapacity) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *NodeCapacity) GetCpuAvailable() float64 {
+	if x != nil {
+		return x.CpuAvailable
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetRamAvailableMb() float64 {
+	if x != nil {
+		return x.RamAvailableMb
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetCpuTotal() float64 {
+	if x != nil {
+		return x.CpuTotal
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetRamTotalMb() float64 {
+	if x != nil {
+		return x.RamTotalMb
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetCanFit() bool {
+	if x != nil {
+		return x.CanFit
+	}
+	return false
+}
+
+func (x *NodeCapacity) GetMaxPods() int32 {
+	if x != nil {
+		return x.MaxPods
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *NodeCapacity) GetSuitable() bool {
+	if x != nil {
+		return x.Suitable
+	}
+	return false
+}
+
+func (x *NodeCapacity) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AddRiskAnalysis struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DependencyRisk string                 `protobuf:"bytes,1,opt,name=dependency_risk,json=dependencyRisk,proto3" json:"dependency_risk,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddRiskAnalysis) Reset() {
+	*x = AddRiskAnalysis{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRiskAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRiskAnalysis) ProtoMessage() {}
+
+func (x *AddRiskAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRiskAnalysis.ProtoReflect.Descriptor instead.
+func (*AddRiskAnalysis) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddRiskAnalysis) GetDependencyRisk() string {
+	if x != nil {
+		return x.DependencyRisk
+	}
+	return ""
+}
+
+func (x *AddRiskAnalysis) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type PlacementDistribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Replicas      int32                  `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlacementDistribution) Reset() {
+	*x = PlacementDistribution{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacementDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementDistribution) ProtoMessage() {}
+
+func (x *PlacementDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementDistribution.ProtoReflect.Descriptor instead.
+func (*PlacementDistribution) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PlacementDistribution) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *PlacementDistribution) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+type AddSimulationResult struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	TargetServiceName string                   `protobuf:"bytes,1,opt,name=target_service_name,json=targetServiceName,proto3" json:"target_service_name,omitempty"`
+	Success           bool                     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Confidence        string                   `protobuf:"bytes,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Explanation       string                   `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	TotalCapacityPods int32                    `protobuf:"varint,5,opt,name=total_capacity_pods,json=totalCapacityPods,proto3" json:"total_capacity_pods,omitempty"`
+	SuitableNodes     []*NodeCapacity          `protobuf:"bytes,6,rep,name=suitable_nodes,json=suitableNodes,proto3" json:"suitable_nodes,omitempty"`
+	RiskAnalysis      *AddRiskAnalysis         `protobuf:"bytes,7,opt,name=risk_analysis,json=riskAnalysis,proto3" json:"risk_analysis,omitempty"`
+	Recommendations   []*Recommendation        `protobuf:"bytes,8,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	Distribution      []*PlacementDistribution `protobuf:"bytes,9,rep,name=distribution,proto3" json:"distribution,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddSimulationResult) Reset() {
+	*x = AddSimulationResult{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSimulationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSimulationResult) ProtoMessage() {}
+
+func (x *AddSimulationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSimulationResult.ProtoReflect.Descriptor instead.
+func (*AddSimulationResult) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddSimulationResult) GetTargetServiceName() string {
+	if x != nil {
+		return x.TargetServiceName
+	}
+	return ""
+}
+
+func (x *AddSimulationResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddSimulationResult) GetConfidence() string {
+	if x != nil {
+		return x.Confidence
+	}
+	return ""
+}
+
+func (x *AddSimulationResult) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *AddSimulationResult) GetTotalCapacityPods() int32 {
+	if x != nil {
+		return x.TotalCapacityPods
+	}
+	return 0
+}
+
+func (x *AddSimulationResult) GetSuitableNodes() []*NodeCapacity {
+	if x != nil {
+		return x.SuitableNodes
+	}
+	return nil
+}
+
+func (x *AddSimulationResult) GetRiskAnalysis() *AddRiskAnalysis {
+	if x != nil {
+		return x.RiskAnalysis
+	}
+	return nil
+}
+
+func (x *AddSimulationResult) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *AddSimulationResult) GetDistribution() []*PlacementDistribution {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+type GetTopRiskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pagerank (default) or betweenness.
+	Metric string `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	// 1-20, defaults to 5.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopRiskRequest) Reset() {
+	*x = GetTopRiskRequest{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopRiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopRiskRequest) ProtoMessage() {}
+
+func (x *GetTopRiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopRiskRequest.ProtoReflect.Descriptor instead.
+func (*GetTopRiskRequest) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetTopRiskRequest) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *GetTopRiskRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RiskService struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId       string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace       string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	CentralityScore float64                `protobuf:"fixed64,4,opt,name=centrality_score,json=centralityScore,proto3" json:"centrality_score,omitempty"`
+	RiskLevel       string                 `protobuf:"bytes,5,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	Explanation     string                 `protobuf:"bytes,6,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RiskService) Reset() {
+	*x = RiskService{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskService) ProtoMessage() {}
+
+func (x *RiskService) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskService.ProtoReflect.Descriptor instead.
+func (*RiskService) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RiskService) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *RiskService) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RiskService) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RiskService) GetCentralityScore() float64 {
+	if x != nil {
+		return x.CentralityScore
+	}
+	return 0
+}
+
+func (x *RiskService) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *RiskService) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+type GetTopRiskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metric        string                 `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	Services      []*RiskService         `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	DataFreshness *DataFreshness         `protobuf:"bytes,3,opt,name=data_freshness,json=dataFreshness,proto3" json:"data_freshness,omitempty"`
+	Confidence    string                 `protobuf:"bytes,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopRiskResponse) Reset() {
+	*x = GetTopRiskResponse{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopRiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopRiskResponse) ProtoMessage() {}
+
+func (x *GetTopRiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopRiskResponse.ProtoReflect.Descriptor instead.
+func (*GetTopRiskResponse) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTopRiskResponse) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *GetTopRiskResponse) GetServices() []*RiskService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *GetTopRiskResponse) GetDataFreshness() *DataFreshness {
+	if x != nil {
+		return x.DataFreshness
+	}
+	return nil
+}
+
+func (x *GetTopRiskResponse) GetConfidence() string {
+	if x != nil {
+		return x.Confidence
+	}
+	return ""
+}
+
+type ListServicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServicesRequest) Reset() {
+	*x = ListServicesRequest{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServicesRequest) ProtoMessage() {}
+
+func (x *ListServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServicesRequest.ProtoReflect.Descriptor instead.
+func (*ListServicesRequest) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{28}
+}
+
+type PodInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RamUsedMb       float64                `protobuf:"fixed64,2,opt,name=ram_used_mb,json=ramUsedMb,proto3" json:"ram_used_mb,omitempty"`
+	CpuUsagePercent float64                `protobuf:"fixed64,3,opt,name=cpu_usage_percent,json=cpuUsagePercent,proto3" json:"cpu_usage_percent,omitempty"`
+	UptimeSeconds   int32                  `protobuf:"varint,4,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PodInfo) Reset() {
+	*x = PodInfo{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodInfo) ProtoMessage() {}
+
+func (x *PodInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodInfo.ProtoReflect.Descriptor instead.
+func (*PodInfo) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PodInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PodInfo) GetRamUsedMb() float64 {
+	if x != nil {
+		return x.RamUsedMb
+	}
+	return 0
+}
+
+func (x *PodInfo) GetCpuUsagePercent() float64 {
+	if x != nil {
+		return x.CpuUsagePercent
+	}
+	return 0
+}
+
+func (x *PodInfo) GetUptimeSeconds() int32 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+type NodePlacement struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Node            string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	CpuUsagePercent float64                `protobuf:"fixed64,2,opt,name=cpu_usage_percent,json=cpuUsagePercent,proto3" json:"cpu_usage_percent,omitempty"`
+	CpuCores        int32                  `protobuf:"varint,3,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	RamUsedMb       float64                `protobuf:"fixed64,4,opt,name=ram_used_mb,json=ramUsedMb,proto3" json:"ram_used_mb,omitempty"`
+	RamTotalMb      float64                `protobuf:"fixed64,5,opt,name=ram_total_mb,json=ramTotalMb,proto3" json:"ram_total_mb,omitempty"`
+	Pods            []*PodInfo             `protobuf:"bytes,6,rep,name=pods,proto3" json:"pods,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NodePlacement) Reset() {
+	*x = NodePlacement{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodePlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodePlacement) ProtoMessage() {}
+
+func (x *NodePlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodePlacement.ProtoReflect.Descriptor instead.
+func (*NodePlacement) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *NodePlacement) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *NodePlacement) GetCpuUsagePercent() float64 {
+	if x != nil {
+		return x.CpuUsagePercent
+	}
+	return 0
+}
+
+func (x *NodePlacement) GetCpuCores() int32 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return 0
+}
+
+func (x *NodePlacement) GetRamUsedMb() float64 {
+	if x != nil {
+		return x.RamUsedMb
+	}
+	return 0
+}
+
+func (x *NodePlacement) GetRamTotalMb() float64 {
+	if x != nil {
+		return x.RamTotalMb
+	}
+	return 0
+}
+
+func (x *NodePlacement) GetPods() []*PodInfo {
+	if x != nil {
+		return x.Pods
+	}
+	return nil
+}
+
+type ServiceInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PodCount      int32                  `protobuf:"varint,4,opt,name=pod_count,json=podCount,proto3" json:"pod_count,omitempty"`
+	Availability  float64                `protobuf:"fixed64,5,opt,name=availability,proto3" json:"availability,omitempty"`
+	Placement     []*NodePlacement       `protobuf:"bytes,6,rep,name=placement,proto3" json:"placement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceInfo) Reset() {
+	*x = ServiceInfo{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceInfo) ProtoMessage() {}
+
+func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceInfo.ProtoReflect.Descriptor instead.
+func (*ServiceInfo) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ServiceInfo) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetPodCount() int32 {
+	if x != nil {
+		return x.PodCount
+	}
+	return 0
+}
+
+func (x *ServiceInfo) GetAvailability() float64 {
+	if x != nil {
+		return x.Availability
+	}
+	return 0
+}
+
+func (x *ServiceInfo) GetPlacement() []*NodePlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+type ListServicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*ServiceInfo         `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServicesResponse) Reset() {
+	*x = ListServicesResponse{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServicesResponse) ProtoMessage() {}
+
+func (x *ListServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServicesResponse.ProtoReflect.Descriptor instead.
+func (*ListServicesResponse) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListServicesResponse) GetServices() []*ServiceInfo {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type StreamDependencySnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDependencySnapshotRequest) Reset() {
+	*x = StreamDependencySnapshotRequest{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDependencySnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDependencySnapshotRequest) ProtoMessage() {}
+
+func (x *StreamDependencySnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDependencySnapshotRequest.ProtoReflect.Descriptor instead.
+func (*StreamDependencySnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StreamDependencySnapshotRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type SnapshotNode struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace       string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	RiskLevel       string                 `protobuf:"bytes,4,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	RiskReason      string                 `protobuf:"bytes,5,opt,name=risk_reason,json=riskReason,proto3" json:"risk_reason,omitempty"`
+	ReqRate         *float64               `protobuf:"fixed64,6,opt,name=req_rate,json=reqRate,proto3,oneof" json:"req_rate,omitempty"`
+	ErrorRatePct    *float64               `protobuf:"fixed64,7,opt,name=error_rate_pct,json=errorRatePct,proto3,oneof" json:"error_rate_pct,omitempty"`
+	LatencyP95Ms    *float64               `protobuf:"fixed64,8,opt,name=latency_p95_ms,json=latencyP95Ms,proto3,oneof" json:"latency_p95_ms,omitempty"`
+	AvailabilityPct *float64               `protobuf:"fixed64,9,opt,name=availability_pct,json=availabilityPct,proto3,oneof" json:"availability_pct,omitempty"`
+	PodCount        *int32                 `protobuf:"varint,10,opt,name=pod_count,json=podCount,proto3,oneof" json:"pod_count,omitempty"`
+	Availability    *float64               `protobuf:"fixed64,11,opt,name=availability,proto3,oneof" json:"availability,omitempty"`
+	PageRank        *float64               `protobuf:"fixed64,12,opt,name=page_rank,json=pageRank,proto3,oneof" json:"page_rank,omitempty"`
+	Betweenness     *float64               `protobuf:"fixed64,13,opt,name=betweenness,proto3,oneof" json:"betweenness,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SnapshotNode) Reset() {
+	*x = SnapshotNode{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotNode) ProtoMessage() {}
+
+func (x *SnapshotNode) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotNode.ProtoReflect.Descriptor instead.
+func (*SnapshotNode) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SnapshotNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SnapshotNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotNode) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SnapshotNode) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *SnapshotNode) GetRiskReason() string {
+	if x != nil {
+		return x.RiskReason
+	}
+	return ""
+}
+
+func (x *SnapshotNode) GetReqRate() float64 {
+	if x != nil && x.ReqRate != nil {
+		return *x.ReqRate
+	}
+	return 0
+}
+
+func (x *SnapshotNode) GetErrorRatePct() float64 {
+	if x != nil && x.ErrorRatePct != nil {
+		return *x.ErrorRatePct
+	}
+	return 0
+}
+
+func (x *SnapshotNode) GetLatencyP95Ms() float64 {
+	if x != nil && x.LatencyP95Ms != nil {
+		return *x.LatencyP95Ms
+	}
+	return 0
+}
+
+func (x *SnapshotNode) GetAvailabilityPct() float64 {
+	if x != nil && x.AvailabilityPct != nil {
+		return *x.AvailabilityPct
+	}
+	return 0
+}
+
+func (x *SnapshotNode) GetPodCount() int32 {
+	if x != nil && x.PodCount != nil {
+		return *x.PodCount
+	}
+	return 0
+}
+
+func (x *SnapshotNode) GetAvailability() float64 {
+	if x != nil && x.Availability != nil {
+		return *x.Availability
+	}
+	return 0
+}
+
+func (x *SnapshotNode) GetPageRank() float64 {
+	if x != nil && x.PageRank != nil {
+		return *x.PageRank
+	}
+	return 0
+}
+
+func (x *SnapshotNode) GetBetweenness() float64 {
+	if x != nil && x.Betweenness != nil {
+		return *x.Betweenness
+	}
+	return 0
+}
+
+func (x *SnapshotNode) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type SnapshotEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	ReqRate       float64                `protobuf:"fixed64,4,opt,name=req_rate,json=reqRate,proto3" json:"req_rate,omitempty"`
+	LatencyP95Ms  float64                `protobuf:"fixed64,5,opt,name=latency_p95_ms,json=latencyP95Ms,proto3" json:"latency_p95_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotEdge) Reset() {
+	*x = SnapshotEdge{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotEdge) ProtoMessage() {}
+
+func (x *SnapshotEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotEdge.ProtoReflect.Descriptor instead.
+func (*SnapshotEdge) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SnapshotEdge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SnapshotEdge) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SnapshotEdge) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SnapshotEdge) GetReqRate() float64 {
+	if x != nil {
+		return x.ReqRate
+	}
+	return 0
+}
+
+func (x *SnapshotEdge) GetLatencyP95Ms() float64 {
+	if x != nil {
+		return x.LatencyP95Ms
+	}
+	return 0
+}
+
+type SnapshotMetadata struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Stale                 bool                   `protobuf:"varint,1,opt,name=stale,proto3" json:"stale,omitempty"`
+	LastUpdatedSecondsAgo *int32                 `protobuf:"varint,2,opt,name=last_updated_seconds_ago,json=lastUpdatedSecondsAgo,proto3,oneof" json:"last_updated_seconds_ago,omitempty"`
+	WindowMinutes         int32                  `protobuf:"varint,3,opt,name=window_minutes,json=windowMinutes,proto3" json:"window_minutes,omitempty"`
+	NodeCount             int32                  `protobuf:"varint,4,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	EdgeCount             int32                  `protobuf:"varint,5,opt,name=edge_count,json=edgeCount,proto3" json:"edge_count,omitempty"`
+	NodesWithMetrics      int32                  `protobuf:"varint,6,opt,name=nodes_with_metrics,json=nodesWithMetrics,proto3" json:"nodes_with_metrics,omitempty"`
+	EdgesWithMetrics      int32                  `protobuf:"varint,7,opt,name=edges_with_metrics,json=edgesWithMetrics,proto3" json:"edges_with_metrics,omitempty"`
+	GeneratedAt           string                 `protobuf:"bytes,8,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SnapshotMetadata) Reset() {
+	*x = SnapshotMetadata{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotMetadata) ProtoMessage() {}
+
+func (x *SnapshotMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotMetadata.ProtoReflect.Descriptor instead.
+func (*SnapshotMetadata) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SnapshotMetadata) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *SnapshotMetadata) GetLastUpdatedSecondsAgo() int32 {
+	if x != nil && x.LastUpdatedSecondsAgo != nil {
+		return *x.LastUpdatedSecondsAgo
+	}
+	return 0
+}
+
+func (x *SnapshotMetadata) GetWindowMinutes() int32 {
+	if x != nil {
+		return x.WindowMinutes
+	}
+	return 0
+}
+
+func (x *SnapshotMetadata) GetNodeCount() int32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *SnapshotMetadata) GetEdgeCount() int32 {
+	if x != nil {
+		return x.EdgeCount
+	}
+	return 0
+}
+
+func (x *SnapshotMetadata) GetNodesWithMetrics() int32 {
+	if x != nil {
+		return x.NodesWithMetrics
+	}
+	return 0
+}
+
+func (x *SnapshotMetadata) GetEdgesWithMetrics() int32 {
+	if x != nil {
+		return x.EdgesWithMetrics
+	}
+	return 0
+}
+
+func (x *SnapshotMetadata) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+type DependencySnapshotChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Item:
+	//
+	//	*DependencySnapshotChunk_Node
+	//	*DependencySnapshotChunk_Edge
+	//	*DependencySnapshotChunk_Metadata
+	Item          isDependencySnapshotChunk_Item `protobuf_oneof:"item"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencySnapshotChunk) Reset() {
+	*x = DependencySnapshotChunk{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencySnapshotChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencySnapshotChunk) ProtoMessage() {}
+
+func (x *DependencySnapshotChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencySnapshotChunk.ProtoReflect.Descriptor instead.
+func (*DependencySnapshotChunk) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DependencySnapshotChunk) GetItem() isDependencySnapshotChunk_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *DependencySnapshotChunk) GetNode() *SnapshotNode {
+	if x != nil {
+		if x, ok := x.Item.(*DependencySnapshotChunk_Node); ok {
+			return x.Node
+		}
+	}
+	return nil
+}
+
+func (x *DependencySnapshotChunk) GetEdge() *SnapshotEdge {
+	if x != nil {
+		if x, ok := x.Item.(*DependencySnapshotChunk_Edge); ok {
+			return x.Edge
+		}
+	}
+	return nil
+}
+
+func (x *DependencySnapshotChunk) GetMetadata() *SnapshotMetadata {
+	if x != nil {
+		if x, ok := x.Item.(*DependencySnapshotChunk_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+type isDependencySnapshotChunk_Item interface {
+	isDependencySnapshotChunk_Item()
+}
+
+type DependencySnapshotChunk_Node struct {
+	Node *SnapshotNode `protobuf:"bytes,1,opt,name=node,proto3,oneof"`
+}
+
+type DependencySnapshotChunk_Edge struct {
+	Edge *SnapshotEdge `protobuf:"bytes,2,opt,name=edge,proto3,oneof"`
+}
+
+type DependencySnapshotChunk_Metadata struct {
+	Metadata *SnapshotMetadata `protobuf:"bytes,3,opt,name=metadata,proto3,oneof"`
+}
+
+func (*DependencySnapshotChunk_Node) isDependencySnapshotChunk_Item() {}
+
+func (*DependencySnapshotChunk_Edge) isDependencySnapshotChunk_Item() {}
+
+func (*DependencySnapshotChunk_Metadata) isDependencySnapshotChunk_Item() {}
+
+type GetDecisionHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDecisionHistoryRequest) Reset() {
+	*x = GetDecisionHistoryRequest{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDecisionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDecisionHistoryRequest) ProtoMessage() {}
+
+func (x *GetDecisionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDecisionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDecisionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetDecisionHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetDecisionHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetDecisionHistoryRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type DecisionRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Scenario      *structpb.Value        `protobuf:"bytes,4,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Result        *structpb.Value        `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`
+	CorrelationId string                 `protobuf:"bytes,6,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecisionRecord) Reset() {
+	*x = DecisionRecord{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecisionRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecisionRecord) ProtoMessage() {}
+
+func (x *DecisionRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecisionRecord.ProtoReflect.Descriptor instead.
+func (*DecisionRecord) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DecisionRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DecisionRecord) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *DecisionRecord) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DecisionRecord) GetScenario() *structpb.Value {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+func (x *DecisionRecord) GetResult() *structpb.Value {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *DecisionRecord) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *DecisionRecord) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetDecisionHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decisions     []*DecisionRecord      `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDecisionHistoryResponse) Reset() {
+	*x = GetDecisionHistoryResponse{}
+	mi := &file_analysis_v1_analysis_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDecisionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDecisionHistoryResponse) ProtoMessage() {}
+
+func (x *GetDecisionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_v1_analysis_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDecisionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDecisionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_analysis_v1_analysis_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetDecisionHistoryResponse) GetDecisions() []*DecisionRecord {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+func (x *GetDecisionHistoryResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetDecisionHistoryResponse) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetDecisionHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_analysis_v1_analysis_proto protoreflect.FileDescriptor
+
+const file_analysis_v1_analysis_proto_rawDesc = "" +
+	"\n" +
+	"\x1aanalysis/v1/analysis.proto\x12\vanalysis.v1\x1a\x1cgoogle/protobuf/struct.proto\"]\n" +
+	"\n" +
+	"ServiceRef\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"\xba\x01\n" +
+	"\x10NeighborhoodMeta\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12#\n" +
+	"\rservice_count\x18\x02 \x01(\x05R\fserviceCount\x12\x1d\n" +
+	"\n" +
+	"edge_count\x18\x03 \x01(\x05R\tedgeCount\x12\x1d\n" +
+	"\n" +
+	"depth_used\x18\x04 \x01(\x05R\tdepthUsed\x12!\n" +
+	"\fgenerated_at\x18\x05 \x01(\tR\vgeneratedAt\"\x9d\x01\n" +
+	"\rDataFreshness\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x14\n" +
+	"\x05stale\x18\x02 \x01(\bR\x05stale\x127\n" +
+	"\x18last_updated_seconds_ago\x18\x03 \x01(\x05R\x15lastUpdatedSecondsAgo\x12%\n" +
+	"\x0ewindow_minutes\x18\x04 \x01(\x05R\rwindowMinutes\"\xaa\x01\n" +
+	"\x0eRecommendation\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\tR\bpriority\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"O\n" +
+	"\x18FailureSimulationRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\"\xb3\x01\n" +
+	"\x0eAffectedCaller\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12(\n" +
+	"\x10lost_traffic_rps\x18\x04 \x01(\x01R\x0elostTrafficRps\x12&\n" +
+	"\x0fedge_error_rate\x18\x05 \x01(\x01R\redgeErrorRate\"\xb7\x01\n" +
+	"\x12AffectedDownstream\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12(\n" +
+	"\x10lost_traffic_rps\x18\x04 \x01(\x01R\x0elostTrafficRps\x12&\n" +
+	"\x0fedge_error_rate\x18\x05 \x01(\x01R\redgeErrorRate\"\x80\x02\n" +
+	"\x12UnreachableService\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12(\n" +
+	"\x10lost_traffic_rps\x18\x04 \x01(\x01R\x0elostTrafficRps\x12/\n" +
+	"\x14lost_from_target_rps\x18\x05 \x01(\x01R\x11lostFromTargetRps\x12>\n" +
+	"\x1clost_from_reachable_cuts_rps\x18\x06 \x01(\x01R\x18lostFromReachableCutsRps\";\n" +
+	"\n" +
+	"BrokenPath\x12\x12\n" +
+	"\x04path\x18\x01 \x03(\tR\x04path\x12\x19\n" +
+	"\bpath_rps\x18\x02 \x01(\x01R\apathRps\"\x89\x06\n" +
+	"\x17FailureSimulationResult\x12/\n" +
+	"\x06target\x18\x01 \x01(\v2\x17.analysis.v1.ServiceRefR\x06target\x12A\n" +
+	"\fneighborhood\x18\x02 \x01(\v2\x1d.analysis.v1.NeighborhoodMetaR\fneighborhood\x12A\n" +
+	"\x0edata_freshness\x18\x03 \x01(\v2\x1a.analysis.v1.DataFreshnessR\rdataFreshness\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\tR\n" +
+	"confidence\x12 \n" +
+	"\vexplanation\x18\x05 \x01(\tR\vexplanation\x12F\n" +
+	"\x10affected_callers\x18\x06 \x03(\v2\x1b.analysis.v1.AffectedCallerR\x0faffectedCallers\x12P\n" +
+	"\x13affected_downstream\x18\a \x03(\v2\x1f.analysis.v1.AffectedDownstreamR\x12affectedDownstream\x12R\n" +
+	"\x14unreachable_services\x18\b \x03(\v2\x1f.analysis.v1.UnreachableServiceR\x13unreachableServices\x12P\n" +
+	"\x18critical_paths_to_target\x18\t \x03(\v2\x17.analysis.v1.BrokenPathR\x15criticalPathsToTarget\x123\n" +
+	"\x16total_lost_traffic_rps\x18\n" +
+	" \x01(\x01R\x13totalLostTrafficRps\x12E\n" +
+	"\x0frecommendations\x18\v \x03(\v2\x1b.analysis.v1.RecommendationR\x0frecommendations\x129\n" +
+	"\fslo_breaches\x18\f \x03(\v2\x16.analysis.v1.SLOBreachR\vsloBreaches\"\xc1\x01\n" +
+	"\tSLOBreach\x12\x15\n" +
+	"\x06slo_id\x18\x01 \x01(\x03R\x05sloId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x03 \x01(\tR\tserviceId\x12\x1c\n" +
+	"\tobjective\x18\x04 \x01(\tR\tobjective\x12\x16\n" +
+	"\x06target\x18\x05 \x01(\x01R\x06target\x12\x1c\n" +
+	"\tprojected\x18\x06 \x01(\x01R\tprojected\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"\xea\x01\n" +
+	"\fScalingModel\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x19\n" +
+	"\x05alpha\x18\x02 \x01(\x01H\x00R\x05alpha\x88\x01\x01\x121\n" +
+	"\x12min_latency_factor\x18\x03 \x01(\x01H\x01R\x10minLatencyFactor\x88\x01\x01\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12?\n" +
+	"\vcalibration\x18\x05 \x01(\v2\x1d.analysis.v1.ModelCalibrationR\vcalibrationB\b\n" +
+	"\x06_alphaB\x15\n" +
+	"\x13_min_latency_factor\"]\n" +
+	"\x10ModelCalibration\x12\x18\n" +
+	"\asamples\x18\x01 \x01(\x05R\asamples\x12\x12\n" +
+	"\x04rmse\x18\x02 \x01(\x01R\x04rmse\x12\x1b\n" +
+	"\tfitted_at\x18\x03 \x01(\tR\bfittedAt\"\xa9\x02\n" +
+	"\x18ScalingSimulationRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12!\n" +
+	"\fcurrent_pods\x18\x02 \x01(\x05R\vcurrentPods\x12\x19\n" +
+	"\bnew_pods\x18\x03 \x01(\x05R\anewPods\x12%\n" +
+	"\x0elatency_metric\x18\x04 \x01(\tR\rlatencyMetric\x12/\n" +
+	"\x05model\x18\x05 \x01(\v2\x19.analysis.v1.ScalingModelR\x05model\x12\x1b\n" +
+	"\tmax_depth\x18\x06 \x01(\x05R\bmaxDepth\x12\x1f\n" +
+	"\vtime_window\x18\a \x01(\tR\n" +
+	"timeWindow\x12\x1a\n" +
+	"\bbaseline\x18\b \x01(\tR\bbaseline\"\x85\x01\n" +
+	"\x0fScalingBaseline\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x0e\n" +
+	"\x02at\x18\x02 \x01(\tR\x02at\x12%\n" +
+	"\x0eforecast_edges\x18\x03 \x01(\x05R\rforecastEdges\x12#\n" +
+	"\rcurrent_edges\x18\x04 \x01(\x05R\fcurrentEdges\"\xea\x01\n" +
+	"\x16ScalingLatencyEstimate\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12$\n" +
+	"\vbaseline_ms\x18\x02 \x01(\x01H\x00R\n" +
+	"baselineMs\x88\x01\x01\x12&\n" +
+	"\fprojected_ms\x18\x03 \x01(\x01H\x01R\vprojectedMs\x88\x01\x01\x12\x1e\n" +
+	"\bdelta_ms\x18\x04 \x01(\x01H\x02R\adeltaMs\x88\x01\x01\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unitB\x0e\n" +
+	"\f_baseline_msB\x0f\n" +
+	"\r_projected_msB\v\n" +
+	"\t_delta_ms\"\x94\x04\n" +
+	"\x15AffectedCallerScaling\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12!\n" +
+	"\fhop_distance\x18\x04 \x01(\x05R\vhopDistance\x12 \n" +
+	"\tbefore_ms\x18\x05 \x01(\x01H\x00R\bbeforeMs\x88\x01\x01\x12\x1e\n" +
+	"\bafter_ms\x18\x06 \x01(\x01H\x01R\aafterMs\x88\x01\x01\x12\x1e\n" +
+	"\bdelta_ms\x18\a \x01(\x01H\x02R\adeltaMs\x88\x01\x01\x123\n" +
+	"\x14end_to_end_before_ms\x18\b \x01(\x01H\x03R\x10endToEndBeforeMs\x88\x01\x01\x121\n" +
+	"\x13end_to_end_after_ms\x18\t \x01(\x01H\x04R\x0fendToEndAfterMs\x88\x01\x01\x121\n" +
+	"\x13end_to_end_delta_ms\x18\n" +
+	" \x01(\x01H\x05R\x0fendToEndDeltaMs\x88\x01\x01\x12\x19\n" +
+	"\bvia_path\x18\v \x03(\tR\aviaPathB\f\n" +
+	"\n" +
+	"_before_msB\v\n" +
+	"\t_after_msB\v\n" +
+	"\t_delta_msB\x17\n" +
+	"\x15_end_to_end_before_msB\x16\n" +
+	"\x14_end_to_end_after_msB\x16\n" +
+	"\x14_end_to_end_delta_ms\"\xf7\x01\n" +
+	"\x13AffectedPathScaling\x12\x12\n" +
+	"\x04path\x18\x01 \x03(\tR\x04path\x12\x19\n" +
+	"\bpath_rps\x18\x02 \x01(\x01R\apathRps\x12 \n" +
+	"\tbefore_ms\x18\x03 \x01(\x01H\x00R\bbeforeMs\x88\x01\x01\x12\x1e\n" +
+	"\bafter_ms\x18\x04 \x01(\x01H\x01R\aafterMs\x88\x01\x01\x12\x1e\n" +
+	"\bdelta_ms\x18\x05 \x01(\x01H\x02R\adeltaMs\x88\x01\x01\x12'\n" +
+	"\x0fincomplete_data\x18\x06 \x01(\bR\x0eincompleteDataB\f\n" +
+	"\n" +
+	"_before_msB\v\n" +
+	"\t_after_msB\v\n" +
+	"\t_delta_ms\"\xa4\a\n" +
+	"\x17ScalingSimulationResult\x12/\n" +
+	"\x06target\x18\x01 \x01(\v2\x17.analysis.v1.ServiceRefR\x06target\x12A\n" +
+	"\fneighborhood\x18\x02 \x01(\v2\x1d.analysis.v1.NeighborhoodMetaR\fneighborhood\x12A\n" +
+	"\x0edata_freshness\x18\x03 \x01(\v2\x1a.analysis.v1.DataFreshnessR\rdataFreshness\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\tR\n" +
+	"confidence\x12 \n" +
+	"\vexplanation\x18\x05 \x01(\tR\vexplanation\x12\x1a\n" +
+	"\bwarnings\x18\x06 \x03(\tR\bwarnings\x12%\n" +
+	"\x0elatency_metric\x18\a \x01(\tR\rlatencyMetric\x12>\n" +
+	"\rscaling_model\x18\b \x01(\v2\x19.analysis.v1.ScalingModelR\fscalingModel\x12!\n" +
+	"\fcurrent_pods\x18\t \x01(\x05R\vcurrentPods\x12\x19\n" +
+	"\bnew_pods\x18\n" +
+	" \x01(\x05R\anewPods\x12N\n" +
+	"\x10latency_estimate\x18\v \x01(\v2#.analysis.v1.ScalingLatencyEstimateR\x0flatencyEstimate\x12+\n" +
+	"\x11scaling_direction\x18\f \x01(\tR\x10scalingDirection\x12M\n" +
+	"\x10affected_callers\x18\r \x03(\v2\".analysis.v1.AffectedCallerScalingR\x0faffectedCallers\x12G\n" +
+	"\x0eaffected_paths\x18\x0e \x03(\v2 .analysis.v1.AffectedPathScalingR\raffectedPaths\x12E\n" +
+	"\x0frecommendations\x18\x0f \x03(\v2\x1b.analysis.v1.RecommendationR\x0frecommendations\x128\n" +
+	"\bbaseline\x18\x10 \x01(\v2\x1c.analysis.v1.ScalingBaselineR\bbaseline\x129\n" +
+	"\fslo_breaches\x18\x11 \x03(\v2\x16.analysis.v1.SLOBreachR\vsloBreaches\".\n" +
+	"\rDependencyRef\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\"\xf8\x01\n" +
+	"\x14AddSimulationRequest\x12!\n" +
+	"\fservice_name\x18\x01 \x01(\tR\vserviceName\x12\x1f\n" +
+	"\vcpu_request\x18\x02 \x01(\x01R\n" +
+	"cpuRequest\x12\x1f\n" +
+	"\vram_request\x18\x03 \x01(\x05R\n" +
+	"ramRequest\x12\x1a\n" +
+	"\breplicas\x18\x04 \x01(\x05R\breplicas\x12\x1f\n" +
+	"\vtime_window\x18\x05 \x01(\tR\n" +
+	"timeWindow\x12>\n" +
+	"\fdependencies\x18\x06 \x03(\v2\x1a.analysis.v1.DependencyRefR\fdependencies\"\xae\x02\n" +
+	"\fNodeCapacity\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12#\n" +
+	"\rcpu_available\x18\x02 \x01(\x01R\fcpuAvailable\x12(\n" +
+	"\x10ram_available_mb\x18\x03 \x01(\x01R\x0eramAvailableMb\x12\x1b\n" +
+	"\tcpu_total\x18\x04 \x01(\x01R\bcpuTotal\x12 \n" +
+	"\fram_total_mb\x18\x05 \x01(\x01R\n" +
+	"ramTotalMb\x12\x17\n" +
+	"\acan_fit\x18\x06 \x01(\bR\x06canFit\x12\x19\n" +
+	"\bmax_pods\x18\a \x01(\x05R\amaxPods\x12\x14\n" +
+	"\x05score\x18\b \x01(\x05R\x05score\x12\x1a\n" +
+	"\bsuitable\x18\t \x01(\bR\bsuitable\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\"\\\n" +
+	"\x0fAddRiskAnalysis\x12'\n" +
+	"\x0fdependency_risk\x18\x01 \x01(\tR\x0edependencyRisk\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"G\n" +
+	"\x15PlacementDistribution\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x1a\n" +
+	"\breplicas\x18\x02 \x01(\x05R\breplicas\"\xe5\x03\n" +
+	"\x13AddSimulationResult\x12.\n" +
+	"\x13target_service_name\x18\x01 \x01(\tR\x11targetServiceName\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x03 \x01(\tR\n" +
+	"confidence\x12 \n" +
+	"\vexplanation\x18\x04 \x01(\tR\vexplanation\x12.\n" +
+	"\x13total_capacity_pods\x18\x05 \x01(\x05R\x11totalCapacityPods\x12@\n" +
+	"\x0esuitable_nodes\x18\x06 \x03(\v2\x19.analysis.v1.NodeCapacityR\rsuitableNodes\x12A\n" +
+	"\rrisk_analysis\x18\a \x01(\v2\x1c.analysis.v1.AddRiskAnalysisR\friskAnalysis\x12E\n" +
+	"\x0frecommendations\x18\b \x03(\v2\x1b.analysis.v1.RecommendationR\x0frecommendations\x12F\n" +
+	"\fdistribution\x18\t \x03(\v2\".analysis.v1.PlacementDistributionR\fdistribution\"A\n" +
+	"\x11GetTopRiskRequest\x12\x16\n" +
+	"\x06metric\x18\x01 \x01(\tR\x06metric\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xca\x01\n" +
+	"\vRiskService\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12)\n" +
+	"\x10centrality_score\x18\x04 \x01(\x01R\x0fcentralityScore\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x05 \x01(\tR\triskLevel\x12 \n" +
+	"\vexplanation\x18\x06 \x01(\tR\vexplanation\"\xc5\x01\n" +
+	"\x12GetTopRiskResponse\x12\x16\n" +
+	"\x06metric\x18\x01 \x01(\tR\x06metric\x124\n" +
+	"\bservices\x18\x02 \x03(\v2\x18.analysis.v1.RiskServiceR\bservices\x12A\n" +
+	"\x0edata_freshness\x18\x03 \x01(\v2\x1a.analysis.v1.DataFreshnessR\rdataFreshness\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\tR\n" +
+	"confidence\"\x15\n" +
+	"\x13ListServicesRequest\"\x90\x01\n" +
+	"\aPodInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\vram_used_mb\x18\x02 \x01(\x01R\tramUsedMb\x12*\n" +
+	"\x11cpu_usage_percent\x18\x03 \x01(\x01R\x0fcpuUsagePercent\x12%\n" +
+	"\x0euptime_seconds\x18\x04 \x01(\x05R\ruptimeSeconds\"\xd8\x01\n" +
+	"\rNodePlacement\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12*\n" +
+	"\x11cpu_usage_percent\x18\x02 \x01(\x01R\x0fcpuUsagePercent\x12\x1b\n" +
+	"\tcpu_cores\x18\x03 \x01(\x05R\bcpuCores\x12\x1e\n" +
+	"\vram_used_mb\x18\x04 \x01(\x01R\tramUsedMb\x12 \n" +
+	"\fram_total_mb\x18\x05 \x01(\x01R\n" +
+	"ramTotalMb\x12(\n" +
+	"\x04pods\x18\x06 \x03(\v2\x14.analysis.v1.PodInfoR\x04pods\"\xd9\x01\n" +
+	"\vServiceInfo\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x1b\n" +
+	"\tpod_count\x18\x04 \x01(\x05R\bpodCount\x12\"\n" +
+	"\favailability\x18\x05 \x01(\x01R\favailability\x128\n" +
+	"\tplacement\x18\x06 \x03(\v2\x1a.analysis.v1.NodePlacementR\tplacement\"L\n" +
+	"\x14ListServicesResponse\x124\n" +
+	"\bservices\x18\x01 \x03(\v2\x18.analysis.v1.ServiceInfoR\bservices\"?\n" +
+	"\x1fStreamDependencySnapshotRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\xee\x04\n" +
+	"\fSnapshotNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x04 \x01(\tR\triskLevel\x12\x1f\n" +
+	"\vrisk_reason\x18\x05 \x01(\tR\n" +
+	"riskReason\x12\x1e\n" +
+	"\breq_rate\x18\x06 \x01(\x01H\x00R\areqRate\x88\x01\x01\x12)\n" +
+	"\x0eerror_rate_pct\x18\a \x01(\x01H\x01R\ferrorRatePct\x88\x01\x01\x12)\n" +
+	"\x0elatency_p95_ms\x18\b \x01(\x01H\x02R\flatencyP95Ms\x88\x01\x01\x12.\n" +
+	"\x10availability_pct\x18\t \x01(\x01H\x03R\x0favailabilityPct\x88\x01\x01\x12 \n" +
+	"\tpod_count\x18\n" +
+	" \x01(\x05H\x04R\bpodCount\x88\x01\x01\x12'\n" +
+	"\favailability\x18\v \x01(\x01H\x05R\favailability\x88\x01\x01\x12 \n" +
+	"\tpage_rank\x18\f \x01(\x01H\x06R\bpageRank\x88\x01\x01\x12%\n" +
+	"\vbetweenness\x18\r \x01(\x01H\aR\vbetweenness\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\tR\tupdatedAtB\v\n" +
+	"\t_req_rateB\x11\n" +
+	"\x0f_error_rate_pctB\x11\n" +
+	"\x0f_latency_p95_msB\x13\n" +
+	"\x11_availability_pctB\f\n" +
+	"\n" +
+	"_pod_countB\x0f\n" +
+	"\r_availabilityB\f\n" +
+	"\n" +
+	"_page_rankB\x0e\n" +
+	"\f_betweenness\"\x8f\x01\n" +
+	"\fSnapshotEdge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x12\x19\n" +
+	"\breq_rate\x18\x04 \x01(\x01R\areqRate\x12$\n" +
+	"\x0elatency_p95_ms\x18\x05 \x01(\x01R\flatencyP95Ms\"\xe7\x02\n" +
+	"\x10SnapshotMetadata\x12\x14\n" +
+	"\x05stale\x18\x01 \x01(\bR\x05stale\x12<\n" +
+	"\x18last_updated_seconds_ago\x18\x02 \x01(\x05H\x00R\x15lastUpdatedSecondsAgo\x88\x01\x01\x12%\n" +
+	"\x0ewindow_minutes\x18\x03 \x01(\x05R\rwindowMinutes\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\x04 \x01(\x05R\tnodeCount\x12\x1d\n" +
+	"\n" +
+	"edge_count\x18\x05 \x01(\x05R\tedgeCount\x12,\n" +
+	"\x12nodes_with_metrics\x18\x06 \x01(\x05R\x10nodesWithMetrics\x12,\n" +
+	"\x12edges_with_metrics\x18\a \x01(\x05R\x10edgesWithMetrics\x12!\n" +
+	"\fgenerated_at\x18\b \x01(\tR\vgeneratedAtB\x1b\n" +
+	"\x19_last_updated_seconds_ago\"\xc0\x01\n" +
+	"\x17DependencySnapshotChunk\x12/\n" +
+	"\x04node\x18\x01 \x01(\v2\x19.analysis.v1.SnapshotNodeH\x00R\x04node\x12/\n" +
+	"\x04edge\x18\x02 \x01(\v2\x19.analysis.v1.SnapshotEdgeH\x00R\x04edge\x12;\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x1d.analysis.v1.SnapshotMetadataH\x00R\bmetadataB\x06\n" +
+	"\x04item\"]\n" +
+	"\x19GetDecisionHistoryRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"\xfc\x01\n" +
+	"\x0eDecisionRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\tR\ttimestamp\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x122\n" +
+	"\bscenario\x18\x04 \x01(\v2\x16.google.protobuf.ValueR\bscenario\x12.\n" +
+	"\x06result\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\x06result\x12%\n" +
+	"\x0ecorrelation_id\x18\x06 \x01(\tR\rcorrelationId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\x9b\x01\n" +
+	"\x1aGetDecisionHistoryResponse\x129\n" +
+	"\tdecisions\x18\x01 \x03(\v2\x1b.analysis.v1.DecisionRecordR\tdecisions\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total2\xa2\x05\n" +
+	"\x0fAnalysisService\x12^\n" +
+	"\x0fSimulateFailure\x12%.analysis.v1.FailureSimulationRequest\x1a$.analysis.v1.FailureSimulationResult\x12^\n" +
+	"\x0fSimulateScaling\x12%.analysis.v1.ScalingSimulationRequest\x1a$.analysis.v1.ScalingSimulationResult\x12R\n" +
+	"\vSimulateAdd\x12!.analysis.v1.AddSimulationRequest\x1a .analysis.v1.AddSimulationResult\x12M\n" +
+	"\n" +
+	"GetTopRisk\x12\x1e.analysis.v1.GetTopRiskRequest\x1a\x1f.analysis.v1.GetTopRiskResponse\x12S\n" +
+	"\fListServices\x12 .analysis.v1.ListServicesRequest\x1a!.analysis.v1.ListServicesResponse\x12p\n" +
+	"\x18StreamDependencySnapshot\x12,.analysis.v1.StreamDependencySnapshotRequest\x1a$.analysis.v1.DependencySnapshotChunk0\x01\x12e\n" +
+	"\x12GetDecisionHistory\x12&.analysis.v1.GetDecisionHistoryRequest\x1a'.analysis.v1.GetDecisionHistoryResponseB>Z<predictive-analysis-engine/pkg/grpcapi/analysisv1;analysisv1b\x06proto3"
+
+var (
+	file_analysis_v1_analysis_proto_rawDescOnce sync.Once
+	file_analysis_v1_analysis_proto_rawDescData []byte
+)
+
+func file_analysis_v1_analysis_proto_rawDescGZIP() []byte {
+	file_analysis_v1_analysis_proto_rawDescOnce.Do(func() {
+		file_analysis_v1_analysis_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_analysis_v1_analysis_proto_rawDesc), len(file_analysis_v1_analysis_proto_rawDesc)))
+	})
+	return file_analysis_v1_analysis_proto_rawDescData
+}
+
+var file_analysis_v1_analysis_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_analysis_v1_analysis_proto_goTypes = []any{
+	(*ServiceRef)(nil),                      // 0: analysis.v1.ServiceRef
+	(*NeighborhoodMeta)(nil),                // 1: analysis.v1.NeighborhoodMeta
+	(*DataFreshness)(nil),                   // 2: analysis.v1.DataFreshness
+	(*Recommendation)(nil),                  // 3: analysis.v1.Recommendation
+	(*FailureSimulationRequest)(nil),        // 4: analysis.v1.FailureSimulationRequest
+	(*AffectedCaller)(nil),                  // 5: analysis.v1.AffectedCaller
+	(*AffectedDownstream)(nil),              // 6: analysis.v1.AffectedDownstream
+	(*UnreachableService)(nil),              // 7: analysis.v1.UnreachableService
+	(*BrokenPath)(nil),                      // 8: analysis.v1.BrokenPath
+	(*FailureSimulationResult)(nil),         // 9: analysis.v1.FailureSimulationResult
+	(*SLOBreach)(nil),                       // 10: analysis.v1.SLOBreach
+	(*ScalingModel)(nil),                    // 11: analysis.v1.ScalingModel
+	(*ModelCalibration)(nil),                // 12: analysis.v1.ModelCalibration
+	(*ScalingSimulationRequest)(nil),        // 13: analysis.v1.ScalingSimulationRequest
+	(*ScalingBaseline)(nil),                 // 14: analysis.v1.ScalingBaseline
+	(*ScalingLatencyEstimate)(nil),          // 15: analysis.v1.ScalingLatencyEstimate
+	(*AffectedCallerScaling)(nil),           // 16: analysis.v1.AffectedCallerScaling
+	(*AffectedPathScaling)(nil),             // 17: analysis.v1.AffectedPathScaling
+	(*ScalingSimulationResult)(nil),         // 18: analysis.v1.ScalingSimulationResult
+	(*DependencyRef)(nil),                   // 19: analysis.v1.DependencyRef
+	(*AddSimulationRequest)(nil),            // 20: analysis.v1.AddSimulationRequest
+	(*NodeCapacity)(nil),                    // 21: analysis.v1.NodeCapacity
+	(*AddRiskAnalysis)(nil),                 // 22: analysis.v1.AddRiskAnalysis
+	(*PlacementDistribution)(nil),           // 23: analysis.v1.PlacementDistribution
+	(*AddSimulationResult)(nil),             // 24: analysis.v1.AddSimulationResult
+	(*GetTopRiskRequest)(nil),               // 25: analysis.v1.GetTopRiskRequest
+	(*RiskService)(nil),                     // 26: analysis.v1.RiskService
+	(*GetTopRiskResponse)(nil),              // 27: analysis.v1.GetTopRiskResponse
+	(*ListServicesRequest)(nil),             // 28: analysis.v1.ListServicesRequest
+	(*PodInfo)(nil),                         // 29: analysis.v1.PodInfo
+	(*NodePlacement)(nil),                   // 30: analysis.v1.NodePlacement
+	(*ServiceInfo)(nil),                     // 31: analysis.v1.ServiceInfo
+	(*ListServicesResponse)(nil),            // 32: analysis.v1.ListServicesResponse
+	(*StreamDependencySnapshotRequest)(nil), // 33: analysis.v1.StreamDependencySnapshotRequest
+	(*SnapshotNode)(nil),                    // 34: analysis.v1.SnapshotNode
+	(*SnapshotEdge)(nil),                    // 35: analysis.v1.SnapshotEdge
+	(*SnapshotMetadata)(nil),                // 36: analysis.v1.SnapshotMetadata
+	(*DependencySnapshotChunk)(nil),         // 37: analysis.v1.DependencySnapshotChunk
+	(*GetDecisionHistoryRequest)(nil),       // 38: analysis.v1.GetDecisionHistoryRequest
+	(*DecisionRecord)(nil),                  // 39: analysis.v1.DecisionRecord
+	(*GetDecisionHistoryResponse)(nil),      // 40: analysis.v1.GetDecisionHistoryResponse
+	(*structpb.Value)(nil),                  // 41: google.protobuf.Value
+}
+var file_analysis_v1_analysis_proto_depIdxs = []int32{
+	0,  // 0: analysis.v1.FailureSimulationResult.target:type_name -> analysis.v1.ServiceRef
+	1,  // 1: analysis.v1.FailureSimulationResult.neighborhood:type_name -> analysis.v1.NeighborhoodMeta
+	2,  // 2: analysis.v1.FailureSimulationResult.data_freshness:type_name -> analysis.v1.DataFreshness
+	5,  // 3: analysis.v1.FailureSimulationResult.affected_callers:type_name -> analysis.v1.AffectedCaller
+	6,  // 4: analysis.v1.FailureSimulationResult.affected_downstream:type_name -> analysis.v1.AffectedDownstream
+	7,  // 5: analysis.v1.FailureSimulationResult.unreachable_services:type_name -> analysis.v1.UnreachableService
+	8,  // 6: analysis.v1.FailureSimulationResult.critical_paths_to_target:type_name -> analysis.v1.BrokenPath
+	3,  // 7: analysis.v1.FailureSimulationResult.recommendations:type_name -> analysis.v1.Recommendation
+	10, // 8: analysis.v1.FailureSimulationResult.slo_breaches:type_name -> analysis.v1.SLOBreach
+	12, // 9: analysis.v1.ScalingModel.calibration:type_name -> analysis.v1.ModelCalibration
+	11, // 10: analysis.v1.ScalingSimulationRequest.model:type_name -> analysis.v1.ScalingModel
+	0,  // 11: analysis.v1.ScalingSimulationResult.target:type_name -> analysis.v1.ServiceRef
+	1,  // 12: analysis.v1.ScalingSimulationResult.neighborhood:type_name -> analysis.v1.NeighborhoodMeta
+	2,  // 13: analysis.v1.ScalingSimulationResult.data_freshness:type_name -> analysis.v1.DataFreshness
+	11, // 14: analysis.v1.ScalingSimulationResult.scaling_model:type_name -> analysis.v1.ScalingModel
+	15, // 15: analysis.v1.ScalingSimulationResult.latency_estimate:type_name -> analysis.v1.ScalingLatencyEstimate
+	16, // 16: analysis.v1.ScalingSimulationResult.affected_callers:type_name -> analysis.v1.AffectedCallerScaling
+	17, // 17: analysis.v1.ScalingSimulationResult.affected_paths:type_name -> analysis.v1.AffectedPathScaling
+	3,  // 18: analysis.v1.ScalingSimulationResult.recommendations:type_name -> analysis.v1.Recommendation
+	14, // 19: analysis.v1.ScalingSimulationResult.baseline:type_name -> analysis.v1.ScalingBaseline
+	10, // 20: analysis.v1.ScalingSimulationResult.slo_breaches:type_name -> analysis.v1.SLOBreach
+	19, // 21: analysis.v1.AddSimulationRequest.dependencies:type_name -> analysis.v1.DependencyRef
+	21, // 22: analysis.v1.AddSimulationResult.suitable_nodes:type_name -> analysis.v1.NodeCapacity
+	22, // 23: analysis.v1.AddSimulationResult.risk_analysis:type_name -> analysis.v1.AddRiskAnalysis
+	3,  // 24: analysis.v1.AddSimulationResult.recommendations:type_name -> analysis.v1.Recommendation
+	23, // 25: analysis.v1.AddSimulationResult.distribution:type_name -> analysis.v1.PlacementDistribution
+	26, // 26: analysis.v1.GetTopRiskResponse.services:type_name -> analysis.v1.RiskService
+	2,  // 27: analysis.v1.GetTopRiskResponse.data_freshness:type_name -> analysis.v1.DataFreshness
+	29, // 28: analysis.v1.NodePlacement.pods:type_name -> analysis.v1.PodInfo
+	30, // 29: analysis.v1.ServiceInfo.placement:type_name -> analysis.v1.NodePlacement
+	31, // 30: analysis.v1.ListServicesResponse.services:type_name -> analysis.v1.ServiceInfo
+	34, // 31: analysis.v1.DependencySnapshotChunk.node:type_name -> analysis.v1.SnapshotNode
+	35, // 32: analysis.v1.DependencySnapshotChunk.edge:type_name -> analysis.v1.SnapshotEdge
+	36, // 33: analysis.v1.DependencySnapshotChunk.metadata:type_name -> analysis.v1.SnapshotMetadata
+	41, // 34: analysis.v1.DecisionRecord.scenario:type_name -> google.protobuf.Value
+	41, // 35: analysis.v1.DecisionRecord.result:type_name -> google.protobuf.Value
+	39, // 36: analysis.v1.GetDecisionHistoryResponse.decisions:type_name -> analysis.v1.DecisionRecord
+	4,  // 37: analysis.v1.AnalysisService.SimulateFailure:input_type -> analysis.v1.FailureSimulationRequest
+	13, // 38: analysis.v1.AnalysisService.SimulateScaling:input_type -> analysis.v1.ScalingSimulationRequest
+	20, // 39: analysis.v1.AnalysisService.SimulateAdd:input_type -> analysis.v1.AddSimulationRequest
+	25, // 40: analysis.v1.AnalysisService.GetTopRisk:input_type -> analysis.v1.GetTopRiskRequest
+	28, // 41: analysis.v1.AnalysisService.ListServices:input_type -> analysis.v1.ListServicesRequest
+	33, // 42: analysis.v1.AnalysisService.StreamDependencySnapshot:input_type -> analysis.v1.StreamDependencySnapshotRequest
+	38, // 43: analysis.v1.AnalysisService.GetDecisionHistory:input_type -> analysis.v1.GetDecisionHistoryRequest
+	9,  // 44: analysis.v1.AnalysisService.SimulateFailure:output_type -> analysis.v1.FailureSimulationResult
+	18, // 45: analysis.v1.AnalysisService.SimulateScaling:output_type -> analysis.v1.ScalingSimulationResult
+	24, // 46: analysis.v1.AnalysisService.SimulateAdd:output_type -> analysis.v1.AddSimulationResult
+	27, // 47: analysis.v1.AnalysisService.GetTopRisk:output_type -> analysis.v1.GetTopRiskResponse
+	32, // 48: analysis.v1.AnalysisService.ListServices:output_type -> analysis.v1.ListServicesResponse
+	37, // 49: analysis.v1.AnalysisService.StreamDependencySnapshot:output_type -> analysis.v1.DependencySnapshotChunk
+	40, // 50: analysis.v1.AnalysisService.GetDecisionHistory:output_type -> analysis.v1.GetDecisionHistoryResponse
+	44, // [44:51] is the sub-list for method output_type
+	37, // [37:44] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
+}
+
+func init() { file_analysis_v1_analysis_proto_init() }
+func file_analysis_v1_analysis_proto_init() {
+	if File_analysis_v1_analysis_proto != nil {
+		return
+	}
+	file_analysis_v1_analysis_proto_msgTypes[11].OneofWrappers = []any{}
+	file_analysis_v1_analysis_proto_msgTypes[15].OneofWrappers = []any{}
+	file_analysis_v1_analysis_proto_msgTypes[16].OneofWrappers = []any{}
+	file_analysis_v1_analysis_proto_msgTypes[17].OneofWrappers = []any{}
+	file_analysis_v1_analysis_proto_msgTypes[34].OneofWrappers = []any{}
+	file_analysis_v1_analysis_proto_msgTypes[36].OneofWrappers = []any{}
+	file_analysis_v1_analysis_proto_msgTypes[37].OneofWrappers = []any{
+		(*DependencySnapshotChunk_Node)(nil),
+		(*DependencySnapshotChunk_Edge)(nil),
+		(*DependencySnapshotChunk_Metadata)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_analysis_v1_analysis_proto_rawDesc), len(file_analysis_v1_analysis_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   41,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_analysis_v1_analysis_proto_goTypes,
+		DependencyIndexes: file_analysis_v1_analysis_proto_depIdxs,
+		MessageInfos:      file_analysis_v1_analysis_proto_msgTypes,
+	}.Build()
+	File_analysis_v1_analysis_proto = out.File
+	file_analysis_v1_analysis_proto_goTypes = nil
+	file_analysis_v1_analysis_proto_depIdxs = nil
+}
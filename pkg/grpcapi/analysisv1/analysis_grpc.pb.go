@@ -0,0 +1,363 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: analysis/v1/analysis.proto
+
+package analysisv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AnalysisService_SimulateFailure_FullMethodName          = "/analysis.v1.AnalysisService/SimulateFailure"
+	AnalysisService_SimulateScaling_FullMethodName          = "/analysis.v1.AnalysisService/SimulateScaling"
+	AnalysisService_SimulateAdd_FullMethodName              = "/analysis.v1.AnalysisService/SimulateAdd"
+	AnalysisService_GetTopRisk_FullMethodName               = "/analysis.v1.AnalysisService/GetTopRisk"
+	AnalysisService_ListServices_FullMethodName             = "/analysis.v1.AnalysisService/ListServices"
+	AnalysisService_StreamDependencySnapshot_FullMethodName = "/analysis.v1.AnalysisService/StreamDependencySnapshot"
+	AnalysisService_GetDecisionHistory_FullMethodName       = "/analysis.v1.AnalysisService/GetDecisionHistory"
+)
+
+// AnalysisServiceClient is the client API for AnalysisService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AnalysisService exposes the engine's simulations and graph queries over gRPC.
+// Messages mirror the JSON types in pkg/simulation; unset optional latency
+// fields correspond to null values in the REST API.
+type AnalysisServiceClient interface {
+	SimulateFailure(ctx context.Context, in *FailureSimulationRequest, opts ...grpc.CallOption) (*FailureSimulationResult, error)
+	SimulateScaling(ctx context.Context, in *ScalingSimulationRequest, opts ...grpc.CallOption) (*ScalingSimulationResult, error)
+	SimulateAdd(ctx context.Context, in *AddSimulationRequest, opts ...grpc.CallOption) (*AddSimulationResult, error)
+	GetTopRisk(ctx context.Context, in *GetTopRiskRequest, opts ...grpc.CallOption) (*GetTopRiskResponse, error)
+	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error)
+	// Streams the dependency snapshot as nodes, then edges, then a final metadata chunk.
+	StreamDependencySnapshot(ctx context.Context, in *StreamDependencySnapshotRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DependencySnapshotChunk], error)
+	GetDecisionHistory(ctx context.Context, in *GetDecisionHistoryRequest, opts ...grpc.CallOption) (*GetDecisionHistoryResponse, error)
+}
+
+type analysisServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnalysisServiceClient(cc grpc.ClientConnInterface) AnalysisServiceClient {
+	return &analysisServiceClient{cc}
+}
+
+func (c *analysisServiceClient) SimulateFailure(ctx context.Context, in *FailureSimulationRequest, opts ...grpc.CallOption) (*FailureSimulationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FailureSimulationResult)
+	err := c.cc.Invoke(ctx, AnalysisService_SimulateFailure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) SimulateScaling(ctx context.Context, in *ScalingSimulationRequest, opts ...grpc.CallOption) (*ScalingSimulationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScalingSimulationResult)
+	err := c.cc.Invoke(ctx, AnalysisService_SimulateScaling_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) SimulateAdd(ctx context.Context, in *AddSimulationRequest, opts ...grpc.CallOption) (*AddSimulationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddSimulationResult)
+	err := c.cc.Invoke(ctx, AnalysisService_SimulateAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) GetTopRisk(ctx context.Context, in *GetTopRiskRequest, opts ...grpc.CallOption) (*GetTopRiskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopRiskResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_GetTopRisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServicesResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_ListServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) StreamDependencySnapshot(ctx context.Context, in *StreamDependencySnapshotRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DependencySnapshotChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AnalysisService_ServiceDesc.Streams[0], AnalysisService_StreamDependencySnapshot_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamDependencySnapshotRequest, DependencySnapshotChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AnalysisService_StreamDependencySnapshotClient = grpc.ServerStreamingClient[DependencySnapshotChunk]
+
+func (c *analysisServiceClient) GetDecisionHistory(ctx context.Context, in *GetDecisionHistoryRequest, opts ...grpc.CallOption) (*GetDecisionHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDecisionHistoryResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_GetDecisionHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnalysisServiceServer is the server API for AnalysisService service.
+// All implementations must embed UnimplementedAnalysisServiceServer
+// for forward compatibility.
+//
+// AnalysisService exposes the engine's simulations and graph queries over gRPC.
+// Messages mirror the JSON types in pkg/simulation; unset optional latency
+// fields correspond to null values in the REST API.
+type AnalysisServiceServer interface {
+	SimulateFailure(context.Context, *FailureSimulationRequest) (*FailureSimulationResult, error)
+	SimulateScaling(context.Context, *ScalingSimulationRequest) (*ScalingSimulationResult, error)
+	SimulateAdd(context.Context, *AddSimulationRequest) (*AddSimulationResult, error)
+	GetTopRisk(context.Context, *GetTopRiskRequest) (*GetTopRiskResponse, error)
+	ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error)
+	// Streams the dependency snapshot as nodes, then edges, then a final metadata chunk.
+	StreamDependencySnapshot(*StreamDependencySnapshotRequest, grpc.ServerStreamingServer[DependencySnapshotChunk]) error
+	GetDecisionHistory(context.Context, *GetDecisionHistoryRequest) (*GetDecisionHistoryResponse, error)
+	mustEmbedUnimplementedAnalysisServiceServer()
+}
+
+// UnimplementedAnalysisServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnalysisServiceServer struct{}
+
+func (UnimplementedAnalysisServiceServer) SimulateFailure(context.Context, *FailureSimulationRequest) (*FailureSimulationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateFailure not implemented")
+}
+func (UnimplementedAnalysisServiceServer) SimulateScaling(context.Context, *ScalingSimulationRequest) (*ScalingSimulationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateScaling not implemented")
+}
+func (UnimplementedAnalysisServiceServer) SimulateAdd(context.Context, *AddSimulationRequest) (*AddSimulationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAdd not implemented")
+}
+func (UnimplementedAnalysisServiceServer) GetTopRisk(context.Context, *GetTopRiskRequest) (*GetTopRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopRisk not implemented")
+}
+func (UnimplementedAnalysisServiceServer) ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServices not implemented")
+}
+func (UnimplementedAnalysisServiceServer) StreamDependencySnapshot(*StreamDependencySnapshotRequest, grpc.ServerStreamingServer[DependencySnapshotChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDependencySnapshot not implemented")
+}
+func (UnimplementedAnalysisServiceServer) GetDecisionHistory(context.Context, *GetDecisionHistoryRequest) (*GetDecisionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDecisionHistory not implemented")
+}
+func (UnimplementedAnalysisServiceServer) mustEmbedUnimplementedAnalysisServiceServer() {}
+func (UnimplementedAnalysisServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeAnalysisServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnalysisServiceServer will
+// result in compilation errors.
+type UnsafeAnalysisServiceServer interface {
+	mustEmbedUnimplementedAnalysisServiceServer()
+}
+
+func RegisterAnalysisServiceServer(s grpc.ServiceRegistrar, srv AnalysisServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAnalysisServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AnalysisService_ServiceDesc, srv)
+}
+
+func _AnalysisService_SimulateFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailureSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).SimulateFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_SimulateFailure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).SimulateFailure(ctx, req.(*FailureSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_SimulateScaling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScalingSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).SimulateScaling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_SimulateScaling_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).SimulateScaling(ctx, req.(*ScalingSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_SimulateAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).SimulateAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_SimulateAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).SimulateAdd(ctx, req.(*AddSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_GetTopRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).GetTopRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_GetTopRisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).GetTopRisk(ctx, req.(*GetTopRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_ListServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).ListServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_ListServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).ListServices(ctx, req.(*ListServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_StreamDependencySnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDependencySnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AnalysisServiceServer).StreamDependencySnapshot(m, &grpc.GenericServerStream[StreamDependencySnapshotRequest, DependencySnapshotChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AnalysisService_StreamDependencySnapshotServer = grpc.ServerStreamingServer[DependencySnapshotChunk]
+
+func _AnalysisService_GetDecisionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDecisionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).GetDecisionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_GetDecisionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).GetDecisionHistory(ctx, req.(*GetDecisionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnalysisService_ServiceDesc is the grpc.ServiceDesc for AnalysisService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnalysisService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "analysis.v1.AnalysisService",
+	HandlerType: (*AnalysisServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SimulateFailure",
+			Handler:    _AnalysisService_SimulateFailure_Handler,
+		},
+		{
+			MethodName: "SimulateScaling",
+			Handler:    _AnalysisService_SimulateScaling_Handler,
+		},
+		{
+			MethodName: "SimulateAdd",
+			Handler:    _AnalysisService_SimulateAdd_Handler,
+		},
+		{
+			MethodName: "GetTopRisk",
+			Handler:    _AnalysisService_GetTopRisk_Handler,
+		},
+		{
+			MethodName: "ListServices",
+			Handler:    _AnalysisService_ListServices_Handler,
+		},
+		{
+			MethodName: "GetDecisionHistory",
+			Handler:    _AnalysisService_GetDecisionHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamDependencySnapshot",
+			Handler:       _AnalysisService_StreamDependencySnapshot_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "analysis/v1/analysis.proto",
+}
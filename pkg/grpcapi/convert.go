@@ -0,0 +1,380 @@
+package grpcapi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/grpcapi/analysisv1"
+	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/snapshot"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+func toServiceRef(ref simulation.ServiceRef) *analysisv1.ServiceRef {
+	return &analysisv1.ServiceRef{
+		ServiceId: ref.ServiceId,
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	}
+}
+
+func toNeighborhoodMeta(m simulation.NeighborhoodMeta) *analysisv1.NeighborhoodMeta {
+	return &analysisv1.NeighborhoodMeta{
+		Description:  m.Description,
+		ServiceCount: int32(m.ServiceCount),
+		EdgeCount:    int32(m.EdgeCount),
+		DepthUsed:    int32(m.DepthUsed),
+		GeneratedAt:  m.GeneratedAt,
+	}
+}
+
+func toDataFreshness(df *simulation.DataFreshness) *analysisv1.DataFreshness {
+	if df == nil {
+		return nil
+	}
+	return &analysisv1.DataFreshness{
+		Source:                df.Source,
+		Stale:                 df.Stale,
+		LastUpdatedSecondsAgo: int32(df.LastUpdatedSecondsAgo),
+		WindowMinutes:         int32(df.WindowMinutes),
+	}
+}
+
+func toRecommendations(recs []simulation.FailureRecommendation) []*analysisv1.Recommendation {
+	out := make([]*analysisv1.Recommendation, 0, len(recs))
+	for _, r := range recs {
+		out = append(out, &analysisv1.Recommendation{
+			Type:        r.Type,
+			Priority:    r.Priority,
+			Target:      r.Target,
+			Reason:      r.Reason,
+			Action:      r.Action,
+			Description: r.Description,
+		})
+	}
+	return out
+}
+
+func toSLOBreaches(breaches []simulation.SLOBreach) []*analysisv1.SLOBreach {
+	out := make([]*analysisv1.SLOBreach, 0, len(breaches))
+	for _, b := range breaches {
+		out = append(out, &analysisv1.SLOBreach{
+			SloId:     b.SLOID,
+			Name:      b.Name,
+			ServiceId: b.ServiceID,
+			Objective: b.Objective,
+			Target:    b.Target,
+			Projected: b.Projected,
+			Reason:    b.Reason,
+		})
+	}
+	return out
+}
+
+func toFailureResult(res *simulation.FailureSimulationResult) *analysisv1.FailureSimulationResult {
+	out := &analysisv1.FailureSimulationResult{
+		Target:              toServiceRef(res.Target),
+		Neighborhood:        toNeighborhoodMeta(res.Neighborhood),
+		DataFreshness:       toDataFreshness(res.DataFreshness),
+		Confidence:          res.Confidence,
+		Explanation:         res.Explanation,
+		TotalLostTrafficRps: res.TotalLostTrafficRps,
+		Recommendations:     toRecommendations(res.Recommendations),
+		SloBreaches:         toSLOBreaches(res.SLOBreaches),
+	}
+	for _, c := range res.AffectedCallers {
+		out.AffectedCallers = append(out.AffectedCallers, &analysisv1.AffectedCaller{
+			ServiceId:      c.ServiceId,
+			Name:           c.Name,
+			Namespace:      c.Namespace,
+			LostTrafficRps: c.LostTrafficRps,
+			EdgeErrorRate:  c.EdgeErrorRate,
+		})
+	}
+	for _, d := range res.AffectedDownstream {
+		out.AffectedDownstream = append(out.AffectedDownstream, &analysisv1.AffectedDownstream{
+			ServiceId:      d.ServiceId,
+			Name:           d.Name,
+			Namespace:      d.Namespace,
+			LostTrafficRps: d.LostTrafficRps,
+			EdgeErrorRate:  d.EdgeErrorRate,
+		})
+	}
+	for _, u := range res.UnreachableServices {
+		out.UnreachableServices = append(out.UnreachableServices, &analysisv1.UnreachableService{
+			ServiceId:                u.ServiceId,
+			Name:                     u.Name,
+			Namespace:                u.Namespace,
+			LostTrafficRps:           u.LostTrafficRps,
+			LostFromTargetRps:        u.LostFromTargetRps,
+			LostFromReachableCutsRps: u.LostFromReachableCutsRps,
+		})
+	}
+	for _, p := range res.CriticalPaths {
+		out.CriticalPathsToTarget = append(out.CriticalPathsToTarget, &analysisv1.BrokenPath{
+			Path:    p.Path,
+			PathRps: p.PathRps,
+		})
+	}
+	return out
+}
+
+func toScalingResult(res *simulation.ScalingSimulationResult) *analysisv1.ScalingSimulationResult {
+	out := &analysisv1.ScalingSimulationResult{
+		Target:        toServiceRef(res.Target),
+		Neighborhood:  toNeighborhoodMeta(res.Neighborhood),
+		DataFreshness: toDataFreshness(res.DataFreshness),
+		Confidence:    res.Confidence,
+		Explanation:   res.Explanation,
+		Warnings:      res.Warnings,
+		LatencyMetric: res.LatencyMetric,
+		ScalingModel:  toScalingModel(res.ScalingModel),
+		CurrentPods:   int32(res.CurrentPods),
+		NewPods:       int32(res.NewPods),
+		LatencyEstimate: &analysisv1.ScalingLatencyEstimate{
+			Description: res.LatencyEstimate.Description,
+			BaselineMs:  res.LatencyEstimate.BaselineMs,
+			ProjectedMs: res.LatencyEstimate.ProjectedMs,
+			DeltaMs:     res.LatencyEstimate.DeltaMs,
+			Unit:        res.LatencyEstimate.Unit,
+		},
+		ScalingDirection: res.ScalingDirection,
+		Recommendations:  toRecommendations(res.Recommendations),
+		SloBreaches:      toSLOBreaches(res.SLOBreaches),
+	}
+	if b := res.Baseline; b != nil {
+		out.Baseline = &analysisv1.ScalingBaseline{
+			Source:        b.Source,
+			At:            b.At,
+			ForecastEdges: int32(b.ForecastEdges),
+			CurrentEdges:  int32(b.CurrentEdges),
+		}
+	}
+	for _, c := range res.AffectedCallers.Items {
+		out.AffectedCallers = append(out.AffectedCallers, &analysisv1.AffectedCallerScaling{
+			ServiceId:        c.ServiceId,
+			Name:             c.Name,
+			Namespace:        c.Namespace,
+			HopDistance:      int32(c.HopDistance),
+			BeforeMs:         c.BeforeMs,
+			AfterMs:          c.AfterMs,
+			DeltaMs:          c.DeltaMs,
+			EndToEndBeforeMs: c.EndToEndBeforeMs,
+			EndToEndAfterMs:  c.EndToEndAfterMs,
+			EndToEndDeltaMs:  c.EndToEndDeltaMs,
+			ViaPath:          c.ViaPath,
+		})
+	}
+	for _, p := range res.AffectedPaths {
+		out.AffectedPaths = append(out.AffectedPaths, &analysisv1.AffectedPathScaling{
+			Path:           p.Path,
+			PathRps:        p.PathRps,
+			BeforeMs:       p.BeforeMs,
+			AfterMs:        p.AfterMs,
+			DeltaMs:        p.DeltaMs,
+			IncompleteData: p.IncompleteData,
+		})
+	}
+	return out
+}
+
+func toAddResult(res *simulation.AddSimulationResult) *analysisv1.AddSimulationResult {
+	out := &analysisv1.AddSimulationResult{
+		TargetServiceName: res.TargetServiceName,
+		Success:           res.Success,
+		Confidence:        res.Confidence,
+		Explanation:       res.Explanation,
+		TotalCapacityPods: int32(res.TotalCapacityPods),
+		RiskAnalysis: &analysisv1.AddRiskAnalysis{
+			DependencyRisk: res.RiskAnalysis.DependencyRisk,
+			Description:    res.RiskAnalysis.Description,
+		},
+		Recommendations: toRecommendations(res.Recommendations),
+	}
+	for _, n := range res.SuitableNodes {
+		out.SuitableNodes = append(out.SuitableNodes, &analysisv1.NodeCapacity{
+			Node:           n.Node,
+			CpuAvailable:   n.CPUAvailable,
+			RamAvailableMb: n.RAMAvailableMB,
+			CpuTotal:       n.CPUTotal,
+			RamTotalMb:     n.RAMTotalMB,
+			CanFit:         n.CanFit,
+			MaxPods:        int32(n.MaxPods),
+			Score:          int32(n.Score),
+			Suitable:       n.Suitable,
+			Reason:         n.Reason,
+		})
+	}
+	if res.Recommendation != nil {
+		for _, d := range res.Recommendation.Distribution {
+			out.Distribution = append(out.Distribution, &analysisv1.PlacementDistribution{
+				Node:     d.Node,
+				Replicas: int32(d.Replicas),
+			})
+		}
+	}
+	return out
+}
+
+func toTopRiskResponse(res *graph.TopCentralityResponse) *analysisv1.GetTopRiskResponse {
+	out := &analysisv1.GetTopRiskResponse{
+		Metric: res.Metric,
+		DataFreshness: &analysisv1.DataFreshness{
+			Source:                res.DataFreshness.Source,
+			Stale:                 res.DataFreshness.Stale,
+			LastUpdatedSecondsAgo: int32(res.DataFreshness.LastUpdatedSecondsAgo),
+			WindowMinutes:         int32(res.DataFreshness.WindowMinutes),
+		},
+		Confidence: res.Confidence,
+	}
+	for _, s := range res.Services {
+		out.Services = append(out.Services, &analysisv1.RiskService{
+			ServiceId:       s.ServiceId,
+			Name:            s.Name,
+			Namespace:       s.Namespace,
+			CentralityScore: s.CentralityScore,
+			RiskLevel:       s.RiskLevel,
+			Explanation:     s.Explanation,
+		})
+	}
+	return out
+}
+
+func toListServicesResponse(services []graph.ServiceInfo) *analysisv1.ListServicesResponse {
+	out := &analysisv1.ListServicesResponse{}
+	for _, s := range services {
+		info := &analysisv1.ServiceInfo{
+			ServiceId:    fmt.Sprintf("%s:%s", s.Namespace, s.Name),
+			Name:         s.Name,
+			Namespace:    s.Namespace,
+			PodCount:     int32(s.PodCount),
+			Availability: s.Availability,
+		}
+		for _, n := range s.Placement.Nodes {
+			placement := &analysisv1.NodePlacement{
+				Node:            n.Node,
+				CpuUsagePercent: n.Resources.CPU.UsagePercent,
+				CpuCores:        int32(n.Resources.CPU.Cores),
+				RamUsedMb:       n.Resources.RAM.UsedMB,
+				RamTotalMb:      n.Resources.RAM.TotalMB,
+			}
+			for _, p := range n.Pods {
+				placement.Pods = append(placement.Pods, &analysisv1.PodInfo{
+					Name:            p.Name,
+					RamUsedMb:       p.RAMUsedMB,
+					CpuUsagePercent: p.CPUUsagePercent,
+					UptimeSeconds:   int32(p.UptimeSeconds),
+				})
+			}
+			info.Placement = append(info.Placement, placement)
+		}
+		out.Services = append(out.Services, info)
+	}
+	return out
+}
+
+func toSnapshotNode(n snapshot.Node) *analysisv1.SnapshotNode {
+	var podCount *int32
+	if n.PodCount != nil {
+		v := int32(*n.PodCount)
+		podCount = &v
+	}
+	return &analysisv1.SnapshotNode{
+		Id:              n.ID,
+		Name:            n.Name,
+		Namespace:       n.Namespace,
+		RiskLevel:       n.RiskLevel,
+		RiskReason:      n.RiskReason,
+		ReqRate:         n.ReqRate,
+		ErrorRatePct:    n.ErrorRatePct,
+		LatencyP95Ms:    n.LatencyP95Ms,
+		AvailabilityPct: n.AvailabilityPct,
+		PodCount:        podCount,
+		Availability:    n.Availability,
+		PageRank:        n.PageRank,
+		Betweenness:     n.Betweenness,
+		UpdatedAt:       n.UpdatedAt,
+	}
+}
+
+func toSnapshotEdge(e snapshot.Edge) *analysisv1.SnapshotEdge {
+	return &analysisv1.SnapshotEdge{
+		Id:           e.ID,
+		Source:       e.Source,
+		Target:       e.Target,
+		ReqRate:      e.ReqRate,
+		LatencyP95Ms: e.LatencyP95Ms,
+	}
+}
+
+func toSnapshotMetadata(m snapshot.Metadata) *analysisv1.SnapshotMetadata {
+	var lastUpdated *int32
+	if m.LastUpdatedSecondsAgo != nil {
+		v := int32(*m.LastUpdatedSecondsAgo)
+		lastUpdated = &v
+	}
+	return &analysisv1.SnapshotMetadata{
+		Stale:                 m.Stale,
+		LastUpdatedSecondsAgo: lastUpdated,
+		WindowMinutes:         int32(m.WindowMinutes),
+		NodeCount:             int32(m.NodeCount),
+		EdgeCount:             int32(m.EdgeCount),
+		NodesWithMetrics:      int32(m.NodesWithMetrics),
+		EdgesWithMetrics:      int32(m.EdgesWithMetrics),
+		GeneratedAt:           m.GeneratedAt,
+	}
+}
+
+// toValue round-trips decoded JSON through encoding/json so that any stored
+// scenario or result shape maps onto a google.protobuf.Value.
+func toValue(v interface{}) (*structpb.Value, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+	return structpb.NewValue(generic)
+}
+
+func toDecisionRecord(rec storage.DecisionRecord) (*analysisv1.DecisionRecord, error) {
+	scenario, err := toValue(rec.Scenario)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert scenario: %w", err)
+	}
+	result, err := toValue(rec.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert result: %w", err)
+	}
+	return &analysisv1.DecisionRecord{
+		Id:            rec.ID,
+		Timestamp:     rec.Timestamp,
+		Type:          rec.Type,
+		Scenario:      scenario,
+		Result:        result,
+		CorrelationId: rec.CorrelationID,
+		CreatedAt:     rec.CreatedAt,
+	}, nil
+}
+
+func toScalingModel(m simulation.ScalingModel) *analysisv1.ScalingModel {
+	out := &analysisv1.ScalingModel{
+		Type:             m.Type,
+		Alpha:            m.Alpha,
+		MinLatencyFactor: m.MinLatencyFactor,
+		Source:           m.Source,
+	}
+	if m.Calibration != nil {
+		out.Calibration = &analysisv1.ModelCalibration{
+			Samples:  int32(m.Calibration.Samples),
+			Rmse:     m.Calibration.RMSE,
+			FittedAt: m.Calibration.FittedAt,
+		}
+	}
+	return out
+}
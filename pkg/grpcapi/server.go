@@ -0,0 +1,286 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"predictive-analysis-engine/pkg/analysis"
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/grpcapi/analysisv1"
+	"predictive-analysis-engine/pkg/logger"
+	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/snapshot"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+// Server implements analysisv1.AnalysisServiceServer on top of the same
+// simulation service, graph client and decision store used by the REST API.
+type Server struct {
+	analysisv1.UnimplementedAnalysisServiceServer
+
+	GraphClient       *graph.Client
+	SimulationService *simulation.Service
+	Store             storage.DecisionReader
+}
+
+func NewServer(graphClient *graph.Client, simService *simulation.Service, store storage.DecisionReader) *grpc.Server {
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(unaryCorrelationInterceptor),
+		grpc.StreamInterceptor(streamCorrelationInterceptor),
+	)
+	analysisv1.RegisterAnalysisServiceServer(srv, &Server{
+		GraphClient:       graphClient,
+		SimulationService: simService,
+		Store:             store,
+	})
+	return srv
+}
+
+func (s *Server) SimulateFailure(ctx context.Context, req *analysisv1.FailureSimulationRequest) (*analysisv1.FailureSimulationResult, error) {
+	result, err := s.SimulationService.RunFailureSimulation(ctx, simulation.FailureSimulationRequest{
+		ServiceId: req.GetServiceId(),
+		Depth:     int(req.GetDepth()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toFailureResult(result), nil
+}
+
+func (s *Server) SimulateScaling(ctx context.Context, req *analysisv1.ScalingSimulationRequest) (*analysisv1.ScalingSimulationResult, error) {
+	simReq := simulation.ScalingSimulationRequest{
+		ServiceId:     req.GetServiceId(),
+		CurrentPods:   int(req.GetCurrentPods()),
+		NewPods:       int(req.GetNewPods()),
+		LatencyMetric: req.GetLatencyMetric(),
+		MaxDepth:      int(req.GetMaxDepth()),
+		TimeWindow:    req.GetTimeWindow(),
+		Baseline:      req.GetBaseline(),
+	}
+	if m := req.GetModel(); m != nil {
+		simReq.Model = &simulation.ScalingModel{Type: m.GetType(), Alpha: m.Alpha, MinLatencyFactor: m.MinLatencyFactor}
+	}
+
+	result, err := s.SimulationService.RunScalingSimulation(ctx, simReq)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toScalingResult(result), nil
+}
+
+func (s *Server) SimulateAdd(ctx context.Context, req *analysisv1.AddSimulationRequest) (*analysisv1.AddSimulationResult, error) {
+	simReq := simulation.AddSimulationRequest{
+		ServiceName: req.GetServiceName(),
+		CPURequest:  req.GetCpuRequest(),
+		RAMRequest:  int(req.GetRamRequest()),
+		Replicas:    int(req.GetReplicas()),
+		TimeWindow:  req.GetTimeWindow(),
+	}
+	for _, dep := range req.GetDependencies() {
+		simReq.Dependencies = append(simReq.Dependencies, simulation.DependencyRef{ServiceId: dep.GetServiceId()})
+	}
+	if simReq.CPURequest <= 0 || simReq.RAMRequest <= 0 || simReq.Replicas <= 0 {
+		return nil, toStatus(common.NewError(common.CodeInvalidResourceRequest, "Invalid resource requests: cpu, ram, and replicas must be positive"))
+	}
+
+	result, err := s.SimulationService.RunAddSimulation(ctx, simReq)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toAddResult(result), nil
+}
+
+func (s *Server) GetTopRisk(ctx context.Context, req *analysisv1.GetTopRiskRequest) (*analysisv1.GetTopRiskResponse, error) {
+	metric := req.GetMetric()
+	if metric == "" {
+		metric = "pagerank"
+	}
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = 5
+	}
+	if limit < 1 {
+		limit = 1
+	}
+	if limit > 20 {
+		limit = 20
+	}
+
+	result, err := analysis.GetTopRiskServices(ctx, s.GraphClient, metric, limit)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toTopRiskResponse(result), nil
+}
+
+func (s *Server) ListServices(ctx context.Context, _ *analysisv1.ListServicesRequest) (*analysisv1.ListServicesResponse, error) {
+	services, err := s.GraphClient.GetServices(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toListServicesResponse(services), nil
+}
+
+func (s *Server) StreamDependencySnapshot(req *analysisv1.StreamDependencySnapshotRequest, stream grpc.ServerStreamingServer[analysisv1.DependencySnapshotChunk]) error {
+	snap, err := snapshot.Build(stream.Context(), s.GraphClient, req.GetNamespace())
+	if err != nil {
+		return toStatus(err)
+	}
+
+	for _, n := range snap.Nodes {
+		chunk := &analysisv1.DependencySnapshotChunk{
+			Item: &analysisv1.DependencySnapshotChunk_Node{Node: toSnapshotNode(n)},
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+	for _, e := range snap.Edges {
+		chunk := &analysisv1.DependencySnapshotChunk{
+			Item: &analysisv1.DependencySnapshotChunk_Edge{Edge: toSnapshotEdge(e)},
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+	return stream.Send(&analysisv1.DependencySnapshotChunk{
+		Item: &analysisv1.DependencySnapshotChunk_Metadata{Metadata: toSnapshotMetadata(snap.Metadata)},
+	})
+}
+
+func (s *Server) GetDecisionHistory(ctx context.Context, req *analysisv1.GetDecisionHistoryRequest) (*analysisv1.GetDecisionHistoryResponse, error) {
+	if s.Store == nil {
+		return nil, toStatus(common.NewError(common.CodeDecisionStoreUnavailable, "Decision store not available. Check DECISION_STORE_DRIVER configuration."))
+	}
+
+	if req.GetOffset() < 0 {
+		return nil, toStatus(common.NewError(common.CodeInvalidParameter, "offset must not be negative, got %d", req.GetOffset()))
+	}
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = 50
+	}
+	if limit < 1 {
+		limit = 1
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	records, err := s.Store.GetHistory(storage.GetHistoryOptions{
+		Limit:  limit,
+		Offset: int(req.GetOffset()),
+		Type:   req.GetType(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	count, err := s.Store.GetCount(storage.GetHistoryOptions{Type: req.GetType()})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &analysisv1.GetDecisionHistoryResponse{
+		Limit:  int32(limit),
+		Offset: req.GetOffset(),
+		Total:  int32(count),
+	}
+	for _, rec := range records {
+		pbRec, err := toDecisionRecord(rec)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		resp.Decisions = append(resp.Decisions, pbRec)
+	}
+	return resp, nil
+}
+
+var grpcCodeByErrorCode = map[common.ErrorCode]codes.Code{
+	common.CodeInvalidRequestBody:       codes.InvalidArgument,
+	common.CodeValidationFailed:         codes.InvalidArgument,
+	common.CodeMissingParameter:         codes.InvalidArgument,
+	common.CodeInvalidParameter:         codes.InvalidArgument,
+	common.CodeInvalidTimestamp:         codes.InvalidArgument,
+	common.CodeTimeRangeTooLarge:        codes.InvalidArgument,
+	common.CodeDepthOutOfRange:          codes.InvalidArgument,
+	common.CodeInvalidLatencyMetric:     codes.InvalidArgument,
+	common.CodeInvalidPodCount:          codes.InvalidArgument,
+	common.CodeInvalidScalingModel:      codes.InvalidArgument,
+	common.CodeInvalidAlpha:             codes.InvalidArgument,
+	common.CodeInvalidResourceRequest:   codes.InvalidArgument,
+	common.CodeInvalidMetric:            codes.InvalidArgument,
+	common.CodeInvalidDecisionType:      codes.InvalidArgument,
+	common.CodeServiceNotFound:          codes.NotFound,
+	common.CodeNotFound:                 codes.NotFound,
+	common.CodeNoNodesFound:             codes.FailedPrecondition,
+	common.CodeUpstreamUnavailable:      codes.Unavailable,
+	common.CodeUpstreamTimeout:          codes.DeadlineExceeded,
+	common.CodeUpstreamError:            codes.Unavailable,
+	common.CodeTelemetryUnavailable:     codes.Unavailable,
+	common.CodeDecisionStoreUnavailable: codes.Unavailable,
+	common.CodeChangeWindowOpen:         codes.FailedPrecondition,
+	common.CodeNoObservedData:           codes.FailedPrecondition,
+	common.CodeInvalidTransition:        codes.FailedPrecondition,
+	common.CodeAlreadyExists:            codes.AlreadyExists,
+}
+
+// toStatus converts an application error into a gRPC status. The stable
+// error code is carried as the status message prefix, e.g. "SERVICE_NOT_FOUND: ...".
+func toStatus(err error) error {
+	var appErr *common.Error
+	if !errors.As(err, &appErr) {
+		logger.Error("Unhandled gRPC error", err)
+		return status.Error(codes.Internal, fmt.Sprintf("%s: Internal server error", common.CodeInternal))
+	}
+
+	code, ok := grpcCodeByErrorCode[appErr.Code]
+	if !ok {
+		code = codes.Internal
+	}
+	if code == codes.Internal || code == codes.Unavailable {
+		logger.Error(appErr.Message, err)
+	}
+	return status.Error(code, fmt.Sprintf("%s: %s", appErr.Code, appErr.Message))
+}
+
+func correlationContext(ctx context.Context) context.Context {
+	correlationID := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get("x-correlation-id"); len(vals) > 0 {
+			correlationID = vals[0]
+		}
+	}
+	if correlationID == "" {
+		correlationID = uuid.New().String()
+	}
+	return context.WithValue(ctx, common.CorrelationIDKey, correlationID)
+}
+
+func unaryCorrelationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx = correlationContext(ctx)
+	grpc.SetHeader(ctx, metadata.Pairs("x-correlation-id", common.GetCorrelationID(ctx)))
+	return handler(ctx, req)
+}
+
+type correlatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *correlatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func streamCorrelationInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := correlationContext(ss.Context())
+	ss.SetHeader(metadata.Pairs("x-correlation-id", common.GetCorrelationID(ctx)))
+	return handler(srv, &correlatedStream{ServerStream: ss, ctx: ctx})
+}
@@ -0,0 +1,193 @@
+// Package outcome compares what scaling decisions predicted with the latency
+// that was actually observed after the change.
+package outcome
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"predictive-analysis-engine/pkg/clients/telemetry"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+const (
+	SourceAuto   = "auto"
+	SourceManual = "manual"
+)
+
+// OutcomeRecorder records evaluated outcomes. It is satisfied by
+// storage.OutcomeStore.
+type OutcomeRecorder interface {
+	RecordOutcome(input storage.OutcomeInput) (*storage.Outcome, error)
+}
+
+// MetricsSource is the subset of the telemetry client used to read observed
+// latency.
+type MetricsSource interface {
+	CheckStatus() (bool, string)
+	GetServiceMetrics(ctx context.Context, service string, from, to string, stepSeconds int) ([]telemetry.ServiceMetric, error)
+}
+
+type Evaluator struct {
+	store     OutcomeRecorder
+	telemetry MetricsSource
+	cfg       config.OutcomeConfig
+}
+
+func NewEvaluator(cfg config.OutcomeConfig, store OutcomeRecorder, ts MetricsSource) *Evaluator {
+	return &Evaluator{store: store, telemetry: ts, cfg: cfg}
+}
+
+// Window returns the observation window for a decision made at t.
+func (e *Evaluator) Window(t time.Time) (time.Time, time.Time) {
+	start := t.Add(time.Duration(e.cfg.SettleMinutes) * time.Minute)
+	return start, start.Add(time.Duration(e.cfg.WindowMinutes) * time.Minute)
+}
+
+// ClosedBefore returns the latest decision time whose observation window has
+// closed by now.
+func (e *Evaluator) ClosedBefore(now time.Time) time.Time {
+	return now.Add(-time.Duration(e.cfg.SettleMinutes+e.cfg.WindowMinutes) * time.Minute)
+}
+
+// Evaluate queries service_metrics for the target of a scaling decision over
+// its observation window and records the mean observed latency as the
+// decision's outcome. A simulation only has an outcome if it was carried
+// out: unless the target's mean pod count in the window rounds to the
+// simulated NewPods, nothing is recorded and a CodeNoObservedData error is
+// returned.
+func (e *Evaluator) Evaluate(ctx context.Context, rec *storage.DecisionRecord) (*storage.Outcome, error) {
+	res, err := scalingResult(rec)
+	if err != nil {
+		return nil, err
+	}
+
+	decidedAt, err := time.Parse(time.RFC3339, rec.Timestamp)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, err, "Decision %d has an invalid timestamp", rec.ID)
+	}
+	from, to := e.Window(decidedAt)
+	if time.Now().Before(to) {
+		return nil, common.NewError(common.CodeChangeWindowOpen, "Observation window for decision %d closes at %s", rec.ID, to.UTC().Format(time.RFC3339))
+	}
+
+	if ok, reason := e.telemetry.CheckStatus(); !ok {
+		return nil, common.NewError(common.CodeTelemetryUnavailable, "%s", reason)
+	}
+
+	metrics, err := e.telemetry.GetServiceMetrics(ctx, res.Target.Name,
+		from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339), max(e.cfg.WindowMinutes*60, 60))
+	if err != nil {
+		return nil, common.WrapError(common.CodeUpstreamError, err, "Service metrics query failed")
+	}
+
+	var sum, podSum float64
+	var n, podSamples int
+	for _, m := range metrics {
+		if res.Target.Namespace != "" && m.Namespace != "" && m.Namespace != res.Target.Namespace {
+			continue
+		}
+		if v := latencyValue(m, res.LatencyMetric); v > 0 {
+			sum += v
+			n++
+		}
+		if m.PodCount > 0 {
+			podSum += m.PodCount
+			podSamples++
+		}
+	}
+	if n == 0 {
+		return nil, common.NewError(common.CodeNoObservedData, "No %s latency observed for %s between %s and %s",
+			res.LatencyMetric, res.Target.Name, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
+	}
+	if podSamples == 0 {
+		return nil, common.NewError(common.CodeNoObservedData, "No pod count observed for %s between %s and %s; cannot tell whether decision %d was applied",
+			res.Target.Name, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339), rec.ID)
+	}
+	if pods := math.Round(podSum / float64(podSamples)); int(pods) != res.NewPods {
+		return nil, common.NewError(common.CodeNoObservedData, "Decision %d was not applied: %s ran %.0f pods between %s and %s, not the simulated %d",
+			rec.ID, res.Target.Name, pods, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339), res.NewPods)
+	}
+
+	return e.store.RecordOutcome(storage.OutcomeInput{
+		DecisionID:  rec.ID,
+		Source:      SourceAuto,
+		Metric:      res.LatencyMetric,
+		ObservedMs:  sum / float64(n),
+		PredictedMs: res.LatencyEstimate.ProjectedMs,
+		BaselineMs:  res.LatencyEstimate.BaselineMs,
+		ModelType:   res.ScalingModel.Type,
+		WindowStart: from.UTC().Format(time.RFC3339),
+		WindowEnd:   to.UTC().Format(time.RFC3339),
+	})
+}
+
+// RecordManual attaches an operator-supplied observation to a decision. For
+// scaling decisions the prediction is copied from the stored result so the
+// outcome counts towards model accuracy.
+func (e *Evaluator) RecordManual(rec *storage.DecisionRecord, observedMs float64, metric, notes string) (*storage.Outcome, error) {
+	input := storage.OutcomeInput{
+		DecisionID: rec.ID,
+		Source:     SourceManual,
+		Metric:     metric,
+		ObservedMs: observedMs,
+		Notes:      notes,
+	}
+	if rec.Type == "scaling" {
+		res, err := scalingResult(rec)
+		if err != nil {
+			return nil, err
+		}
+		if input.Metric == "" {
+			input.Metric = res.LatencyMetric
+		}
+		if input.Metric == res.LatencyMetric {
+			input.PredictedMs = res.LatencyEstimate.ProjectedMs
+			input.BaselineMs = res.LatencyEstimate.BaselineMs
+		}
+		input.ModelType = res.ScalingModel.Type
+	}
+	if input.Metric == "" {
+		input.Metric = "p95"
+	}
+	return e.store.RecordOutcome(input)
+}
+
+func scalingResult(rec *storage.DecisionRecord) (*simulation.ScalingSimulationResult, error) {
+	if rec.Type != "scaling" {
+		return nil, common.NewError(common.CodeInvalidDecisionType, "Decision %d is a %s decision; automatic outcomes are only supported for scaling decisions", rec.ID, rec.Type)
+	}
+	var res simulation.ScalingSimulationResult
+	if err := rec.DecodeResult(&res); err != nil {
+		return nil, common.WrapError(common.CodeInternal, err, "Stored decision could not be decoded")
+	}
+	if res.Target.Name == "" {
+		return nil, common.NewError(common.CodeInternal, "Stored decision %d has no target service", rec.ID)
+	}
+	return &res, nil
+}
+
+func latencyValue(m telemetry.ServiceMetric, metric string) float64 {
+	switch metric {
+	case "p50":
+		return m.P50
+	case "p99":
+		return m.P99
+	}
+	return m.P95
+}
+
+// IsRetryable reports whether a failed evaluation may succeed later.
+func IsRetryable(err error) bool {
+	switch common.CodeOf(err) {
+	case common.CodeChangeWindowOpen, common.CodeTelemetryUnavailable, common.CodeUpstreamError:
+		return true
+	}
+	var ce *common.Error
+	return !errors.As(err, &ce)
+}
@@ -0,0 +1,162 @@
+package report
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"predictive-analysis-engine/pkg/export"
+)
+
+var contentTypes = map[string]string{
+	FormatMarkdown: "text/markdown; charset=utf-8",
+	FormatHTML:     "text/html; charset=utf-8",
+}
+
+func IsSupported(format string) bool {
+	_, ok := contentTypes[format]
+	return ok
+}
+
+func ContentType(format string) string {
+	return contentTypes[format]
+}
+
+func Render(w io.Writer, format string, r *Report) error {
+	switch format {
+	case FormatMarkdown:
+		return renderMarkdown(w, r)
+	case FormatHTML:
+		return renderHTML(w, r)
+	}
+	return fmt.Errorf("unsupported report format: %s", format)
+}
+
+var mdCell = strings.NewReplacer("|", `\|`, "\n", " ")
+
+func renderMarkdown(w io.Writer, r *Report) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "# %s\n\n", r.Title)
+	fmt.Fprintf(bw, "_%s_\n\n", r.Subtitle)
+
+	fmt.Fprintln(bw, "## Summary")
+	fmt.Fprintln(bw)
+	if r.Summary != "" {
+		fmt.Fprintf(bw, "%s\n\n", r.Summary)
+	}
+	fmt.Fprintln(bw, "| | |")
+	fmt.Fprintln(bw, "|---|---|")
+	for _, f := range r.Facts {
+		fmt.Fprintf(bw, "| **%s** | %s |\n", mdCell.Replace(f.Label), mdCell.Replace(f.Value))
+	}
+	fmt.Fprintln(bw)
+
+	if len(r.Caveats) > 0 {
+		fmt.Fprintln(bw, "## Data caveats")
+		fmt.Fprintln(bw)
+		for _, c := range r.Caveats {
+			fmt.Fprintf(bw, "> **Caveat:** %s\n>\n", c)
+		}
+		fmt.Fprintln(bw)
+	}
+
+	if r.Graph != nil && len(r.Graph.Nodes) > 0 {
+		fmt.Fprintln(bw, "## Impact graph")
+		fmt.Fprintln(bw)
+		fmt.Fprintln(bw, "```mermaid")
+		if err := export.Render(bw, export.FormatMermaid, r.Graph); err != nil {
+			return err
+		}
+		fmt.Fprintln(bw, "```")
+		fmt.Fprintln(bw)
+	}
+
+	for _, s := range r.Sections {
+		fmt.Fprintf(bw, "## %s\n\n", s.Title)
+		if len(s.Rows) == 0 {
+			fmt.Fprintf(bw, "%s\n\n", s.Empty)
+			continue
+		}
+		fmt.Fprintf(bw, "| %s |\n", strings.Join(s.Headers, " | "))
+		fmt.Fprintf(bw, "|%s\n", strings.Repeat("---|", len(s.Headers)))
+		for _, row := range s.Rows {
+			cells := make([]string, len(row))
+			for i, c := range row {
+				cells[i] = mdCell.Replace(c)
+			}
+			fmt.Fprintf(bw, "| %s |\n", strings.Join(cells, " | "))
+		}
+		fmt.Fprintln(bw)
+	}
+
+	return bw.Flush()
+}
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; margin: 2rem auto; max-width: 1100px; color: #222; line-height: 1.45; }
+h1 { margin-bottom: 0.2rem; }
+.subtitle { color: #666; margin-top: 0; }
+table { border-collapse: collapse; margin: 0.5rem 0 1.5rem; width: 100%; }
+th, td { border: 1px solid #ddd; padding: 6px 10px; text-align: left; vertical-align: top; }
+th { background: #f5f5f5; }
+table.facts th { width: 30%; }
+.caveat { background: #fff8e1; border-left: 4px solid #f9a825; padding: 8px 12px; margin: 0.5rem 0; }
+.graph { overflow-x: auto; border: 1px solid #eee; padding: 8px; }
+.empty { color: #666; font-style: italic; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p class="subtitle">{{.Subtitle}}</p>
+
+<h2>Summary</h2>
+{{if .Summary}}<p>{{.Summary}}</p>{{end}}
+<table class="facts">
+{{range .Facts}}<tr><th>{{.Label}}</th><td>{{.Value}}</td></tr>
+{{end}}</table>
+
+{{if .Caveats}}<h2>Data caveats</h2>
+{{range .Caveats}}<div class="caveat"><strong>Caveat:</strong> {{.}}</div>
+{{end}}{{end}}
+
+{{if .SVG}}<h2>Impact graph</h2>
+<div class="graph">{{.SVG}}</div>
+{{end}}
+
+{{range .Sections}}<h2>{{.Title}}</h2>
+{{if .Rows}}<table>
+<tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr>
+{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</table>
+{{else}}<p class="empty">{{.Empty}}</p>
+{{end}}{{end}}
+</body>
+</html>
+`))
+
+func renderHTML(w io.Writer, r *Report) error {
+	data := struct {
+		*Report
+		SVG template.HTML
+	}{Report: r}
+
+	if r.Graph != nil && len(r.Graph.Nodes) > 0 {
+		var buf bytes.Buffer
+		if err := export.RenderSVG(&buf, r.Graph); err != nil {
+			return err
+		}
+		// The SVG is generated by export.RenderSVG, which escapes all labels.
+		data.SVG = template.HTML(buf.String())
+	}
+
+	return htmlTemplate.Execute(w, data)
+}
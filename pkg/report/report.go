@@ -0,0 +1,232 @@
+// Package report renders stored simulation decisions as standalone Markdown
+// or HTML documents for change-review boards.
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"predictive-analysis-engine/pkg/export"
+	"predictive-analysis-engine/pkg/simulation"
+)
+
+const (
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+// Report is the format-independent content of a rendered report.
+type Report struct {
+	Title    string
+	Subtitle string
+	Summary  string
+	Facts    []Fact
+	Caveats  []string
+	Graph    *export.Graph
+	Sections []Section
+}
+
+type Fact struct {
+	Label string
+	Value string
+}
+
+type Section struct {
+	Title   string
+	Headers []string
+	Rows    [][]string
+	// Empty is shown instead of the table when there are no rows.
+	Empty string
+}
+
+// Meta identifies the stored decision a report was generated from.
+type Meta struct {
+	DecisionID    int64
+	Timestamp     string
+	CorrelationID string
+}
+
+func (m Meta) subtitle() string {
+	s := fmt.Sprintf("Decision #%d recorded %s", m.DecisionID, m.Timestamp)
+	if m.CorrelationID != "" {
+		s += fmt.Sprintf(" (correlation ID %s)", m.CorrelationID)
+	}
+	return s
+}
+
+func Failure(meta Meta, res *simulation.FailureSimulationResult) *Report {
+	r := &Report{
+		Title:    fmt.Sprintf("Failure impact report: %s", serviceLabel(res.Target)),
+		Subtitle: meta.subtitle(),
+		Summary:  res.Explanation,
+		Facts: []Fact{
+			{"Target", serviceLabel(res.Target)},
+			{"Total lost traffic", formatRps(res.TotalLostTrafficRps)},
+			{"Affected callers", fmt.Sprint(len(res.AffectedCallers))},
+			{"Affected downstream services", fmt.Sprint(len(res.AffectedDownstream))},
+			{"Unreachable services", fmt.Sprint(len(res.UnreachableServices))},
+			{"Confidence", res.Confidence},
+			{"Neighborhood", fmt.Sprintf("%d services, %d edges, depth %d", res.Neighborhood.ServiceCount, res.Neighborhood.EdgeCount, res.Neighborhood.DepthUsed)},
+		},
+		Caveats: freshnessCaveats(res.DataFreshness, res.Confidence),
+		Graph:   export.FailureGraph(res, nil, nil),
+	}
+
+	callers := Section{Title: "Affected callers", Headers: []string{"Service", "Namespace", "Lost traffic", "Edge error rate"}, Empty: "No callers lose traffic."}
+	for _, c := range res.AffectedCallers {
+		callers.Rows = append(callers.Rows, []string{c.Name, c.Namespace, formatRps(c.LostTrafficRps), formatPct(c.EdgeErrorRate)})
+	}
+
+	downstream := Section{Title: "Affected downstream services", Headers: []string{"Service", "Namespace", "Lost traffic", "Edge error rate"}, Empty: "No downstream services lose traffic."}
+	for _, d := range res.AffectedDownstream {
+		downstream.Rows = append(downstream.Rows, []string{d.Name, d.Namespace, formatRps(d.LostTrafficRps), formatPct(d.EdgeErrorRate)})
+	}
+
+	unreachable := Section{Title: "Unreachable services", Headers: []string{"Service", "Namespace", "Lost traffic", "From target", "From other cuts"}, Empty: "No services become unreachable."}
+	for _, u := range res.UnreachableServices {
+		unreachable.Rows = append(unreachable.Rows, []string{u.Name, u.Namespace, formatRps(u.LostTrafficRps), formatRps(u.LostFromTargetRps), formatRps(u.LostFromReachableCutsRps)})
+	}
+
+	paths := Section{Title: "Critical paths to target", Headers: []string{"#", "Path", "Path traffic"}, Empty: "No critical paths found."}
+	for i, p := range res.CriticalPaths {
+		paths.Rows = append(paths.Rows, []string{fmt.Sprint(i + 1), strings.Join(p.Path, " → "), formatRps(p.PathRps)})
+	}
+
+	r.Sections = []Section{callers, downstream, unreachable, paths, recommendationsSection(res.Recommendations)}
+	return r
+}
+
+func Scaling(meta Meta, res *simulation.ScalingSimulationResult) *Report {
+	est := res.LatencyEstimate
+	r := &Report{
+		Title:    fmt.Sprintf("Scaling impact report: %s", serviceLabel(res.Target)),
+		Subtitle: meta.subtitle(),
+		Summary:  res.Explanation,
+		Facts: []Fact{
+			{"Target", serviceLabel(res.Target)},
+			{"Pods", fmt.Sprintf("%d → %d (%s)", res.CurrentPods, res.NewPods, res.ScalingDirection)},
+			{"Latency metric", res.LatencyMetric},
+			{"Scaling model", scalingModelLabel(res.ScalingModel)},
+			{"Baseline latency", formatMs(est.BaselineMs)},
+			{"Projected latency", formatMs(est.ProjectedMs)},
+			{"Latency change", formatDeltaMs(est.DeltaMs)},
+			{"Confidence", res.Confidence},
+		},
+		Caveats: append(freshnessCaveats(res.DataFreshness, res.Confidence), res.Warnings...),
+		Graph:   export.ScalingGraph(res, nil, nil),
+	}
+
+	callers := Section{Title: "Affected callers", Headers: []string{"Service", "Namespace", "Hops", "Before", "After", "Change", "End-to-end change", "Via"}, Empty: "No callers are affected."}
+	for _, c := range res.AffectedCallers.Items {
+		callers.Rows = append(callers.Rows, []string{
+			c.Name, c.Namespace, fmt.Sprint(c.HopDistance),
+			formatMs(c.BeforeMs), formatMs(c.AfterMs), formatDeltaMs(c.DeltaMs),
+			formatDeltaMs(c.EndToEndDeltaMs), strings.Join(c.ViaPath, " → "),
+		})
+	}
+
+	paths := Section{Title: "Affected paths", Headers: []string{"#", "Path", "Path traffic", "Before", "After", "Change"}, Empty: "No affected paths found."}
+	for i, p := range res.AffectedPaths {
+		change := formatDeltaMs(p.DeltaMs)
+		if p.IncompleteData {
+			change += " (incomplete data)"
+		}
+		paths.Rows = append(paths.Rows, []string{fmt.Sprint(i + 1), strings.Join(p.Path, " → "), formatRps(p.PathRps), formatMs(p.BeforeMs), formatMs(p.AfterMs), change})
+	}
+
+	r.Sections = []Section{callers, paths, recommendationsSection(res.Recommendations)}
+	return r
+}
+
+var priorityRank = map[string]int{"critical": 0, "high": 1, "medium": 2, "low": 3}
+
+func recommendationsSection(recs []simulation.FailureRecommendation) Section {
+	sorted := append([]simulation.FailureRecommendation(nil), recs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return rank(sorted[i].Priority) < rank(sorted[j].Priority)
+	})
+
+	s := Section{Title: "Recommendations", Headers: []string{"Priority", "Type", "Target", "Recommendation"}, Empty: "No recommendations."}
+	for _, rec := range sorted {
+		text := rec.Reason
+		if text == "" {
+			text = rec.Description
+		}
+		if rec.Action != "" {
+			if text != "" && !strings.HasSuffix(text, ".") {
+				text += "."
+			}
+			text = strings.TrimSpace(text + " Action: " + rec.Action)
+		}
+		s.Rows = append(s.Rows, []string{strings.ToUpper(rec.Priority), rec.Type, rec.Target, text})
+	}
+	return s
+}
+
+func rank(priority string) int {
+	if r, ok := priorityRank[strings.ToLower(priority)]; ok {
+		return r
+	}
+	return len(priorityRank)
+}
+
+func freshnessCaveats(df *simulation.DataFreshness, confidence string) []string {
+	var caveats []string
+	switch {
+	case df == nil:
+		caveats = append(caveats, "Data freshness is unknown: the Graph Engine health check was unavailable when this simulation ran.")
+	case df.Stale:
+		caveats = append(caveats, fmt.Sprintf("Graph data was stale when this simulation ran (last updated %ds ago, %d minute window).", df.LastUpdatedSecondsAgo, df.WindowMinutes))
+	}
+	if confidence == "low" || confidence == "unknown" {
+		caveats = append(caveats, fmt.Sprintf("Simulation confidence is %s; treat projected numbers as indicative only.", confidence))
+	}
+	return caveats
+}
+
+func serviceLabel(ref simulation.ServiceRef) string {
+	return simulation.CanonicalServiceId(ref.Namespace + ":" + ref.Name)
+}
+
+func scalingModelLabel(m simulation.ScalingModel) string {
+	var params []string
+	if m.Alpha != nil {
+		params = append(params, fmt.Sprintf("alpha %.2f", *m.Alpha))
+	}
+	if m.MinLatencyFactor != nil {
+		params = append(params, fmt.Sprintf("min factor %.2f", *m.MinLatencyFactor))
+	}
+	switch {
+	case m.Calibration != nil:
+		params = append(params, fmt.Sprintf("calibrated from %d pod-count changes", m.Calibration.Samples))
+	case m.Source != "":
+		params = append(params, "from "+m.Source)
+	}
+	if len(params) == 0 {
+		return m.Type
+	}
+	return fmt.Sprintf("%s (%s)", m.Type, strings.Join(params, ", "))
+}
+
+func formatRps(v float64) string {
+	return fmt.Sprintf("%.1f rps", v)
+}
+
+func formatPct(v float64) string {
+	return fmt.Sprintf("%.2f%%", v*100)
+}
+
+func formatMs(v *float64) string {
+	if v == nil {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.1f ms", *v)
+}
+
+func formatDeltaMs(v *float64) string {
+	if v == nil {
+		return "n/a"
+	}
+	return fmt.Sprintf("%+.1f ms", *v)
+}
@@ -0,0 +1,201 @@
+// Package retention prunes decisions past their configured age, archiving
+// them to compressed NDJSON first.
+package retention
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/export"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+const maxBatchSize = 1000
+
+type Pruner struct {
+	store storage.DecisionPruner
+	cfg   config.RetentionConfig
+}
+
+// Policy is one retention rule: decisions of Type (or of every type without
+// its own rule, when Type is "*") older than MaxAgeDays are pruned, unless
+// they are approved or applied.
+type Policy struct {
+	Type       string `json:"type"`
+	MaxAgeDays int    `json:"maxAgeDays"`
+	Before     string `json:"before"`
+	Deleted    int    `json:"deleted,omitempty"`
+}
+
+type Result struct {
+	Deleted     int      `json:"deleted"`
+	Policies    []Policy `json:"policies"`
+	ArchiveFile string   `json:"archiveFile,omitempty"`
+}
+
+func NewPruner(cfg config.RetentionConfig, store storage.DecisionPruner) *Pruner {
+	return &Pruner{store: store, cfg: cfg}
+}
+
+// Policies returns the active retention rules as of now, per-type rules
+// first. Types configured with 0 days are kept forever and have no rule.
+func (p *Pruner) Policies() []Policy {
+	return p.policies(time.Now().UTC())
+}
+
+func (p *Pruner) policies(now time.Time) []Policy {
+	var out []Policy
+	for t, days := range p.cfg.MaxAgeDaysByType {
+		if days > 0 {
+			out = append(out, Policy{Type: t, MaxAgeDays: days})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Type < out[j].Type })
+	if p.cfg.MaxAgeDays > 0 {
+		out = append(out, Policy{Type: "*", MaxAgeDays: p.cfg.MaxAgeDays})
+	}
+	for i := range out {
+		out[i].Before = now.AddDate(0, 0, -out[i].MaxAgeDays).Format(time.RFC3339)
+	}
+	return out
+}
+
+// Run applies every policy in batches. Each batch is written to the archive
+// and flushed to disk before it is deleted, so an archive failure leaves the
+// rows in place.
+func (p *Pruner) Run(ctx context.Context) (*Result, error) {
+	if p.store == nil {
+		return nil, common.NewError(common.CodeDecisionStoreUnavailable, "Decision store not available. Check DECISION_STORE_DRIVER configuration.")
+	}
+
+	batch := p.cfg.BatchSize
+	if batch <= 0 || batch > maxBatchSize {
+		batch = maxBatchSize
+	}
+
+	var overridden []string
+	for t := range p.cfg.MaxAgeDaysByType {
+		overridden = append(overridden, t)
+	}
+	sort.Strings(overridden)
+
+	now := time.Now().UTC()
+	res := &Result{Policies: p.policies(now)}
+	var arc *archive
+	defer func() {
+		if arc != nil {
+			arc.close()
+		}
+	}()
+
+	for i := range res.Policies {
+		pol := &res.Policies[i]
+		filter := storage.ExpiryFilter{Before: pol.Before}
+		if pol.Type == "*" {
+			filter.ExcludeTypes = overridden
+		} else {
+			filter.Types = []string{pol.Type}
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				return res, err
+			}
+			rows, err := p.store.ExpiredDecisions(filter, batch)
+			if err != nil {
+				return res, err
+			}
+			if len(rows) == 0 {
+				break
+			}
+
+			if p.cfg.ArchiveEnabled {
+				if arc == nil {
+					if arc, err = openArchive(p.cfg.ArchiveDir, now); err != nil {
+						return res, err
+					}
+					res.ArchiveFile = arc.path
+				}
+				if err := arc.write(rows); err != nil {
+					return res, err
+				}
+			}
+
+			ids := make([]int64, len(rows))
+			for j, r := range rows {
+				ids[j] = r.ID
+			}
+			n, err := p.store.DeleteDecisions(ids)
+			if err != nil {
+				return res, err
+			}
+			pol.Deleted += n
+			res.Deleted += n
+			if len(rows) < batch {
+				break
+			}
+		}
+	}
+
+	if arc != nil {
+		err := arc.close()
+		arc = nil
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+type archive struct {
+	path string
+	file *os.File
+	gz   *gzip.Writer
+	w    export.DecisionWriter
+}
+
+func openArchive(dir string, now time.Time) (*archive, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create archive directory: %w", err)
+	}
+	path := filepath.Join(dir, "decisions-"+now.Format("20060102T150405Z")+".ndjson.gz")
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+	gz := gzip.NewWriter(f)
+	return &archive{path: path, file: f, gz: gz, w: export.NewDecisionWriter(gz, export.FormatNDJSON)}, nil
+}
+
+func (a *archive) write(rows []storage.DecisionRow) error {
+	for i := range rows {
+		if err := a.w.Write(&rows[i]); err != nil {
+			return fmt.Errorf("failed to write archive: %w", err)
+		}
+	}
+	if err := a.gz.Flush(); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	if err := a.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync archive: %w", err)
+	}
+	return nil
+}
+
+func (a *archive) close() error {
+	err := a.gz.Close()
+	if cerr := a.file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to close archive: %w", err)
+	}
+	return nil
+}
@@ -7,10 +7,10 @@ import (
 	"sort"
 	"strings"
 
-	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/common"
 )
 
-func SimulateAddService(ctx context.Context, client *graph.Client, req AddSimulationRequest) (*AddSimulationResult, error) {
+func SimulateAddService(ctx context.Context, client GraphSource, req AddSimulationRequest) (*AddSimulationResult, error) {
 
 	if req.ServiceName == "" {
 		req.ServiceName = "new-service"
@@ -27,12 +27,12 @@ func SimulateAddService(ctx context.Context, client *graph.Client, req AddSimula
 	}
 
 	if req.CPURequest <= 0 || req.RAMRequest <= 0 || req.Replicas <= 0 {
-		return nil, fmt.Errorf("Invalid resource requests: cpu, ram, and replicas must be positive")
+		return nil, common.NewError(common.CodeInvalidResourceRequest, "Invalid resource requests: cpu, ram, and replicas must be positive")
 	}
 
 	services, err := client.GetServices(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to fetch cluster state: %v", err)
+		return nil, common.WrapError(common.CodeOf(err), err, "Failed to fetch cluster state")
 	}
 
 	type rawNode struct {
@@ -65,7 +65,7 @@ func SimulateAddService(ctx context.Context, client *graph.Client, req AddSimula
 
 	if len(rawNodes) == 0 {
 
-		return nil, fmt.Errorf("No nodes found in cluster state. Cannot perform placement analysis.")
+		return nil, common.NewError(common.CodeNoNodesFound, "No nodes found in cluster state. Cannot perform placement analysis.")
 	}
 
 	var minikubeNodes []*rawNode
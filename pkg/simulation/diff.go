@@ -0,0 +1,343 @@
+package simulation
+
+import (
+	"math"
+	"strings"
+)
+
+// diffEpsilon absorbs float noise so that re-running a simulation on the same
+// graph does not report changes.
+const diffEpsilon = 1e-9
+
+// ValueChange is a numeric field compared between two results. Before or
+// After is nil when that result has no value; Delta is After minus Before.
+type ValueChange struct {
+	Before *float64 `json:"before"`
+	After  *float64 `json:"after"`
+	Delta  *float64 `json:"delta"`
+}
+
+func newValueChange(before, after *float64) ValueChange {
+	c := ValueChange{Before: before, After: after}
+	if before != nil && after != nil {
+		d := *after - *before
+		c.Delta = &d
+	}
+	return c
+}
+
+func (c ValueChange) changed() bool {
+	if c.Before == nil || c.After == nil {
+		return c.Before != c.After
+	}
+	return math.Abs(*c.Delta) > diffEpsilon
+}
+
+type StringChange struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+type ServiceRpsChange struct {
+	ServiceId      string      `json:"serviceId"`
+	LostTrafficRps ValueChange `json:"lostTrafficRps"`
+}
+
+type CallersDiff struct {
+	Added   []AffectedCaller   `json:"added"`
+	Removed []AffectedCaller   `json:"removed"`
+	Changed []ServiceRpsChange `json:"changed"`
+}
+
+type UnreachableDiff struct {
+	Added   []UnreachableService `json:"added"`
+	Removed []UnreachableService `json:"removed"`
+	Changed []ServiceRpsChange   `json:"changed"`
+}
+
+type PathRpsChange struct {
+	Path    []string    `json:"path"`
+	PathRps ValueChange `json:"pathRps"`
+}
+
+type CriticalPathsDiff struct {
+	Added   []BrokenPath    `json:"added"`
+	Removed []BrokenPath    `json:"removed"`
+	Changed []PathRpsChange `json:"changed"`
+}
+
+// RecommendationChange is a recommendation with the same type and target in
+// both results whose priority, reason or action differs.
+type RecommendationChange struct {
+	Type   string                `json:"type"`
+	Target string                `json:"target,omitempty"`
+	Before FailureRecommendation `json:"before"`
+	After  FailureRecommendation `json:"after"`
+}
+
+type RecommendationsDiff struct {
+	Added   []FailureRecommendation `json:"added"`
+	Removed []FailureRecommendation `json:"removed"`
+	Changed []RecommendationChange  `json:"changed"`
+}
+
+// FailureDiff compares failure simulation a (before) with b (after).
+type FailureDiff struct {
+	TargetChanged       *StringChange       `json:"targetChanged,omitempty"`
+	ConfidenceChanged   *StringChange       `json:"confidenceChanged,omitempty"`
+	TotalLostTrafficRps ValueChange         `json:"totalLostTrafficRps"`
+	Callers             CallersDiff         `json:"callers"`
+	Unreachable         UnreachableDiff     `json:"unreachable"`
+	CriticalPaths       CriticalPathsDiff   `json:"criticalPaths"`
+	Recommendations     RecommendationsDiff `json:"recommendations"`
+	Identical           bool                `json:"identical"`
+}
+
+type ScalingCallerChange struct {
+	ServiceId       string      `json:"serviceId"`
+	AfterMs         ValueChange `json:"afterMs"`
+	DeltaMs         ValueChange `json:"deltaMs"`
+	EndToEndAfterMs ValueChange `json:"endToEndAfterMs"`
+}
+
+type ScalingCallersDiff struct {
+	Added   []AffectedCallerScaling `json:"added"`
+	Removed []AffectedCallerScaling `json:"removed"`
+	Changed []ScalingCallerChange   `json:"changed"`
+}
+
+type ScalingPathChange struct {
+	Path    []string    `json:"path"`
+	AfterMs ValueChange `json:"afterMs"`
+	DeltaMs ValueChange `json:"deltaMs"`
+}
+
+type ScalingPathsDiff struct {
+	Added   []AffectedPathScaling `json:"added"`
+	Removed []AffectedPathScaling `json:"removed"`
+	Changed []ScalingPathChange   `json:"changed"`
+}
+
+// ScalingDiff compares scaling simulation a (before) with b (after).
+type ScalingDiff struct {
+	TargetChanged        *StringChange       `json:"targetChanged,omitempty"`
+	ConfidenceChanged    *StringChange       `json:"confidenceChanged,omitempty"`
+	LatencyMetricChanged *StringChange       `json:"latencyMetricChanged,omitempty"`
+	ScalingModelChanged  *StringChange       `json:"scalingModelChanged,omitempty"`
+	CurrentPods          ValueChange         `json:"currentPods"`
+	NewPods              ValueChange         `json:"newPods"`
+	BaselineMs           ValueChange         `json:"baselineMs"`
+	ProjectedMs          ValueChange         `json:"projectedMs"`
+	Callers              ScalingCallersDiff  `json:"callers"`
+	Paths                ScalingPathsDiff    `json:"paths"`
+	Recommendations      RecommendationsDiff `json:"recommendations"`
+	Identical            bool                `json:"identical"`
+}
+
+func DiffFailure(a, b *FailureSimulationResult) *FailureDiff {
+	d := &FailureDiff{
+		TargetChanged:       stringChange(a.Target.ServiceId, b.Target.ServiceId),
+		ConfidenceChanged:   stringChange(a.Confidence, b.Confidence),
+		TotalLostTrafficRps: newValueChange(&a.TotalLostTrafficRps, &b.TotalLostTrafficRps),
+		Callers:             CallersDiff{Added: []AffectedCaller{}, Removed: []AffectedCaller{}, Changed: []ServiceRpsChange{}},
+		Unreachable:         UnreachableDiff{Added: []UnreachableService{}, Removed: []UnreachableService{}, Changed: []ServiceRpsChange{}},
+		CriticalPaths:       CriticalPathsDiff{Added: []BrokenPath{}, Removed: []BrokenPath{}, Changed: []PathRpsChange{}},
+		Recommendations:     diffRecommendations(a.Recommendations, b.Recommendations),
+	}
+
+	before := map[string]AffectedCaller{}
+	for _, c := range a.AffectedCallers {
+		before[c.ServiceId] = c
+	}
+	after := map[string]AffectedCaller{}
+	for _, c := range b.AffectedCallers {
+		after[c.ServiceId] = c
+		old, ok := before[c.ServiceId]
+		if !ok {
+			d.Callers.Added = append(d.Callers.Added, c)
+			continue
+		}
+		if ch := newValueChange(&old.LostTrafficRps, &c.LostTrafficRps); ch.changed() {
+			d.Callers.Changed = append(d.Callers.Changed, ServiceRpsChange{ServiceId: c.ServiceId, LostTrafficRps: ch})
+		}
+	}
+	for _, c := range a.AffectedCallers {
+		if _, ok := after[c.ServiceId]; !ok {
+			d.Callers.Removed = append(d.Callers.Removed, c)
+		}
+	}
+
+	beforeU := map[string]UnreachableService{}
+	for _, u := range a.UnreachableServices {
+		beforeU[u.ServiceId] = u
+	}
+	afterU := map[string]UnreachableService{}
+	for _, u := range b.UnreachableServices {
+		afterU[u.ServiceId] = u
+		old, ok := beforeU[u.ServiceId]
+		if !ok {
+			d.Unreachable.Added = append(d.Unreachable.Added, u)
+			continue
+		}
+		if ch := newValueChange(&old.LostTrafficRps, &u.LostTrafficRps); ch.changed() {
+			d.Unreachable.Changed = append(d.Unreachable.Changed, ServiceRpsChange{ServiceId: u.ServiceId, LostTrafficRps: ch})
+		}
+	}
+	for _, u := range a.UnreachableServices {
+		if _, ok := afterU[u.ServiceId]; !ok {
+			d.Unreachable.Removed = append(d.Unreachable.Removed, u)
+		}
+	}
+
+	beforeP := map[string]BrokenPath{}
+	for _, p := range a.CriticalPaths {
+		beforeP[pathKey(p.Path)] = p
+	}
+	afterP := map[string]BrokenPath{}
+	for _, p := range b.CriticalPaths {
+		afterP[pathKey(p.Path)] = p
+		old, ok := beforeP[pathKey(p.Path)]
+		if !ok {
+			d.CriticalPaths.Added = append(d.CriticalPaths.Added, p)
+			continue
+		}
+		if ch := newValueChange(&old.PathRps, &p.PathRps); ch.changed() {
+			d.CriticalPaths.Changed = append(d.CriticalPaths.Changed, PathRpsChange{Path: p.Path, PathRps: ch})
+		}
+	}
+	for _, p := range a.CriticalPaths {
+		if _, ok := afterP[pathKey(p.Path)]; !ok {
+			d.CriticalPaths.Removed = append(d.CriticalPaths.Removed, p)
+		}
+	}
+
+	d.Identical = d.TargetChanged == nil && d.ConfidenceChanged == nil && !d.TotalLostTrafficRps.changed() &&
+		len(d.Callers.Added)+len(d.Callers.Removed)+len(d.Callers.Changed) == 0 &&
+		len(d.Unreachable.Added)+len(d.Unreachable.Removed)+len(d.Unreachable.Changed) == 0 &&
+		len(d.CriticalPaths.Added)+len(d.CriticalPaths.Removed)+len(d.CriticalPaths.Changed) == 0 &&
+		d.Recommendations.empty()
+	return d
+}
+
+func DiffScaling(a, b *ScalingSimulationResult) *ScalingDiff {
+	curA, curB := float64(a.CurrentPods), float64(b.CurrentPods)
+	newA, newB := float64(a.NewPods), float64(b.NewPods)
+	d := &ScalingDiff{
+		TargetChanged:        stringChange(a.Target.ServiceId, b.Target.ServiceId),
+		ConfidenceChanged:    stringChange(a.Confidence, b.Confidence),
+		LatencyMetricChanged: stringChange(a.LatencyMetric, b.LatencyMetric),
+		ScalingModelChanged:  stringChange(a.ScalingModel.Type, b.ScalingModel.Type),
+		CurrentPods:          newValueChange(&curA, &curB),
+		NewPods:              newValueChange(&newA, &newB),
+		BaselineMs:           newValueChange(a.LatencyEstimate.BaselineMs, b.LatencyEstimate.BaselineMs),
+		ProjectedMs:          newValueChange(a.LatencyEstimate.ProjectedMs, b.LatencyEstimate.ProjectedMs),
+		Callers:              ScalingCallersDiff{Added: []AffectedCallerScaling{}, Removed: []AffectedCallerScaling{}, Changed: []ScalingCallerChange{}},
+		Paths:                ScalingPathsDiff{Added: []AffectedPathScaling{}, Removed: []AffectedPathScaling{}, Changed: []ScalingPathChange{}},
+		Recommendations:      diffRecommendations(a.Recommendations, b.Recommendations),
+	}
+
+	before := map[string]AffectedCallerScaling{}
+	for _, c := range a.AffectedCallers.Items {
+		before[c.ServiceId] = c
+	}
+	after := map[string]AffectedCallerScaling{}
+	for _, c := range b.AffectedCallers.Items {
+		after[c.ServiceId] = c
+		old, ok := before[c.ServiceId]
+		if !ok {
+			d.Callers.Added = append(d.Callers.Added, c)
+			continue
+		}
+		ch := ScalingCallerChange{
+			ServiceId:       c.ServiceId,
+			AfterMs:         newValueChange(old.AfterMs, c.AfterMs),
+			DeltaMs:         newValueChange(old.DeltaMs, c.DeltaMs),
+			EndToEndAfterMs: newValueChange(old.EndToEndAfterMs, c.EndToEndAfterMs),
+		}
+		if ch.AfterMs.changed() || ch.DeltaMs.changed() || ch.EndToEndAfterMs.changed() {
+			d.Callers.Changed = append(d.Callers.Changed, ch)
+		}
+	}
+	for _, c := range a.AffectedCallers.Items {
+		if _, ok := after[c.ServiceId]; !ok {
+			d.Callers.Removed = append(d.Callers.Removed, c)
+		}
+	}
+
+	beforeP := map[string]AffectedPathScaling{}
+	for _, p := range a.AffectedPaths {
+		beforeP[pathKey(p.Path)] = p
+	}
+	afterP := map[string]AffectedPathScaling{}
+	for _, p := range b.AffectedPaths {
+		afterP[pathKey(p.Path)] = p
+		old, ok := beforeP[pathKey(p.Path)]
+		if !ok {
+			d.Paths.Added = append(d.Paths.Added, p)
+			continue
+		}
+		ch := ScalingPathChange{
+			Path:    p.Path,
+			AfterMs: newValueChange(old.AfterMs, p.AfterMs),
+			DeltaMs: newValueChange(old.DeltaMs, p.DeltaMs),
+		}
+		if ch.AfterMs.changed() || ch.DeltaMs.changed() {
+			d.Paths.Changed = append(d.Paths.Changed, ch)
+		}
+	}
+	for _, p := range a.AffectedPaths {
+		if _, ok := afterP[pathKey(p.Path)]; !ok {
+			d.Paths.Removed = append(d.Paths.Removed, p)
+		}
+	}
+
+	d.Identical = d.TargetChanged == nil && d.ConfidenceChanged == nil && d.LatencyMetricChanged == nil && d.ScalingModelChanged == nil &&
+		!d.CurrentPods.changed() && !d.NewPods.changed() && !d.BaselineMs.changed() && !d.ProjectedMs.changed() &&
+		len(d.Callers.Added)+len(d.Callers.Removed)+len(d.Callers.Changed) == 0 &&
+		len(d.Paths.Added)+len(d.Paths.Removed)+len(d.Paths.Changed) == 0 &&
+		d.Recommendations.empty()
+	return d
+}
+
+func diffRecommendations(a, b []FailureRecommendation) RecommendationsDiff {
+	d := RecommendationsDiff{Added: []FailureRecommendation{}, Removed: []FailureRecommendation{}, Changed: []RecommendationChange{}}
+	key := func(r FailureRecommendation) string { return r.Type + "|" + r.Target }
+
+	before := map[string]FailureRecommendation{}
+	for _, r := range a {
+		before[key(r)] = r
+	}
+	after := map[string]FailureRecommendation{}
+	for _, r := range b {
+		after[key(r)] = r
+		old, ok := before[key(r)]
+		if !ok {
+			d.Added = append(d.Added, r)
+			continue
+		}
+		if old != r {
+			d.Changed = append(d.Changed, RecommendationChange{Type: r.Type, Target: r.Target, Before: old, After: r})
+		}
+	}
+	for _, r := range a {
+		if _, ok := after[key(r)]; !ok {
+			d.Removed = append(d.Removed, r)
+		}
+	}
+	return d
+}
+
+func (d RecommendationsDiff) empty() bool {
+	return len(d.Added)+len(d.Removed)+len(d.Changed) == 0
+}
+
+func stringChange(before, after string) *StringChange {
+	if before == after {
+		return nil
+	}
+	return &StringChange{Before: before, After: after}
+}
+
+func pathKey(path []string) string {
+	return strings.Join(path, "\x00")
+}
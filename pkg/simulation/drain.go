@@ -0,0 +1,291 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"predictive-analysis-engine/pkg/common"
+)
+
+type drainNode struct {
+	name     string
+	cpuTotal float64
+	ramTotal float64
+	cpuUsed  float64
+	ramUsed  float64
+	cpuAdded float64
+	ramAdded float64
+	added    int
+	pods     []PodMove
+	services map[string]int
+}
+
+// SimulateDrain re-places every pod on the drained nodes onto the remaining
+// nodes, using each pod's observed CPU and RAM usage as its requests. Pods
+// are placed largest first on the node left with the most headroom, and
+// pods that fit nowhere are reported as unschedulable.
+func SimulateDrain(ctx context.Context, client GraphSource, req DrainSimulationRequest) (*DrainSimulationResult, error) {
+	if len(req.Nodes) == 0 {
+		return nil, common.NewError(common.CodeValidationFailed, "nodes must not be empty")
+	}
+
+	services, err := client.GetServices(ctx)
+	if err != nil {
+		return nil, common.WrapError(common.CodeOf(err), err, "Failed to fetch cluster state")
+	}
+
+	nodes := map[string]*drainNode{}
+	replicas := map[string]int{}
+	for _, svc := range services {
+		serviceID := toCanonicalServiceId(svc.Namespace, svc.Name)
+		for _, placement := range svc.Placement.Nodes {
+			if placement.Node == "" {
+				continue
+			}
+			n, ok := nodes[placement.Node]
+			if !ok {
+				cores := float64(placement.Resources.CPU.Cores)
+				n = &drainNode{
+					name:     placement.Node,
+					cpuTotal: cores,
+					ramTotal: placement.Resources.RAM.TotalMB,
+					cpuUsed:  placement.Resources.CPU.UsagePercent / 100.0 * cores,
+					ramUsed:  placement.Resources.RAM.UsedMB,
+					services: map[string]int{},
+				}
+				nodes[placement.Node] = n
+			}
+			for _, pod := range placement.Pods {
+				if slices.ContainsFunc(n.pods, func(p PodMove) bool { return p.Pod == pod.Name }) {
+					continue
+				}
+				n.pods = append(n.pods, PodMove{
+					Pod:          pod.Name,
+					ServiceId:    serviceID,
+					FromNode:     n.name,
+					CPURequest:   toFixed(pod.CPUUsagePercent/100.0*n.cpuTotal, 3),
+					RAMRequestMB: pod.RAMUsedMB,
+				})
+				n.services[serviceID]++
+				replicas[serviceID]++
+			}
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, common.NewError(common.CodeNoNodesFound, "No nodes found in cluster state. Cannot perform placement analysis.")
+	}
+
+	drained := map[string]bool{}
+	var unknown []string
+	for _, name := range req.Nodes {
+		if _, ok := nodes[name]; !ok {
+			unknown = append(unknown, name)
+		}
+		drained[name] = true
+	}
+	if len(unknown) > 0 {
+		return nil, common.NewError(common.CodeNotFound, "Node not found: %s", strings.Join(unknown, ", "))
+	}
+
+	var moving []PodMove
+	var remaining []*drainNode
+	for _, n := range nodes {
+		if drained[n.name] {
+			moving = append(moving, n.pods...)
+		} else {
+			remaining = append(remaining, n)
+		}
+	}
+	sort.Slice(remaining, func(i, j int) bool { return remaining[i].name < remaining[j].name })
+	sort.Slice(moving, func(i, j int) bool {
+		if moving[i].RAMRequestMB != moving[j].RAMRequestMB {
+			return moving[i].RAMRequestMB > moving[j].RAMRequestMB
+		}
+		if moving[i].CPURequest != moving[j].CPURequest {
+			return moving[i].CPURequest > moving[j].CPURequest
+		}
+		return moving[i].Pod < moving[j].Pod
+	})
+
+	result := &DrainSimulationResult{
+		DrainedNodes:     make([]string, 0, len(drained)),
+		Confidence:       "high",
+		PodsToMove:       len(moving),
+		Rescheduled:      []PodMove{},
+		Unschedulable:    []PodMove{},
+		Nodes:            []NodeUtilization{},
+		AffectedServices: []DrainedService{},
+	}
+	for name := range drained {
+		result.DrainedNodes = append(result.DrainedNodes, name)
+	}
+	sort.Strings(result.DrainedNodes)
+
+	moved := map[string]int{}
+	lost := map[string]int{}
+	for _, pod := range moving {
+		if pod.CPURequest == 0 && pod.RAMRequestMB == 0 {
+			result.Confidence = "medium"
+		}
+		target := pickDrainTarget(remaining, pod)
+		if target == nil {
+			pod.Reason = unschedulableReason(remaining, pod)
+			result.Unschedulable = append(result.Unschedulable, pod)
+			lost[pod.ServiceId]++
+			continue
+		}
+		target.cpuAdded += pod.CPURequest
+		target.ramAdded += pod.RAMRequestMB
+		target.added++
+		target.services[pod.ServiceId]++
+		pod.ToNode = target.name
+		result.Rescheduled = append(result.Rescheduled, pod)
+		moved[pod.ServiceId]++
+	}
+	result.Success = len(result.Unschedulable) == 0
+
+	for _, n := range remaining {
+		u := NodeUtilization{
+			Node:            n.name,
+			CPUTotal:        n.cpuTotal,
+			RAMTotalMB:      n.ramTotal,
+			CPUUsedBefore:   toFixed(n.cpuUsed, 2),
+			CPUUsedAfter:    toFixed(n.cpuUsed+n.cpuAdded, 2),
+			RAMUsedBeforeMB: toFixed(n.ramUsed, 2),
+			RAMUsedAfterMB:  toFixed(n.ramUsed+n.ramAdded, 2),
+			PodsAdded:       n.added,
+		}
+		if n.cpuTotal > 0 {
+			u.CPUUtilizationBefore = toFixed(n.cpuUsed/n.cpuTotal*100, 1)
+			u.CPUUtilizationAfter = toFixed((n.cpuUsed+n.cpuAdded)/n.cpuTotal*100, 1)
+		}
+		if n.ramTotal > 0 {
+			u.RAMUtilizationBefore = toFixed(n.ramUsed/n.ramTotal*100, 1)
+			u.RAMUtilizationAfter = toFixed((n.ramUsed+n.ramAdded)/n.ramTotal*100, 1)
+		}
+		result.Nodes = append(result.Nodes, u)
+	}
+
+	var affected []string
+	for id := range moved {
+		affected = append(affected, id)
+	}
+	for id := range lost {
+		if moved[id] == 0 {
+			affected = append(affected, id)
+		}
+	}
+	sort.Strings(affected)
+	for _, id := range affected {
+		result.AffectedServices = append(result.AffectedServices, DrainedService{
+			ServiceId:      id,
+			ReplicasBefore: replicas[id],
+			ReplicasAfter:  replicas[id] - lost[id],
+			PodsMoved:      moved[id],
+			PodsLost:       lost[id],
+		})
+	}
+
+	result.Recommendations = drainRecommendations(result)
+	if result.Success {
+		result.Explanation = fmt.Sprintf("All %d pod(s) on %s can be rescheduled on the remaining %d node(s).", len(moving), strings.Join(result.DrainedNodes, ", "), len(remaining))
+	} else {
+		result.Explanation = fmt.Sprintf("%d of %d pod(s) on %s cannot be rescheduled on the remaining %d node(s).", len(result.Unschedulable), len(moving), strings.Join(result.DrainedNodes, ", "), len(remaining))
+	}
+	return result, nil
+}
+
+// pickDrainTarget returns the node with the most headroom left after taking
+// pod, preferring nodes with fewer replicas of the same service.
+func pickDrainTarget(nodes []*drainNode, pod PodMove) *drainNode {
+	var best *drainNode
+	var bestScore float64
+	for _, n := range nodes {
+		cpuFree := n.cpuTotal - n.cpuUsed - n.cpuAdded
+		ramFree := n.ramTotal - n.ramUsed - n.ramAdded
+		if cpuFree < pod.CPURequest || ramFree < pod.RAMRequestMB {
+			continue
+		}
+		var score float64
+		if n.cpuTotal > 0 {
+			score += (cpuFree - pod.CPURequest) / n.cpuTotal
+		}
+		if n.ramTotal > 0 {
+			score += (ramFree - pod.RAMRequestMB) / n.ramTotal
+		}
+		score /= 2
+		if best == nil || n.services[pod.ServiceId] < best.services[pod.ServiceId] ||
+			(n.services[pod.ServiceId] == best.services[pod.ServiceId] && score > bestScore) {
+			best, bestScore = n, score
+		}
+	}
+	return best
+}
+
+func unschedulableReason(nodes []*drainNode, pod PodMove) string {
+	if len(nodes) == 0 {
+		return "No nodes remain"
+	}
+	var maxCPU, maxRAM float64
+	for _, n := range nodes {
+		maxCPU = math.Max(maxCPU, n.cpuTotal-n.cpuUsed-n.cpuAdded)
+		maxRAM = math.Max(maxRAM, n.ramTotal-n.ramUsed-n.ramAdded)
+	}
+	switch {
+	case maxCPU < pod.CPURequest && maxRAM < pod.RAMRequestMB:
+		return "Insufficient CPU and RAM"
+	case maxCPU < pod.CPURequest:
+		return "Insufficient CPU"
+	case maxRAM < pod.RAMRequestMB:
+		return "Insufficient RAM"
+	}
+	return "No single node has enough CPU and RAM"
+}
+
+func drainRecommendations(result *DrainSimulationResult) []FailureRecommendation {
+	recs := []FailureRecommendation{}
+	for _, s := range result.AffectedServices {
+		if s.PodsLost == 0 {
+			continue
+		}
+		priority := "high"
+		if s.ReplicasAfter == 0 {
+			priority = "critical"
+		}
+		recs = append(recs, FailureRecommendation{
+			Type:        "capacity",
+			Priority:    priority,
+			Target:      s.ServiceId,
+			Reason:      fmt.Sprintf("%d of %d replica(s) of %s cannot be rescheduled.", s.PodsLost, s.ReplicasBefore, s.ServiceId),
+			Action:      "Add a node or free capacity before draining.",
+			Description: fmt.Sprintf("%s drops from %d to %d replica(s).", s.ServiceId, s.ReplicasBefore, s.ReplicasAfter),
+		})
+	}
+	for _, n := range result.Nodes {
+		if n.CPUUtilizationAfter < 80 && n.RAMUtilizationAfter < 80 {
+			continue
+		}
+		recs = append(recs, FailureRecommendation{
+			Type:        "capacity",
+			Priority:    "medium",
+			Target:      n.Node,
+			Reason:      fmt.Sprintf("%s runs at %.1f%% CPU and %.1f%% RAM after the drain.", n.Node, n.CPUUtilizationAfter, n.RAMUtilizationAfter),
+			Action:      "Leave headroom for spikes or add capacity.",
+			Description: fmt.Sprintf("%s is left with little headroom.", n.Node),
+		})
+	}
+	if result.Success && len(recs) == 0 {
+		recs = append(recs, FailureRecommendation{
+			Type:        "drain",
+			Priority:    "low",
+			Reason:      "Every pod fits on the remaining nodes with headroom to spare.",
+			Action:      fmt.Sprintf("Drain %s.", strings.Join(result.DrainedNodes, ", ")),
+			Description: fmt.Sprintf("%s can be drained safely.", strings.Join(result.DrainedNodes, ", ")),
+		})
+	}
+	return recs
+}
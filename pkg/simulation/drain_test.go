@@ -0,0 +1,129 @@
+package simulation
+
+import (
+	"testing"
+
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/common"
+)
+
+// testNode is a 4-core, 8000MB node at the given CPU percentage and RAM use.
+type testNode struct {
+	name   string
+	cpuPct float64
+	ramMB  float64
+}
+
+// cluster places the pods of service "cart" on the given nodes, keyed by
+// node name.
+func cluster(nodes []testNode, pods map[string][]graph.PodInfo) *fakeGraph {
+	svc := graph.ServiceInfo{Name: "cart", Namespace: "default"}
+	for _, n := range nodes {
+		svc.Placement.Nodes = append(svc.Placement.Nodes, graph.NodePlacement{
+			Node: n.name,
+			Resources: graph.NodeResources{
+				CPU: graph.CPUResources{UsagePercent: n.cpuPct, Cores: 4},
+				RAM: graph.RAMResources{UsedMB: n.ramMB, TotalMB: 8000},
+			},
+			Pods: pods[n.name],
+		})
+		svc.PodCount += len(pods[n.name])
+	}
+	return &fakeGraph{services: []graph.ServiceInfo{svc}}
+}
+
+// pod is a pod using ramMB and 10% of its node's CPU.
+func pod(name string, ramMB float64) graph.PodInfo {
+	return graph.PodInfo{Name: name, RAMUsedMB: ramMB, CPUUsagePercent: 10}
+}
+
+func TestSimulateDrain(t *testing.T) {
+	tests := []struct {
+		name          string
+		nodes         []testNode
+		pods          map[string][]graph.PodInfo
+		drain         []string
+		success       bool
+		unschedulable map[string]string
+		placed        map[string]string
+		replicasAfter int
+		priority      string
+	}{
+		{
+			name:          "single node",
+			nodes:         []testNode{{"n1", 20, 2000}},
+			pods:          map[string][]graph.PodInfo{"n1": {pod("cart-1", 500), pod("cart-2", 500)}},
+			drain:         []string{"n1"},
+			unschedulable: map[string]string{"cart-1": "No nodes remain", "cart-2": "No nodes remain"},
+			replicasAfter: 0,
+			priority:      "critical",
+		},
+		{
+			name:          "no capacity left",
+			nodes:         []testNode{{"n1", 20, 2000}, {"n2", 95, 7800}},
+			pods:          map[string][]graph.PodInfo{"n1": {pod("cart-1", 1000)}, "n2": {pod("cart-2", 1000)}},
+			drain:         []string{"n1"},
+			unschedulable: map[string]string{"cart-1": "Insufficient CPU and RAM"},
+			replicasAfter: 1,
+			priority:      "high",
+		},
+		{
+			name:          "spreads replicas over the remaining nodes",
+			nodes:         []testNode{{"n1", 20, 2000}, {"n2", 10, 1000}, {"n3", 40, 3000}},
+			pods:          map[string][]graph.PodInfo{"n1": {pod("cart-1", 600), pod("cart-2", 500)}, "n2": {pod("cart-3", 500)}},
+			drain:         []string{"n1"},
+			success:       true,
+			placed:        map[string]string{"cart-1": "n3", "cart-2": "n2"},
+			replicasAfter: 3,
+			priority:      "low",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := SimulateDrain(t.Context(), cluster(tt.nodes, tt.pods), DrainSimulationRequest{Nodes: tt.drain})
+			if err != nil {
+				t.Fatalf("SimulateDrain: %v", err)
+			}
+			if r.Success != tt.success || len(r.Unschedulable) != len(tt.unschedulable) || len(r.Rescheduled) != len(tt.placed) {
+				t.Fatalf("success %v, rescheduled %+v, unschedulable %+v", r.Success, r.Rescheduled, r.Unschedulable)
+			}
+			for _, p := range r.Unschedulable {
+				if p.Reason != tt.unschedulable[p.Pod] {
+					t.Errorf("%s reason = %q, want %q", p.Pod, p.Reason, tt.unschedulable[p.Pod])
+				}
+			}
+			for _, p := range r.Rescheduled {
+				if p.ToNode != tt.placed[p.Pod] {
+					t.Errorf("%s placed on %s, want %s", p.Pod, p.ToNode, tt.placed[p.Pod])
+				}
+			}
+			if len(r.AffectedServices) != 1 || r.AffectedServices[0].ReplicasAfter != tt.replicasAfter {
+				t.Errorf("affected services = %+v, want %d replicas left", r.AffectedServices, tt.replicasAfter)
+			}
+			if len(r.Recommendations) == 0 || r.Recommendations[0].Priority != tt.priority {
+				t.Errorf("recommendations = %+v, want %s first", r.Recommendations, tt.priority)
+			}
+		})
+	}
+}
+
+func TestSimulateDrainRejects(t *testing.T) {
+	nodes := []testNode{{"n1", 20, 2000}}
+	pods := map[string][]graph.PodInfo{"n1": {pod("cart-1", 500)}}
+	tests := []struct {
+		name  string
+		drain []string
+		code  common.ErrorCode
+	}{
+		{"no nodes", nil, common.CodeValidationFailed},
+		{"unknown node", []string{"n9"}, common.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SimulateDrain(t.Context(), cluster(nodes, pods), DrainSimulationRequest{Nodes: tt.drain})
+			if common.CodeOf(err) != tt.code {
+				t.Errorf("error = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package simulation
+
+import (
+	"errors"
+
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/common"
+)
+
+// neighborhoodError maps a graph lookup failure for serviceId to a typed
+// error, translating an upstream 404 into SERVICE_NOT_FOUND.
+func neighborhoodError(err error, serviceId string) error {
+	if errors.Is(err, graph.ErrNotFound) {
+		return common.NewError(common.CodeServiceNotFound, "Service not found: %s", serviceId)
+	}
+	return err
+}
@@ -9,9 +9,13 @@ import (
 	"time"
 
 	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/common"
 )
 
-func SimulateFailure(ctx context.Context, client *graph.Client, req FailureSimulationRequest) (*FailureSimulationResult, error) {
+// SimulateFailure estimates the impact of a service going down within its
+// k-hop neighborhood. Availability SLOs from slos that the outage would
+// break are reported in the result.
+func SimulateFailure(ctx context.Context, client GraphSource, req FailureSimulationRequest, slos SLOSource) (*FailureSimulationResult, error) {
 	maxDepth := req.Depth
 
 	if maxDepth < 2 {
@@ -19,12 +23,12 @@ func SimulateFailure(ctx context.Context, client *graph.Client, req FailureSimul
 	}
 
 	if maxDepth > 3 {
-		return nil, fmt.Errorf("maxDepth > 3 not supported. Got: %d", maxDepth)
+		return nil, common.NewError(common.CodeDepthOutOfRange, "maxDepth > 3 not supported. Got: %d", maxDepth)
 	}
 
 	neighborhood, err := client.GetNeighborhood(ctx, req.ServiceId, maxDepth)
 	if err != nil {
-		return nil, err
+		return nil, neighborhoodError(err, req.ServiceId)
 	}
 
 	snapshot := buildSnapshot(neighborhood)
@@ -39,7 +43,7 @@ func SimulateFailure(ctx context.Context, client *graph.Client, req FailureSimul
 		} else if _, exists := snapshot.Nodes[snapshot.TargetKey]; exists {
 
 		}
-		return nil, fmt.Errorf("Service not found: %s", req.ServiceId)
+		return nil, common.NewError(common.CodeServiceNotFound, "Service not found: %s", req.ServiceId)
 	}
 	targetOut := nodeToOutRef(targetNode, targetKey)
 
@@ -189,6 +193,7 @@ func SimulateFailure(ctx context.Context, client *graph.Client, req FailureSimul
 		UnreachableServices: unreachableServices,
 		CriticalPaths:       criticalPaths,
 		TotalLostTrafficRps: totalLostTrafficRps,
+		SLOBreaches:         failureSLOBreaches(snapshot, CanonicalServiceId(targetOut.Namespace+":"+targetOut.Name), affectedCallers, slos),
 	}
 
 	result.Recommendations = GenerateFailureRecommendations(result)
@@ -273,6 +278,13 @@ func parseServiceRef(idOrName string) (namespace, name string) {
 	return "default", idOrName
 }
 
+// CanonicalServiceId normalizes a "name" or "namespace:name" reference to
+// "namespace:name", defaulting the namespace to "default".
+func CanonicalServiceId(idOrName string) string {
+	ns, name := parseServiceRef(idOrName)
+	return toCanonicalServiceId(ns, name)
+}
+
 func toCanonicalServiceId(namespace, name string) string {
 	if namespace == "" {
 		namespace = "default"
@@ -0,0 +1,334 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/config"
+)
+
+// SimulateRemove projects a planned decommission of a service: the load its
+// callers move onto their replacements, the traffic lost from callers that
+// were not migrated, and the node capacity its pods free up. Each
+// replacement's latency is projected with the parameters the calibration job
+// fitted for it, when calibrations has them, as in SimulateScaling.
+func SimulateRemove(ctx context.Context, client GraphSource, cfg *config.Config, req RemoveSimulationRequest, calibrations CalibrationSource) (*RemoveSimulationResult, error) {
+	if req.ServiceId == "" {
+		return nil, common.NewError(common.CodeValidationFailed, "serviceId is required")
+	}
+	targetKey := CanonicalServiceId(req.ServiceId)
+
+	latencyMetric := req.LatencyMetric
+	if latencyMetric == "" {
+		latencyMetric = cfg.Simulation.DefaultLatencyMetric
+	}
+	if latencyMetric != "p50" && latencyMetric != "p95" && latencyMetric != "p99" {
+		return nil, common.NewError(common.CodeInvalidLatencyMetric, "Invalid latencyMetric: %s", latencyMetric)
+	}
+	model := ScalingModel{Type: cfg.Simulation.ScalingModel, Source: "config"}
+	if model.Type != "bounded_sqrt" && model.Type != "linear" {
+		return nil, common.NewError(common.CodeInvalidScalingModel, "Unknown scaling model: %s", model.Type)
+	}
+	alpha, minLatencyFactor := cfg.Simulation.ScalingAlpha, cfg.Simulation.MinLatencyFactor
+	model.Alpha, model.MinLatencyFactor = &alpha, &minLatencyFactor
+
+	redirects := make(map[string]string, len(req.Redirects))
+	for caller, replacement := range req.Redirects {
+		callerKey, replacementKey := CanonicalServiceId(caller), CanonicalServiceId(replacement)
+		if replacementKey == targetKey {
+			return nil, common.NewError(common.CodeValidationFailed, "Replacement for %s cannot be the removed service", callerKey)
+		}
+		if replacementKey == callerKey {
+			return nil, common.NewError(common.CodeInvalidParameter, "Replacement for %s cannot be the caller itself", callerKey)
+		}
+		redirects[callerKey] = replacementKey
+	}
+
+	services, err := client.GetServices(ctx)
+	if err != nil {
+		return nil, common.WrapError(common.CodeOf(err), err, "Failed to fetch cluster state")
+	}
+	byID := make(map[string]graph.ServiceInfo, len(services))
+	for _, svc := range services {
+		byID[toCanonicalServiceId(svc.Namespace, svc.Name)] = svc
+	}
+	target, ok := byID[targetKey]
+	if !ok {
+		return nil, common.NewError(common.CodeServiceNotFound, "Service not found: %s", targetKey)
+	}
+	var missing []string
+	for _, replacementKey := range redirects {
+		if _, ok := byID[replacementKey]; !ok && !slices.Contains(missing, replacementKey) {
+			missing = append(missing, replacementKey)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, common.NewError(common.CodeServiceNotFound, "Replacement service not found: %s", strings.Join(missing, ", "))
+	}
+
+	neighborhood, err := client.GetNeighborhood(ctx, targetKey, 1)
+	if err != nil {
+		return nil, neighborhoodError(err, targetKey)
+	}
+	snapshot := buildSnapshot(neighborhood)
+
+	incoming := map[string][]*Edge{}
+	for _, e := range snapshot.IncomingEdges[targetKey] {
+		if e.Source != targetKey {
+			incoming[e.Source] = append(incoming[e.Source], e)
+		}
+	}
+	for callerKey := range redirects {
+		if _, ok := incoming[callerKey]; !ok {
+			return nil, common.NewError(common.CodeValidationFailed, "%s does not call %s", callerKey, targetKey)
+		}
+	}
+
+	result := &RemoveSimulationResult{
+		Target:            nodeToOutRef(snapshot.Nodes[targetKey], targetKey),
+		Confidence:        "high",
+		Warnings:          []string{},
+		LatencyMetric:     latencyMetric,
+		ScalingModel:      model,
+		Redirects:         []RedirectImpact{},
+		Replacements:      []ReplacementLoad{},
+		UnmigratedCallers: []AffectedCaller{},
+		DroppedCalls:      []DroppedCall{},
+		Recommendations:   []FailureRecommendation{},
+	}
+
+	callerKeys := make([]string, 0, len(incoming))
+	for k := range incoming {
+		callerKeys = append(callerKeys, k)
+	}
+	sort.Strings(callerKeys)
+
+	added := map[string]float64{}
+	for _, callerKey := range callerKeys {
+		var rate, errRate float64
+		for _, e := range incoming[callerKey] {
+			rate += e.Rate
+			errRate = math.Max(errRate, e.ErrorRate)
+		}
+		replacementKey, ok := redirects[callerKey]
+		if !ok {
+			ref := nodeToOutRef(snapshot.Nodes[callerKey], callerKey)
+			result.UnmigratedCallers = append(result.UnmigratedCallers, AffectedCaller{
+				ServiceId:      callerKey,
+				Name:           ref.Name,
+				Namespace:      ref.Namespace,
+				LostTrafficRps: rate,
+				EdgeErrorRate:  errRate,
+			})
+			result.LostTrafficRps += rate
+			continue
+		}
+		added[replacementKey] += rate
+		result.Redirects = append(result.Redirects, RedirectImpact{
+			CallerId:      callerKey,
+			ReplacementId: replacementKey,
+			Rps:           rate,
+			BeforeMs:      computeWeightedMeanLatency(incoming[callerKey], latencyMetric, nil),
+		})
+	}
+
+	replacementKeys := make([]string, 0, len(added))
+	for k := range added {
+		replacementKeys = append(replacementKeys, k)
+	}
+	sort.Strings(replacementKeys)
+
+	projected := map[string]*float64{}
+	for _, key := range replacementKeys {
+		load, err := projectReplacementLoad(ctx, client, key, byID[key].PodCount, added[key], latencyMetric, calibratedModel(calibrations, key, latencyMetric, model))
+		if err != nil {
+			return nil, err
+		}
+		if load.ProjectedLatencyMs == nil {
+			result.Confidence = "medium"
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s has no %s latency on its current calls; its projected latency is unknown.", key, latencyMetric))
+		}
+		projected[key] = load.ProjectedLatencyMs
+		result.Replacements = append(result.Replacements, *load)
+	}
+	for i := range result.Redirects {
+		r := &result.Redirects[i]
+		r.AfterMs = projected[r.ReplacementId]
+		if r.BeforeMs != nil && r.AfterMs != nil {
+			d := *r.AfterMs - *r.BeforeMs
+			r.DeltaMs = &d
+		}
+	}
+
+	for _, e := range snapshot.OutgoingEdges[targetKey] {
+		if e.Target != targetKey {
+			result.DroppedCalls = append(result.DroppedCalls, DroppedCall{ServiceId: e.Target, Rps: e.Rate})
+		}
+	}
+	sort.Slice(result.DroppedCalls, func(i, j int) bool { return result.DroppedCalls[i].Rps > result.DroppedCalls[j].Rps })
+
+	result.FreedCapacity = freedCapacity(target)
+
+	result.Recommendations = removeRecommendations(result, targetKey)
+	switch {
+	case len(callerKeys) == 0:
+		result.Explanation = fmt.Sprintf("%s has no callers; removing it frees %d pod(s) without redirecting traffic.", targetKey, result.FreedCapacity.Pods)
+	case len(result.UnmigratedCallers) > 0:
+		result.Explanation = fmt.Sprintf("%d of %d caller(s) have no replacement; %.2f rps would fail after removal.", len(result.UnmigratedCallers), len(callerKeys), result.LostTrafficRps)
+	default:
+		result.Explanation = fmt.Sprintf("All %d caller(s) are redirected to %d replacement(s); removal frees %d pod(s).", len(callerKeys), len(replacementKeys), result.FreedCapacity.Pods)
+	}
+	return result, nil
+}
+
+// projectReplacementLoad treats addedRps as a load factor f on the
+// replacement, equivalent to dividing its pods by f under the scaling model:
+// bounded_sqrt latency grows by alpha + (1-alpha)*sqrt(f), never below
+// MinLatencyFactor, and linear latency by f.
+func projectReplacementLoad(ctx context.Context, client GraphSource, key string, pods int, addedRps float64, latencyMetric string, model ScalingModel) (*ReplacementLoad, error) {
+	neighborhood, err := client.GetNeighborhood(ctx, key, 1)
+	if err != nil {
+		return nil, neighborhoodError(err, key)
+	}
+	snapshot := buildSnapshot(neighborhood)
+
+	var inEdges []*Edge
+	load := &ReplacementLoad{ServiceId: key, PodCount: pods, AddedRps: addedRps, SuggestedPods: pods, ScalingModel: model}
+	for _, e := range snapshot.IncomingEdges[key] {
+		if e.Source != key {
+			inEdges = append(inEdges, e)
+			load.CurrentRps += e.Rate
+		}
+	}
+	load.ProjectedRps = load.CurrentRps + addedRps
+	if load.CurrentRps <= 0 {
+		return load, nil
+	}
+
+	f := load.ProjectedRps / load.CurrentRps
+	load.LoadFactor = &f
+	if pods > 0 {
+		load.SuggestedPods = int(math.Ceil(float64(pods)*f - 1e-9))
+	}
+	load.CurrentLatencyMs = computeWeightedMeanLatency(inEdges, latencyMetric, nil)
+	if load.CurrentLatencyMs != nil {
+		factor := f
+		if model.Type == "bounded_sqrt" {
+			factor = math.Max(*model.Alpha+(1-*model.Alpha)*math.Sqrt(f), *model.MinLatencyFactor)
+		}
+		after := *load.CurrentLatencyMs * factor
+		delta := after - *load.CurrentLatencyMs
+		load.ProjectedLatencyMs, load.DeltaMs = &after, &delta
+	}
+	return load, nil
+}
+
+func freedCapacity(target graph.ServiceInfo) FreedCapacity {
+	freed := FreedCapacity{Nodes: []NodeRelease{}}
+	byNode := map[string]*NodeRelease{}
+	var order []string
+	for _, placement := range target.Placement.Nodes {
+		if placement.Node == "" {
+			continue
+		}
+		cores := float64(placement.Resources.CPU.Cores)
+		n, ok := byNode[placement.Node]
+		if !ok {
+			cpuAvail := math.Max(0, cores-(placement.Resources.CPU.UsagePercent/100.0)*cores)
+			ramAvail := math.Max(0, placement.Resources.RAM.TotalMB-placement.Resources.RAM.UsedMB)
+			n = &NodeRelease{
+				Node:                 placement.Node,
+				CPUTotal:             cores,
+				RAMTotalMB:           placement.Resources.RAM.TotalMB,
+				CPUAvailableBefore:   toFixed(cpuAvail, 2),
+				RAMAvailableBeforeMB: toFixed(ramAvail, 2),
+			}
+			byNode[placement.Node] = n
+			order = append(order, placement.Node)
+		}
+		for _, pod := range placement.Pods {
+			n.PodsRemoved++
+			n.CPUFreedCores += pod.CPUUsagePercent / 100.0 * cores
+			n.RAMFreedMB += pod.RAMUsedMB
+		}
+	}
+
+	for _, name := range order {
+		n := byNode[name]
+		n.CPUFreedCores = toFixed(n.CPUFreedCores, 2)
+		n.RAMFreedMB = toFixed(n.RAMFreedMB, 2)
+		n.CPUAvailableAfter = toFixed(math.Min(n.CPUTotal, n.CPUAvailableBefore+n.CPUFreedCores), 2)
+		n.RAMAvailableAfterMB = toFixed(math.Min(n.RAMTotalMB, n.RAMAvailableBeforeMB+n.RAMFreedMB), 2)
+		freed.Pods += n.PodsRemoved
+		freed.CPUCores += n.CPUFreedCores
+		freed.RAMMB += n.RAMFreedMB
+		freed.Nodes = append(freed.Nodes, *n)
+	}
+	sort.Slice(freed.Nodes, func(i, j int) bool { return freed.Nodes[i].Node < freed.Nodes[j].Node })
+	freed.CPUCores = toFixed(freed.CPUCores, 2)
+	freed.RAMMB = toFixed(freed.RAMMB, 2)
+	return freed
+}
+
+func removeRecommendations(result *RemoveSimulationResult, targetKey string) []FailureRecommendation {
+	recs := []FailureRecommendation{}
+	for _, c := range result.UnmigratedCallers {
+		priority := "high"
+		if c.LostTrafficRps > 0 {
+			priority = "critical"
+		}
+		recs = append(recs, FailureRecommendation{
+			Type:        "migration",
+			Priority:    priority,
+			Target:      c.ServiceId,
+			Reason:      fmt.Sprintf("%s still sends %.2f rps to %s and has no replacement.", c.ServiceId, c.LostTrafficRps, targetKey),
+			Action:      fmt.Sprintf("Migrate %s to a replacement before removing %s.", c.ServiceId, targetKey),
+			Description: fmt.Sprintf("Migrate %s (%.2f rps) before removing %s.", c.ServiceId, c.LostTrafficRps, targetKey),
+		})
+	}
+	for _, r := range result.Replacements {
+		if r.SuggestedPods <= r.PodCount {
+			continue
+		}
+		recs = append(recs, FailureRecommendation{
+			Type:        "scaling",
+			Priority:    "high",
+			Target:      r.ServiceId,
+			Reason:      fmt.Sprintf("%s takes on %.2f rps on top of its current %.2f rps.", r.ServiceId, r.AddedRps, r.CurrentRps),
+			Action:      fmt.Sprintf("Scale %s from %d to %d pods to keep its load per pod unchanged.", r.ServiceId, r.PodCount, r.SuggestedPods),
+			Description: fmt.Sprintf("Scale %s from %d to %d pods before redirecting traffic.", r.ServiceId, r.PodCount, r.SuggestedPods),
+		})
+	}
+	if len(result.DroppedCalls) > 0 {
+		var ids []string
+		for _, d := range result.DroppedCalls {
+			ids = append(ids, d.ServiceId)
+		}
+		recs = append(recs, FailureRecommendation{
+			Type:        "dependency",
+			Priority:    "medium",
+			Target:      targetKey,
+			Reason:      fmt.Sprintf("%s calls %s; those calls stop unless a replacement makes them.", targetKey, strings.Join(ids, ", ")),
+			Action:      "Confirm the replacements cover the work these calls did.",
+			Description: fmt.Sprintf("Check that removing %s's calls to %s is intended.", targetKey, strings.Join(ids, ", ")),
+		})
+	}
+	if len(recs) == 0 {
+		recs = append(recs, FailureRecommendation{
+			Type:        "decommission",
+			Priority:    "low",
+			Target:      targetKey,
+			Reason:      "No caller depends on the service and no replacement needs more capacity.",
+			Action:      fmt.Sprintf("Remove %s.", targetKey),
+			Description: fmt.Sprintf("%s can be removed safely.", targetKey),
+		})
+	}
+	return recs
+}
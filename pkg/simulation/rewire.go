@@ -0,0 +1,684 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"predictive-analysis-engine/pkg/analysis"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/config"
+)
+
+// SimulateRewire applies edge mutations to a copy of the graph around the
+// mutated services and compares it with the original: load per service,
+// latency of the mutated callers and their paths, and the cycles and single
+// points of failure the mutations create or remove.
+func SimulateRewire(ctx context.Context, client GraphSource, cfg *config.Config, req RewireSimulationRequest) (*RewireSimulationResult, error) {
+	if len(req.Mutations) == 0 {
+		return nil, common.NewError(common.CodeValidationFailed, "mutations must not be empty")
+	}
+	depth := req.Depth
+	if depth == 0 {
+		depth = cfg.Simulation.MaxTraversalDepth
+	}
+	if depth < 1 || depth > 3 {
+		return nil, common.NewError(common.CodeDepthOutOfRange, "depth must be integer 1, 2, or 3. Got: %d", depth)
+	}
+	latencyMetric := req.LatencyMetric
+	if latencyMetric == "" {
+		latencyMetric = cfg.Simulation.DefaultLatencyMetric
+	}
+	if latencyMetric != "p50" && latencyMetric != "p95" && latencyMetric != "p99" {
+		return nil, common.NewError(common.CodeInvalidLatencyMetric, "Invalid latencyMetric: %s", latencyMetric)
+	}
+
+	mutations := make([]EdgeMutation, len(req.Mutations))
+	var services []string
+	for i, m := range req.Mutations {
+		if err := validateMutation(i, m); err != nil {
+			return nil, err
+		}
+		m.Source, m.Target = CanonicalServiceId(m.Source), CanonicalServiceId(m.Target)
+		if m.From != "" {
+			m.From = CanonicalServiceId(m.From)
+		}
+		mutations[i] = m
+		for _, id := range []string{m.Source, m.Target, m.From} {
+			if id != "" && !slices.Contains(services, id) {
+				services = append(services, id)
+			}
+		}
+	}
+
+	before := &GraphSnapshot{
+		Nodes:         map[string]*Node{},
+		IncomingEdges: map[string][]*Edge{},
+		OutgoingEdges: map[string][]*Edge{},
+	}
+	for _, id := range services {
+		neighborhood, err := client.GetNeighborhood(ctx, id, depth)
+		if err != nil {
+			return nil, neighborhoodError(err, id)
+		}
+		mergeSnapshot(before, buildSnapshot(neighborhood))
+	}
+
+	after := cloneSnapshot(before)
+	result := &RewireSimulationResult{
+		Neighborhood: NeighborhoodMeta{
+			Description:  fmt.Sprintf("%d-hop neighborhoods of %s", depth, strings.Join(services, ", ")),
+			ServiceCount: len(before.Nodes),
+			EdgeCount:    len(before.Edges),
+			DepthUsed:    depth,
+			GeneratedAt:  time.Now().UTC().Format(time.RFC3339),
+		},
+		Confidence: "high",
+		Warnings: []string{
+			fmt.Sprintf("Cycles and single points of failure are only analyzed within the merged %d-hop neighborhoods of the mutated services.", depth),
+		},
+		LatencyMetric: latencyMetric,
+		Assumptions: []string{
+			"Load changes are not propagated to the downstream calls of the services that receive or lose traffic.",
+			"Edge latencies do not change with load; a new edge takes the rate-weighted latency of the target's existing incoming calls.",
+		},
+		Mutations:       []AppliedMutation{},
+		Recommendations: []FailureRecommendation{},
+	}
+	for i, m := range mutations {
+		applied, warning, err := applyMutation(before, after, i, m)
+		if err != nil {
+			return nil, err
+		}
+		if warning != "" {
+			result.Confidence = "medium"
+			result.Warnings = append(result.Warnings, warning)
+		}
+		result.Mutations = append(result.Mutations, applied)
+	}
+
+	result.ServiceLoad = serviceLoadChanges(before, after)
+	var callers []string
+	for _, m := range mutations {
+		if !slices.Contains(callers, m.Source) {
+			callers = append(callers, m.Source)
+		}
+	}
+	sort.Strings(callers)
+	result.CallerLatency = []CallerLatencyChange{}
+	for _, id := range callers {
+		c := CallerLatencyChange{
+			ServiceId: id,
+			BeforeMs:  computeWeightedMeanLatency(before.OutgoingEdges[id], latencyMetric, nil),
+			AfterMs:   computeWeightedMeanLatency(after.OutgoingEdges[id], latencyMetric, nil),
+		}
+		if c.BeforeMs != nil && c.AfterMs != nil {
+			d := *c.AfterMs - *c.BeforeMs
+			c.DeltaMs = &d
+		}
+		result.CallerLatency = append(result.CallerLatency, c)
+	}
+	result.PathChanges = pathChanges(before, after, callers, depth, latencyMetric, cfg.Simulation.MaxPathsReturned)
+
+	result.NewCycles, result.RemovedCycles, result.ChangedComponents = cycleChanges(before, after)
+
+	beforeSPOFs := singlePointsOfFailure(before)
+	afterSPOFs := singlePointsOfFailure(after)
+	result.NewSPOFs = spofDifference(afterSPOFs, beforeSPOFs)
+	result.ResolvedSPOFs = spofDifference(beforeSPOFs, afterSPOFs)
+
+	result.Recommendations = rewireRecommendations(result, before)
+	result.Explanation = fmt.Sprintf("Applied %d mutation(s): %d service(s) change load, %d new cycle(s), %d new single point(s) of failure.",
+		len(result.Mutations), len(result.ServiceLoad), len(result.NewCycles), len(result.NewSPOFs))
+	return result, nil
+}
+
+func validateMutation(i int, m EdgeMutation) error {
+	if m.Source == "" || m.Target == "" {
+		return common.NewError(common.CodeValidationFailed, "mutations[%d]: source and target are required", i)
+	}
+	if CanonicalServiceId(m.Source) == CanonicalServiceId(m.Target) {
+		return common.NewError(common.CodeValidationFailed, "mutations[%d]: source and target must differ", i)
+	}
+	switch m.Op {
+	case RewireAddEdge:
+		if (m.From == "") == (m.Rate == nil) {
+			return common.NewError(common.CodeValidationFailed, "mutations[%d]: add_edge needs exactly one of from or rate", i)
+		}
+		if m.Percent != nil && (m.From == "" || *m.Percent <= 0 || *m.Percent > 100) {
+			return common.NewError(common.CodeValidationFailed, "mutations[%d]: percent must be in (0, 100] and requires from", i)
+		}
+		// Moving traffic from an edge onto itself, or off a self-call, would
+		// remove the edge it is about to add to.
+		if m.From != "" && CanonicalServiceId(m.From) == CanonicalServiceId(m.Target) {
+			return common.NewError(common.CodeInvalidParameter, "mutations[%d]: from and target must differ; %s -> %s cannot be rerouted onto itself", i, m.Source, m.Target)
+		}
+		if m.From != "" && CanonicalServiceId(m.From) == CanonicalServiceId(m.Source) {
+			return common.NewError(common.CodeInvalidParameter, "mutations[%d]: from must differ from source; %s does not call itself", i, m.Source)
+		}
+	case RewireRemoveEdge:
+	case RewireSetRate:
+		if m.Rate == nil {
+			return common.NewError(common.CodeValidationFailed, "mutations[%d]: set_rate needs rate", i)
+		}
+	default:
+		return common.NewError(common.CodeValidationFailed, "mutations[%d]: invalid op %q. Allowed: %s, %s, %s", i, m.Op, RewireAddEdge, RewireRemoveEdge, RewireSetRate)
+	}
+	if m.Rate != nil && *m.Rate < 0 {
+		return common.NewError(common.CodeValidationFailed, "mutations[%d]: rate must not be negative", i)
+	}
+	return nil
+}
+
+// applyMutation changes after in place. New edges take their latencies from
+// the target's incoming calls in before.
+func applyMutation(before, after *GraphSnapshot, i int, m EdgeMutation) (AppliedMutation, string, error) {
+	applied := AppliedMutation{EdgeMutation: m}
+	edge := findEdge(after, m.Source, m.Target)
+	if edge != nil {
+		applied.BeforeRps = edge.Rate
+	}
+
+	switch m.Op {
+	case RewireRemoveEdge:
+		if edge == nil {
+			return applied, "", common.NewError(common.CodeValidationFailed, "mutations[%d]: %s does not call %s", i, m.Source, m.Target)
+		}
+		removeEdge(after, edge)
+		return applied, "", nil
+
+	case RewireSetRate:
+		if edge == nil {
+			return applied, "", common.NewError(common.CodeValidationFailed, "mutations[%d]: %s does not call %s", i, m.Source, m.Target)
+		}
+		edge.Rate = *m.Rate
+		applied.AfterRps = edge.Rate
+		return applied, "", nil
+	}
+
+	rate := 0.0
+	if m.From != "" {
+		from := findEdge(after, m.Source, m.From)
+		if from == nil {
+			return applied, "", common.NewError(common.CodeValidationFailed, "mutations[%d]: %s does not call %s", i, m.Source, m.From)
+		}
+		percent := 100.0
+		if m.Percent != nil {
+			percent = *m.Percent
+		}
+		rate = from.Rate * percent / 100
+		from.Rate -= rate
+		if percent == 100 {
+			removeEdge(after, from)
+		}
+	} else {
+		rate = *m.Rate
+	}
+
+	var warning string
+	if edge == nil {
+		edge = &Edge{Source: m.Source, Target: m.Target}
+		for _, metric := range []string{"p50", "p95", "p99"} {
+			lat := computeWeightedMeanLatency(before.IncomingEdges[m.Target], metric, nil)
+			switch metric {
+			case "p50":
+				edge.P50 = lat
+			case "p95":
+				edge.P95 = lat
+			case "p99":
+				edge.P99 = lat
+			}
+		}
+		if edge.P95 == nil {
+			warning = fmt.Sprintf("%s has no incoming calls with traffic; the latency of %s -> %s is unknown.", m.Target, m.Source, m.Target)
+		}
+		after.Edges = append(after.Edges, edge)
+		after.OutgoingEdges[edge.Source] = append(after.OutgoingEdges[edge.Source], edge)
+		after.IncomingEdges[edge.Target] = append(after.IncomingEdges[edge.Target], edge)
+	}
+	edge.Rate += rate
+	applied.AfterRps = edge.Rate
+	return applied, warning, nil
+}
+
+func mergeSnapshot(dst, src *GraphSnapshot) {
+	for id, n := range src.Nodes {
+		if _, ok := dst.Nodes[id]; !ok {
+			dst.Nodes[id] = n
+		}
+	}
+	for _, e := range src.Edges {
+		if findEdge(dst, e.Source, e.Target) != nil {
+			continue
+		}
+		dst.Edges = append(dst.Edges, e)
+		dst.OutgoingEdges[e.Source] = append(dst.OutgoingEdges[e.Source], e)
+		dst.IncomingEdges[e.Target] = append(dst.IncomingEdges[e.Target], e)
+	}
+}
+
+func cloneSnapshot(s *GraphSnapshot) *GraphSnapshot {
+	c := &GraphSnapshot{
+		Nodes:         make(map[string]*Node, len(s.Nodes)),
+		IncomingEdges: map[string][]*Edge{},
+		OutgoingEdges: map[string][]*Edge{},
+		TargetKey:     s.TargetKey,
+		DataFreshness: s.DataFreshness,
+	}
+	for id, n := range s.Nodes {
+		node := *n
+		c.Nodes[id] = &node
+	}
+	for _, e := range s.Edges {
+		edge := *e
+		c.Edges = append(c.Edges, &edge)
+		c.OutgoingEdges[edge.Source] = append(c.OutgoingEdges[edge.Source], &edge)
+		c.IncomingEdges[edge.Target] = append(c.IncomingEdges[edge.Target], &edge)
+	}
+	return c
+}
+
+func findEdge(s *GraphSnapshot, source, target string) *Edge {
+	for _, e := range s.OutgoingEdges[source] {
+		if e.Target == target {
+			return e
+		}
+	}
+	return nil
+}
+
+func removeEdge(s *GraphSnapshot, edge *Edge) {
+	without := func(edges []*Edge) []*Edge {
+		out := edges[:0]
+		for _, e := range edges {
+			if e != edge {
+				out = append(out, e)
+			}
+		}
+		return out
+	}
+	s.Edges = without(s.Edges)
+	s.OutgoingEdges[edge.Source] = without(s.OutgoingEdges[edge.Source])
+	s.IncomingEdges[edge.Target] = without(s.IncomingEdges[edge.Target])
+}
+
+func serviceLoadChanges(before, after *GraphSnapshot) []ServiceLoadChange {
+	changes := []ServiceLoadChange{}
+	for id := range after.Nodes {
+		var b, a float64
+		for _, e := range before.IncomingEdges[id] {
+			b += e.Rate
+		}
+		for _, e := range after.IncomingEdges[id] {
+			a += e.Rate
+		}
+		if math.Abs(a-b) > 1e-9 {
+			changes = append(changes, ServiceLoadChange{ServiceId: id, BeforeRps: b, AfterRps: a, DeltaRps: a - b})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if math.Abs(changes[i].DeltaRps) != math.Abs(changes[j].DeltaRps) {
+			return math.Abs(changes[i].DeltaRps) > math.Abs(changes[j].DeltaRps)
+		}
+		return changes[i].ServiceId < changes[j].ServiceId
+	})
+	return changes
+}
+
+type pathMetrics struct {
+	path       []string
+	rps        float64
+	latency    float64
+	incomplete bool
+}
+
+// pathChanges compares the paths of up to depth hops from each caller and
+// returns those that were added, removed or changed rate, largest latency
+// change first.
+func pathChanges(before, after *GraphSnapshot, callers []string, depth int, latencyMetric string, limit int) []RewiredPath {
+	beforePaths := map[string]pathMetrics{}
+	afterPaths := map[string]pathMetrics{}
+	for _, id := range callers {
+		collectPaths(before, id, depth, latencyMetric, beforePaths)
+		collectPaths(after, id, depth, latencyMetric, afterPaths)
+	}
+
+	metrics := func(p pathMetrics) (*float64, *float64) {
+		rps := p.rps
+		if p.incomplete {
+			return &rps, nil
+		}
+		lat := p.latency
+		return &rps, &lat
+	}
+	changes := []RewiredPath{}
+	for key, b := range beforePaths {
+		rp := RewiredPath{Path: b.path, IncompleteData: b.incomplete}
+		rp.BeforeRps, rp.BeforeMs = metrics(b)
+		a, ok := afterPaths[key]
+		if !ok {
+			rp.Change = "removed"
+			changes = append(changes, rp)
+			continue
+		}
+		if math.Abs(a.rps-b.rps) <= 1e-9 && a.incomplete == b.incomplete && math.Abs(a.latency-b.latency) <= 1e-9 {
+			continue
+		}
+		rp.Change = "changed"
+		rp.AfterRps, rp.AfterMs = metrics(a)
+		rp.IncompleteData = rp.IncompleteData || a.incomplete
+		changes = append(changes, rp)
+	}
+	for key, a := range afterPaths {
+		if _, ok := beforePaths[key]; ok {
+			continue
+		}
+		rp := RewiredPath{Path: a.path, Change: "added", IncompleteData: a.incomplete}
+		rp.AfterRps, rp.AfterMs = metrics(a)
+		changes = append(changes, rp)
+	}
+	for i := range changes {
+		c := &changes[i]
+		switch {
+		case c.BeforeMs != nil && c.AfterMs != nil:
+			d := *c.AfterMs - *c.BeforeMs
+			c.DeltaMs = &d
+		case c.Change == "added" && c.AfterMs != nil:
+			c.DeltaMs = c.AfterMs
+		case c.Change == "removed" && c.BeforeMs != nil:
+			d := -*c.BeforeMs
+			c.DeltaMs = &d
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		d1, d2 := changes[i].DeltaMs, changes[j].DeltaMs
+		if (d1 == nil) != (d2 == nil) {
+			return d2 == nil
+		}
+		if d1 != nil && math.Abs(*d1) != math.Abs(*d2) {
+			return math.Abs(*d1) > math.Abs(*d2)
+		}
+		return pathKey(changes[i].Path) < pathKey(changes[j].Path)
+	})
+	if limit > 0 && len(changes) > limit {
+		changes = changes[:limit]
+	}
+	return changes
+}
+
+func collectPaths(s *GraphSnapshot, start string, depth int, latencyMetric string, out map[string]pathMetrics) {
+	onPath := map[string]bool{start: true}
+	var walk func(p pathMetrics)
+	walk = func(p pathMetrics) {
+		at := p.path[len(p.path)-1]
+		if len(p.path) > 1 {
+			out[pathKey(p.path)] = p
+		}
+		if len(p.path)-1 >= depth {
+			return
+		}
+		for _, e := range s.OutgoingEdges[at] {
+			if onPath[e.Target] {
+				continue
+			}
+			next := pathMetrics{
+				path:       append(append([]string{}, p.path...), e.Target),
+				rps:        math.Min(p.rps, e.Rate),
+				latency:    p.latency,
+				incomplete: p.incomplete,
+			}
+			if lat := getEdgeLatency(e, latencyMetric); lat != nil {
+				next.latency += *lat
+			} else {
+				next.incomplete = true
+			}
+			onPath[e.Target] = true
+			walk(next)
+			delete(onPath, e.Target)
+		}
+	}
+	walk(pathMetrics{path: []string{start}, rps: math.Inf(1)})
+}
+
+func dependencyGraph(s *GraphSnapshot) *analysis.DependencyGraph {
+	g := &analysis.DependencyGraph{
+		Nodes:    map[string]*analysis.DependencyNode{},
+		Outgoing: map[string][]analysis.DependencyEdge{},
+		Incoming: map[string][]analysis.DependencyEdge{},
+	}
+	for id, n := range s.Nodes {
+		g.Nodes[id] = &analysis.DependencyNode{ID: id, Name: n.Name, Namespace: n.Namespace}
+	}
+	for _, e := range s.Edges {
+		de := analysis.DependencyEdge{Source: e.Source, Target: e.Target, RPS: e.Rate, ErrorRate: e.ErrorRate}
+		g.Outgoing[e.Source] = append(g.Outgoing[e.Source], de)
+		g.Incoming[e.Target] = append(g.Incoming[e.Target], de)
+	}
+	for _, edges := range g.Outgoing {
+		sort.Slice(edges, func(i, j int) bool { return edges[i].Target < edges[j].Target })
+	}
+	return g
+}
+
+// cycleChanges compares the cycles of before and after. Cycles are compared
+// one by one only where neither side's component was truncated: a truncated
+// component lists an arbitrary subset of its cycles, so two of them cannot
+// be diffed. Those components are compared by members and internal edges
+// instead, and reported as changed components.
+func cycleChanges(before, after *GraphSnapshot) ([]analysis.Cycle, []analysis.Cycle, []CycleComponentChange) {
+	beforeComponents := analysis.AnalyzeCycles(dependencyGraph(before)).Components
+	afterComponents := analysis.AnalyzeCycles(dependencyGraph(after)).Components
+
+	truncated := map[string]bool{}
+	for _, scc := range slices.Concat(beforeComponents, afterComponents) {
+		if scc.CyclesTruncated {
+			for _, id := range scc.Services {
+				truncated[id] = true
+			}
+		}
+	}
+
+	cycles := func(components []analysis.StronglyConnectedComponent) map[string]analysis.Cycle {
+		out := map[string]analysis.Cycle{}
+		for _, scc := range components {
+			if truncated[scc.Services[0]] {
+				continue
+			}
+			for _, c := range scc.Cycles {
+				out[c.Key] = c
+			}
+		}
+		return out
+	}
+	beforeCycles, afterCycles := cycles(beforeComponents), cycles(afterComponents)
+	return cycleDifference(afterCycles, beforeCycles), cycleDifference(beforeCycles, afterCycles),
+		componentChanges(before, after, beforeComponents, afterComponents, truncated)
+}
+
+// componentChanges groups the components touching a truncated component on
+// either side into clusters of overlapping components, and reports each
+// cluster whose members or internal edges differ between the sides.
+func componentChanges(before, after *GraphSnapshot, beforeComponents, afterComponents []analysis.StronglyConnectedComponent, truncated map[string]bool) []CycleComponentChange {
+	var sides [2][][]string
+	for side, components := range [2][]analysis.StronglyConnectedComponent{beforeComponents, afterComponents} {
+		for _, scc := range components {
+			if truncated[scc.Services[0]] {
+				sides[side] = append(sides[side], scc.Services)
+			}
+		}
+	}
+
+	changes := []CycleComponentChange{}
+	used := [2][]bool{make([]bool, len(sides[0])), make([]bool, len(sides[1]))}
+	for side := range sides {
+		for i := range sides[side] {
+			if used[side][i] {
+				continue
+			}
+			used[side][i] = true
+			members := [2]map[string]bool{{}, {}}
+			for _, id := range sides[side][i] {
+				members[side][id] = true
+			}
+			// Absorb components of either side sharing a service with the
+			// cluster until it stops growing.
+			for grown := true; grown; {
+				grown = false
+				for other := range sides {
+					for j, services := range sides[other] {
+						if used[other][j] || !slices.ContainsFunc(services, func(id string) bool { return members[0][id] || members[1][id] }) {
+							continue
+						}
+						used[other][j], grown = true, true
+						for _, id := range services {
+							members[other][id] = true
+						}
+					}
+				}
+			}
+
+			change := CycleComponentChange{BeforeServices: sortedSet(members[0]), AfterServices: sortedSet(members[1])}
+			all := map[string]bool{}
+			for _, m := range members {
+				for id := range m {
+					all[id] = true
+				}
+			}
+			change.Services = sortedSet(all)
+			if slices.Equal(change.BeforeServices, change.AfterServices) && slices.Equal(internalEdges(before, all), internalEdges(after, all)) {
+				continue
+			}
+			changes = append(changes, change)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Services[0] < changes[j].Services[0] })
+	return changes
+}
+
+func internalEdges(s *GraphSnapshot, members map[string]bool) []string {
+	var out []string
+	for _, e := range s.Edges {
+		if members[e.Source] && members[e.Target] {
+			out = append(out, e.Source+"->"+e.Target)
+		}
+	}
+	sort.Strings(out)
+	return slices.Compact(out)
+}
+
+func sortedSet(m map[string]bool) []string {
+	out := make([]string, 0, len(m))
+	for id := range m {
+		out = append(out, id)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func cycleDifference(a, b map[string]analysis.Cycle) []analysis.Cycle {
+	out := []analysis.Cycle{}
+	for key, c := range a {
+		if _, ok := b[key]; !ok {
+			out = append(out, c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+// singlePointsOfFailure finds the services other than entry points whose
+// failure leaves some other service unreachable from every entry point.
+func singlePointsOfFailure(s *GraphSnapshot) map[string]SinglePointOfFailure {
+	entrypoints := pickEntrypoints(s, "")
+	reachable := computeReachableNodes(s, entrypoints, "")
+	spofs := map[string]SinglePointOfFailure{}
+	for id := range s.Nodes {
+		if len(s.IncomingEdges[id]) == 0 || !reachable[id] {
+			continue
+		}
+		without := computeReachableNodes(s, entrypoints, id)
+		var cut []string
+		for other := range reachable {
+			if other != id && !without[other] {
+				cut = append(cut, other)
+			}
+		}
+		if len(cut) > 0 {
+			sort.Strings(cut)
+			spofs[id] = SinglePointOfFailure{ServiceId: id, CutOff: cut}
+		}
+	}
+	return spofs
+}
+
+func spofDifference(a, b map[string]SinglePointOfFailure) []SinglePointOfFailure {
+	out := []SinglePointOfFailure{}
+	for id, s := range a {
+		if _, ok := b[id]; !ok {
+			out = append(out, s)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ServiceId < out[j].ServiceId })
+	return out
+}
+
+func rewireRecommendations(result *RewireSimulationResult, before *GraphSnapshot) []FailureRecommendation {
+	recs := []FailureRecommendation{}
+	for _, c := range result.NewCycles {
+		// A new cycle has at least one edge the original graph lacks.
+		source, target := c.Path[0], c.Path[1]
+		for i := 0; i < len(c.Path)-1; i++ {
+			if findEdge(before, c.Path[i], c.Path[i+1]) == nil {
+				source, target = c.Path[i], c.Path[i+1]
+				break
+			}
+		}
+		recs = append(recs, FailureRecommendation{
+			Type:        "topology",
+			Priority:    "high",
+			Target:      source,
+			Reason:      fmt.Sprintf("The rewiring creates the cycle %s; a slowdown anywhere on it can feed back into itself.", strings.Join(c.Path, " -> ")),
+			Action:      fmt.Sprintf("Reconsider the new call %s -> %s, which closes the cycle.", source, target),
+			Description: fmt.Sprintf("New dependency cycle: %s.", strings.Join(c.Path, " -> ")),
+		})
+	}
+	for _, c := range result.ChangedComponents {
+		recs = append(recs, FailureRecommendation{
+			Type:        "topology",
+			Priority:    "medium",
+			Target:      c.Services[0],
+			Reason:      fmt.Sprintf("The rewiring changes the cycle structure of the component %s, which has too many cycles to compare one by one.", strings.Join(c.Services, ", ")),
+			Action:      "Review the calls the mutations add or remove between these services.",
+			Description: fmt.Sprintf("Cycle structure changed in component %s.", strings.Join(c.Services, ", ")),
+		})
+	}
+	for _, s := range result.NewSPOFs {
+		recs = append(recs, FailureRecommendation{
+			Type:        "redundancy",
+			Priority:    "high",
+			Target:      s.ServiceId,
+			Reason:      fmt.Sprintf("After the rewiring, %s is the only way to reach %s.", s.ServiceId, strings.Join(s.CutOff, ", ")),
+			Action:      fmt.Sprintf("Add replicas of %s or an alternative route before rewiring.", s.ServiceId),
+			Description: fmt.Sprintf("%s becomes a single point of failure.", s.ServiceId),
+		})
+	}
+	for _, c := range result.CallerLatency {
+		if c.DeltaMs == nil || *c.DeltaMs <= 0 {
+			continue
+		}
+		recs = append(recs, FailureRecommendation{
+			Type:        "latency",
+			Priority:    "medium",
+			Target:      c.ServiceId,
+			Reason:      fmt.Sprintf("The calls %s makes get %.1fms slower on average.", c.ServiceId, *c.DeltaMs),
+			Action:      "Check the new targets' latency before moving traffic.",
+			Description: fmt.Sprintf("%s latency rises by %.1fms.", c.ServiceId, *c.DeltaMs),
+		})
+	}
+	return recs
+}
@@ -0,0 +1,206 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/config"
+)
+
+// fakeGraph serves the same neighborhood, the whole graph, for every
+// service, and its services with their placements.
+type fakeGraph struct {
+	nodes    []graph.GraphNode
+	edges    []graph.GraphEdge
+	services []graph.ServiceInfo
+}
+
+func (f *fakeGraph) CheckHealth(ctx context.Context) (*graph.HealthResponse, error) {
+	return &graph.HealthResponse{}, nil
+}
+
+func (f *fakeGraph) GetServices(ctx context.Context) ([]graph.ServiceInfo, error) {
+	return f.services, nil
+}
+
+func (f *fakeGraph) GetNeighborhood(ctx context.Context, serviceName string, k int) (*graph.NeighborhoodResponse, error) {
+	return &graph.NeighborhoodResponse{Center: serviceName, K: k, Nodes: f.nodes, Edges: f.edges}, nil
+}
+
+// callGraph builds a fakeGraph from edges written "source>target:rps" with
+// a p95 latency of 10ms each.
+func callGraph(t *testing.T, edges ...string) *fakeGraph {
+	t.Helper()
+	f := &fakeGraph{}
+	seen := map[string]bool{}
+	for _, spec := range edges {
+		var from, to string
+		var rate float64
+		endpoints, rps, _ := strings.Cut(spec, ":")
+		from, to, ok := strings.Cut(endpoints, ">")
+		if _, err := fmt.Sscan(rps, &rate); !ok || err != nil {
+			t.Fatalf("bad edge %q", spec)
+		}
+		for _, name := range []string{from, to} {
+			if !seen[name] {
+				seen[name] = true
+				f.nodes = append(f.nodes, graph.GraphNode{Name: name, Namespace: "default", PodCount: 1})
+			}
+		}
+		f.edges = append(f.edges, graph.GraphEdge{From: from, To: to, Rate: rate, P50: 5, P95: 10, P99: 20})
+	}
+	return f
+}
+
+func testSimulationConfig() *config.Config {
+	return &config.Config{Simulation: config.SimulationConfig{
+		DefaultLatencyMetric: "p95",
+		MaxTraversalDepth:    2,
+		ScalingModel:         "bounded_sqrt",
+		ScalingAlpha:         0.5,
+		MinLatencyFactor:     0.6,
+		MaxPathsReturned:     10,
+	}}
+}
+
+func rate(v float64) *float64 { return &v }
+
+func cycleKeysOf(r *RewireSimulationResult) (added, removed []string) {
+	for _, c := range r.NewCycles {
+		added = append(added, c.Key)
+	}
+	for _, c := range r.RemovedCycles {
+		removed = append(removed, c.Key)
+	}
+	return added, removed
+}
+
+func completeGraph(n int) []string {
+	var edges []string
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j {
+				edges = append(edges, fmt.Sprintf("s%d>s%d:1", i, j))
+			}
+		}
+	}
+	return edges
+}
+
+func TestSimulateRewire(t *testing.T) {
+	tests := []struct {
+		name      string
+		edges     []string
+		mutations []EdgeMutation
+		check     func(t *testing.T, r *RewireSimulationResult)
+	}{
+		{
+			name:  "remove then add the same edge",
+			edges: []string{"a>b:10", "b>c:10"},
+			mutations: []EdgeMutation{
+				{Op: RewireRemoveEdge, Source: "a", Target: "b"},
+				{Op: RewireAddEdge, Source: "a", Target: "b", Rate: rate(4)},
+			},
+			check: func(t *testing.T, r *RewireSimulationResult) {
+				if r.Mutations[0].BeforeRps != 10 || r.Mutations[1].BeforeRps != 0 || r.Mutations[1].AfterRps != 4 {
+					t.Errorf("mutations = %+v", r.Mutations)
+				}
+				i := slices.IndexFunc(r.ServiceLoad, func(l ServiceLoadChange) bool { return l.ServiceId == "default:b" })
+				if i < 0 || r.ServiceLoad[i].BeforeRps != 10 || r.ServiceLoad[i].AfterRps != 4 {
+					t.Errorf("service load = %+v, want b from 10 to 4 rps", r.ServiceLoad)
+				}
+				if added, removed := cycleKeysOf(r); len(added)+len(removed) != 0 {
+					t.Errorf("cycles added %v, removed %v", added, removed)
+				}
+			},
+		},
+		{
+			name:      "new edge closes a cycle",
+			edges:     []string{"a>b:10", "b>c:10"},
+			mutations: []EdgeMutation{{Op: RewireAddEdge, Source: "c", Target: "a", Rate: rate(1)}},
+			check: func(t *testing.T, r *RewireSimulationResult) {
+				added, removed := cycleKeysOf(r)
+				if !slices.Equal(added, []string{"default:a->default:b->default:c->default:a"}) || len(removed) != 0 {
+					t.Errorf("cycles added %v, removed %v", added, removed)
+				}
+				i := slices.IndexFunc(r.Recommendations, func(rec FailureRecommendation) bool { return rec.Type == "topology" })
+				if i < 0 || r.Recommendations[i].Target != "default:c" || r.Recommendations[i].Priority != "high" {
+					t.Errorf("recommendations = %+v, want a topology one on c", r.Recommendations)
+				}
+			},
+		},
+		{
+			name:      "removing an edge breaks a cycle",
+			edges:     []string{"a>b:10", "b>a:2", "b>c:10"},
+			mutations: []EdgeMutation{{Op: RewireRemoveEdge, Source: "b", Target: "a"}},
+			check: func(t *testing.T, r *RewireSimulationResult) {
+				added, removed := cycleKeysOf(r)
+				if len(added) != 0 || !slices.Equal(removed, []string{"default:a->default:b->default:a"}) {
+					t.Errorf("cycles added %v, removed %v", added, removed)
+				}
+			},
+		},
+		{
+			name:      "truncated component compared by structure",
+			edges:     completeGraph(6),
+			mutations: []EdgeMutation{{Op: RewireRemoveEdge, Source: "s0", Target: "s1"}},
+			check: func(t *testing.T, r *RewireSimulationResult) {
+				if added, removed := cycleKeysOf(r); len(added)+len(removed) != 0 {
+					t.Errorf("cycles of a truncated component diffed: added %v, removed %v", added, removed)
+				}
+				if len(r.ChangedComponents) != 1 || len(r.ChangedComponents[0].Services) != 6 {
+					t.Errorf("changed components = %+v, want the six services", r.ChangedComponents)
+				}
+			},
+		},
+		{
+			name:      "truncated component with only a rate change",
+			edges:     completeGraph(6),
+			mutations: []EdgeMutation{{Op: RewireSetRate, Source: "s0", Target: "s1", Rate: rate(3)}},
+			check: func(t *testing.T, r *RewireSimulationResult) {
+				if added, removed := cycleKeysOf(r); len(added)+len(removed) != 0 || len(r.ChangedComponents) != 0 {
+					t.Errorf("cycles added %v, removed %v, changed components %+v", added, removed, r.ChangedComponents)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := SimulateRewire(t.Context(), callGraph(t, tt.edges...), testSimulationConfig(), RewireSimulationRequest{Mutations: tt.mutations})
+			if err != nil {
+				t.Fatalf("SimulateRewire: %v", err)
+			}
+			if len(r.Warnings) == 0 || !strings.Contains(r.Warnings[0], "neighborhoods") {
+				t.Errorf("warnings = %v, want the neighborhood scope", r.Warnings)
+			}
+			tt.check(t, r)
+		})
+	}
+}
+
+func TestSimulateRewireRejects(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutation EdgeMutation
+		code     common.ErrorCode
+	}{
+		{"missing target", EdgeMutation{Op: RewireRemoveEdge, Source: "a"}, common.CodeValidationFailed},
+		{"unknown op", EdgeMutation{Op: "swap", Source: "a", Target: "b"}, common.CodeValidationFailed},
+		{"reroute onto itself", EdgeMutation{Op: RewireAddEdge, Source: "a", Target: "b", From: "b"}, common.CodeInvalidParameter},
+		{"reroute a self-call", EdgeMutation{Op: RewireAddEdge, Source: "a", Target: "c", From: "a"}, common.CodeInvalidParameter},
+		{"edge that does not exist", EdgeMutation{Op: RewireRemoveEdge, Source: "b", Target: "a"}, common.CodeValidationFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SimulateRewire(t.Context(), callGraph(t, "a>b:10", "b>c:10"), testSimulationConfig(), RewireSimulationRequest{Mutations: []EdgeMutation{tt.mutation}})
+			if common.CodeOf(err) != tt.code {
+				t.Errorf("error = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
@@ -2,16 +2,53 @@ package simulation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 	"time"
 
-	"predictive-analysis-engine/pkg/clients/graph"
+	"predictive-analysis-engine/pkg/common"
 	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/logger"
+	"predictive-analysis-engine/pkg/storage"
 )
 
-func SimulateScaling(ctx context.Context, client *graph.Client, cfg *config.Config, req ScalingSimulationRequest) (*ScalingSimulationResult, error) {
+// CalibrationSource looks up fitted bounded_sqrt parameters by canonical
+// service ID. It is satisfied by storage.CalibrationStore.
+type CalibrationSource interface {
+	GetCalibration(serviceID string) (*storage.ScalingCalibration, error)
+}
+
+// EdgeKey identifies an edge by canonical service IDs.
+type EdgeKey struct {
+	Source string
+	Target string
+}
+
+// EdgeForecast is the predicted request rate and latency of an edge.
+type EdgeForecast struct {
+	Rate      float64
+	LatencyMs float64
+}
+
+// EdgeForecaster predicts edge metrics at a future time. Edges without
+// enough history are left out of the result.
+type EdgeForecaster interface {
+	ForecastEdges(ctx context.Context, edges []EdgeKey, latencyMetric string, at time.Time) (map[EdgeKey]EdgeForecast, error)
+	MaxHorizon() time.Duration
+}
+
+const forecastBaselinePrefix = "forecast@"
+
+// SimulateScaling projects the latency impact of changing the pod count of
+// a service. When the request does not specify a model, parameters fitted by
+// the calibration job are used if calibrations has one for the target. A
+// forecast baseline replaces the snapshot's edge metrics with forecaster's
+// predictions for the requested time. Latency SLOs from slos that the
+// projected latencies would exceed are reported in the result.
+func SimulateScaling(ctx context.Context, client GraphSource, cfg *config.Config, req ScalingSimulationRequest, calibrations CalibrationSource, forecaster EdgeForecaster, slos SLOSource) (*ScalingSimulationResult, error) {
 
 	maxDepth := req.MaxDepth
 	if maxDepth == 0 {
@@ -19,7 +56,7 @@ func SimulateScaling(ctx context.Context, client *graph.Client, cfg *config.Conf
 	}
 
 	if maxDepth < 1 || maxDepth > 3 {
-		return nil, fmt.Errorf("maxDepth must be integer 1, 2, or 3. Got: %d", maxDepth)
+		return nil, common.NewError(common.CodeDepthOutOfRange, "maxDepth must be integer 1, 2, or 3. Got: %d", maxDepth)
 	}
 
 	latencyMetric := req.LatencyMetric
@@ -27,46 +64,74 @@ func SimulateScaling(ctx context.Context, client *graph.Client, cfg *config.Conf
 		latencyMetric = cfg.Simulation.DefaultLatencyMetric
 	}
 	if latencyMetric != "p50" && latencyMetric != "p95" && latencyMetric != "p99" {
-		return nil, fmt.Errorf("Invalid latencyMetric: %s", latencyMetric)
+		return nil, common.NewError(common.CodeInvalidLatencyMetric, "Invalid latencyMetric: %s", latencyMetric)
 	}
 
 	if req.CurrentPods <= 0 {
-		return nil, fmt.Errorf("currentPods must be a positive integer. Got: %d", req.CurrentPods)
+		return nil, common.NewError(common.CodeInvalidPodCount, "currentPods must be a positive integer. Got: %d", req.CurrentPods)
 	}
 	if req.NewPods <= 0 {
-		return nil, fmt.Errorf("newPods must be a positive integer. Got: %d", req.NewPods)
+		return nil, common.NewError(common.CodeInvalidPodCount, "newPods must be a positive integer. Got: %d", req.NewPods)
 	}
 
-	modelType := cfg.Simulation.ScalingModel
+	model := ScalingModel{Type: cfg.Simulation.ScalingModel, Source: "config"}
 	alpha := cfg.Simulation.ScalingAlpha
+	minLatencyFactor := cfg.Simulation.MinLatencyFactor
 	if req.Model != nil {
+		model.Source = "request"
 		if req.Model.Type != "" {
-			modelType = req.Model.Type
+			model.Type = req.Model.Type
 		}
 		if req.Model.Alpha != nil {
 			alpha = *req.Model.Alpha
 		}
+		if req.Model.MinLatencyFactor != nil {
+			minLatencyFactor = *req.Model.MinLatencyFactor
+		}
 	}
 	if alpha < 0 || alpha > 1 {
-		return nil, fmt.Errorf("alpha must be between 0 and 1")
+		return nil, common.NewError(common.CodeInvalidAlpha, "alpha must be between 0 and 1")
+	}
+	if minLatencyFactor < 0 || minLatencyFactor > 1 {
+		return nil, common.NewError(common.CodeValidationFailed, "minLatencyFactor must be between 0 and 1")
 	}
 
-	neighborhood, err := client.GetNeighborhood(ctx, req.ServiceId, maxDepth)
+	forecastAt, err := parseScalingBaseline(req.Baseline, forecaster)
 	if err != nil {
 		return nil, err
 	}
+
+	neighborhood, err := client.GetNeighborhood(ctx, req.ServiceId, maxDepth)
+	if err != nil {
+		return nil, neighborhoodError(err, req.ServiceId)
+	}
 	snapshot := buildSnapshot(neighborhood)
 
+	var baseline *ScalingBaseline
+	if forecastAt != nil {
+		baseline, err = applyForecastBaseline(ctx, forecaster, snapshot, latencyMetric, *forecastAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	targetKey := snapshot.TargetKey
 	if targetKey == "" {
 		targetKey = req.ServiceId
 	}
 	targetNode, ok := snapshot.Nodes[targetKey]
 	if !ok {
-		return nil, fmt.Errorf("Service not found: %s", req.ServiceId)
+		return nil, common.NewError(common.CodeServiceNotFound, "Service not found: %s", req.ServiceId)
 	}
 	targetOut := nodeToOutRef(targetNode, targetKey)
 
+	model.Alpha = &alpha
+	model.MinLatencyFactor = &minLatencyFactor
+	if req.Model == nil {
+		model = calibratedModel(calibrations, CanonicalServiceId(targetOut.Namespace+":"+targetOut.Name), latencyMetric, model)
+		alpha, minLatencyFactor = *model.Alpha, *model.MinLatencyFactor
+	}
+
 	incomingEdges := snapshot.IncomingEdges[targetKey]
 	var baseLat float64
 	var totalWeighted, totalRate float64
@@ -91,12 +156,12 @@ func SimulateScaling(ctx context.Context, client *graph.Client, cfg *config.Conf
 	adjustedLatencies := make(map[string]float64)
 
 	if hasBaseData {
-		if modelType == "bounded_sqrt" {
-			newLat = applyBoundedSqrtScaling(baseLat, req.CurrentPods, req.NewPods, alpha, cfg.Simulation.MinLatencyFactor)
-		} else if modelType == "linear" {
+		if model.Type == "bounded_sqrt" {
+			newLat = baseLat * BoundedSqrtFactor(req.CurrentPods, req.NewPods, alpha, minLatencyFactor)
+		} else if model.Type == "linear" {
 			newLat = applyLinearScaling(baseLat, req.CurrentPods, req.NewPods)
 		} else {
-			return nil, fmt.Errorf("Unknown scaling model: %s", modelType)
+			return nil, common.NewError(common.CodeInvalidScalingModel, "Unknown scaling model: %s", model.Type)
 		}
 		adjustedLatencies[targetKey] = newLat
 	}
@@ -157,6 +222,12 @@ func SimulateScaling(ctx context.Context, client *graph.Client, cfg *config.Conf
 		return math.Abs(*d1) > math.Abs(*d2)
 	})
 
+	var projectedMs *float64
+	if hasBaseData {
+		projectedMs = &newLat
+	}
+	sloBreaches := scalingSLOBreaches(snapshot, CanonicalServiceId(targetOut.Namespace+":"+targetOut.Name), latencyMetric, projectedMs, affectedCallers, slos)
+
 	maxPaths := cfg.Simulation.MaxPathsReturned
 	topPaths := FindTopPathsToTarget(snapshot, targetKey, maxDepth, maxPaths)
 
@@ -248,6 +319,9 @@ func SimulateScaling(ctx context.Context, client *graph.Client, cfg *config.Conf
 	}
 
 	confidence := "high"
+	if baseline != nil {
+		confidence = "medium"
+	}
 	healthRes, _ := client.CheckHealth(ctx)
 	var df *DataFreshness
 	if healthRes != nil {
@@ -293,7 +367,8 @@ func SimulateScaling(ctx context.Context, client *graph.Client, cfg *config.Conf
 		DataFreshness:    df,
 		Confidence:       confidence,
 		LatencyMetric:    latencyMetric,
-		ScalingModel:     ScalingModel{Type: modelType, Alpha: &alpha},
+		ScalingModel:     model,
+		Baseline:         baseline,
 		CurrentPods:      req.CurrentPods,
 		NewPods:          req.NewPods,
 		ScalingDirection: scalingDirection,
@@ -309,6 +384,7 @@ func SimulateScaling(ctx context.Context, client *graph.Client, cfg *config.Conf
 			Items:       affectedCallers,
 		},
 		AffectedPaths:   affectedPaths,
+		SLOBreaches:     sloBreaches,
 		Recommendations: []FailureRecommendation{},
 	}
 
@@ -355,6 +431,10 @@ func SimulateScaling(ctx context.Context, client *graph.Client, cfg *config.Conf
 			fmt.Sprintf("%d of %d path(s) have incomplete latency data (missing edge metrics). Results may be partial.", incompleteCount, pathsCount),
 		}
 	}
+	if baseline != nil && baseline.CurrentEdges > 0 {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("%d of %d edge(s) have too little history to forecast; their current metrics were used.", baseline.CurrentEdges, baseline.CurrentEdges+baseline.ForecastEdges))
+	}
 
 	recommendations := []FailureRecommendation{}
 
@@ -385,13 +465,95 @@ func SimulateScaling(ctx context.Context, client *graph.Client, cfg *config.Conf
 	return result, nil
 }
 
-func applyBoundedSqrtScaling(baseLatency float64, currentPods, newPods int, alpha, minLatencyFactor float64) float64 {
+// parseScalingBaseline returns the forecast time of a "forecast@<time>"
+// baseline, or nil for the current snapshot.
+func parseScalingBaseline(baseline string, forecaster EdgeForecaster) (*time.Time, error) {
+	if baseline == "" || baseline == "current" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(baseline, forecastBaselinePrefix) {
+		return nil, common.NewError(common.CodeValidationFailed, "Invalid baseline: %s. Use current or forecast@<RFC3339 time>", baseline)
+	}
+	at, err := time.Parse(time.RFC3339, strings.TrimPrefix(baseline, forecastBaselinePrefix))
+	if err != nil {
+		return nil, common.NewError(common.CodeInvalidTimestamp, "Invalid forecast time in baseline. Use ISO 8601 (e.g., forecast@2026-01-11T18:00:00Z)")
+	}
+	if forecaster == nil {
+		return nil, common.NewError(common.CodeTelemetryUnavailable, "Forecast baselines need telemetry history, which is not available here")
+	}
+	now := time.Now()
+	if !at.After(now) {
+		return nil, common.NewError(common.CodeValidationFailed, "Forecast time must be in the future")
+	}
+	if limit := forecaster.MaxHorizon(); at.Sub(now) > limit {
+		return nil, common.NewError(common.CodeValidationFailed, "Forecast time must be within %s from now", limit)
+	}
+	at = at.UTC()
+	return &at, nil
+}
+
+// applyForecastBaseline overwrites the rate and latencyMetric of every
+// snapshot edge that has a forecast for at.
+func applyForecastBaseline(ctx context.Context, forecaster EdgeForecaster, snapshot *GraphSnapshot, latencyMetric string, at time.Time) (*ScalingBaseline, error) {
+	keys := make([]EdgeKey, 0, len(snapshot.Edges))
+	for _, e := range snapshot.Edges {
+		keys = append(keys, EdgeKey{Source: e.Source, Target: e.Target})
+	}
+	forecasts, err := forecaster.ForecastEdges(ctx, keys, latencyMetric, at)
+	if err != nil {
+		return nil, err
+	}
+
+	baseline := &ScalingBaseline{Source: "forecast", At: at.Format(time.RFC3339)}
+	for _, e := range snapshot.Edges {
+		fc, ok := forecasts[EdgeKey{Source: e.Source, Target: e.Target}]
+		if !ok {
+			baseline.CurrentEdges++
+			continue
+		}
+		baseline.ForecastEdges++
+		e.Rate = fc.Rate
+		latency := fc.LatencyMs
+		switch latencyMetric {
+		case "p50":
+			e.P50 = &latency
+		case "p95":
+			e.P95 = &latency
+		case "p99":
+			e.P99 = &latency
+		}
+	}
+	return baseline, nil
+}
+
+// calibratedModel replaces the parameters of a bounded_sqrt model with the
+// ones the calibration job fitted for serviceID on latencyMetric, if any.
+// Failing to read the calibration keeps the given model.
+func calibratedModel(calibrations CalibrationSource, serviceID, latencyMetric string, model ScalingModel) ScalingModel {
+	if model.Type != "bounded_sqrt" || calibrations == nil {
+		return model
+	}
+	cal, err := calibrations.GetCalibration(serviceID)
+	switch {
+	case err == nil && cal.Metric == latencyMetric:
+		alpha, minLatencyFactor := cal.Alpha, cal.MinLatencyFactor
+		model.Alpha, model.MinLatencyFactor = &alpha, &minLatencyFactor
+		model.Source = "calibration"
+		model.Calibration = &ModelCalibration{Samples: cal.Samples, RMSE: cal.RMSE, FittedAt: cal.FittedAt}
+	case err != nil && !errors.Is(err, storage.ErrCalibrationNotFound):
+		logger.Error("Failed to load scaling calibration; using configured model", err)
+	}
+	return model
+}
+
+// BoundedSqrtFactor is the bounded_sqrt latency multiplier for a change from
+// currentPods to newPods: alpha of the latency does not scale, the rest
+// scales with 1/sqrt(pod ratio), and the result never drops below
+// minLatencyFactor.
+func BoundedSqrtFactor(currentPods, newPods int, alpha, minLatencyFactor float64) float64 {
 	ratio := float64(newPods) / float64(currentPods)
 	improvement := 1.0 / math.Sqrt(ratio)
-	newLatency := baseLatency * (alpha + (1.0-alpha)*improvement)
-
-	minLatency := baseLatency * minLatencyFactor
-	return math.Max(newLatency, minLatency)
+	return math.Max(alpha+(1.0-alpha)*improvement, minLatencyFactor)
 }
 
 func applyLinearScaling(baseLatency float64, currentPods, newPods int) float64 {
@@ -11,22 +11,48 @@ import (
 	"predictive-analysis-engine/pkg/storage"
 )
 
+// GraphSource is the subset of the Graph Engine API the simulations read
+// from. It is satisfied by *graph.Client and by graph.TopologySource.
+type GraphSource interface {
+	CheckHealth(ctx context.Context) (*graph.HealthResponse, error)
+	GetServices(ctx context.Context) ([]graph.ServiceInfo, error)
+	GetNeighborhood(ctx context.Context, serviceName string, k int) (*graph.NeighborhoodResponse, error)
+}
+
+// DecisionStore is what the simulation service needs from the decision
+// store: somewhere to log decisions, and the SLOs and calibrations the
+// simulations consult.
+type DecisionStore interface {
+	storage.DecisionWriter
+	SLOSource
+	CalibrationSource
+}
+
 type Service struct {
-	graphClient   *graph.Client
-	decisionStore *storage.DecisionStore
+	graphClient   GraphSource
+	decisionStore DecisionStore
+	forecaster    EdgeForecaster
 	config        *config.Config
 }
 
-func NewService(cfg *config.Config, gc *graph.Client, ds *storage.DecisionStore) *Service {
+// NewService creates the simulation service. ds and forecaster may be nil;
+// without a forecaster, scaling simulations only accept the current
+// baseline.
+func NewService(cfg *config.Config, gc GraphSource, ds DecisionStore, forecaster EdgeForecaster) *Service {
 	return &Service{
 		config:        cfg,
 		graphClient:   gc,
 		decisionStore: ds,
+		forecaster:    forecaster,
 	}
 }
 
 func (s *Service) RunFailureSimulation(ctx context.Context, req FailureSimulationRequest) (*FailureSimulationResult, error) {
-	result, err := SimulateFailure(ctx, s.graphClient, req)
+	var slos SLOSource
+	if s.decisionStore != nil {
+		slos = s.decisionStore
+	}
+	result, err := SimulateFailure(ctx, s.graphClient, req, slos)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +74,12 @@ func (s *Service) RunFailureSimulation(ctx context.Context, req FailureSimulatio
 }
 
 func (s *Service) RunScalingSimulation(ctx context.Context, req ScalingSimulationRequest) (*ScalingSimulationResult, error) {
-	result, err := SimulateScaling(ctx, s.graphClient, s.config, req)
+	var calibrations CalibrationSource
+	var slos SLOSource
+	if s.decisionStore != nil {
+		calibrations, slos = s.decisionStore, s.decisionStore
+	}
+	result, err := SimulateScaling(ctx, s.graphClient, s.config, req, calibrations, s.forecaster, slos)
 	if err != nil {
 		return nil, err
 	}
@@ -90,3 +121,73 @@ func (s *Service) RunAddSimulation(ctx context.Context, req AddSimulationRequest
 
 	return result, nil
 }
+
+func (s *Service) RunRemoveSimulation(ctx context.Context, req RemoveSimulationRequest) (*RemoveSimulationResult, error) {
+	var calibrations CalibrationSource
+	if s.decisionStore != nil {
+		calibrations = s.decisionStore
+	}
+	result, err := SimulateRemove(ctx, s.graphClient, s.config, req, calibrations)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.decisionStore != nil {
+		_, err := s.decisionStore.LogDecision(storage.LogDecisionInput{
+			Timestamp:     time.Now().UTC().Format(time.RFC3339),
+			Type:          "remove",
+			Scenario:      req,
+			Result:        result,
+			CorrelationID: common.GetCorrelationID(ctx),
+		})
+		if err != nil {
+			logger.Error("Failed to log decision", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) RunRewireSimulation(ctx context.Context, req RewireSimulationRequest) (*RewireSimulationResult, error) {
+	result, err := SimulateRewire(ctx, s.graphClient, s.config, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.decisionStore != nil {
+		_, err := s.decisionStore.LogDecision(storage.LogDecisionInput{
+			Timestamp:     time.Now().UTC().Format(time.RFC3339),
+			Type:          "rewire",
+			Scenario:      req,
+			Result:        result,
+			CorrelationID: common.GetCorrelationID(ctx),
+		})
+		if err != nil {
+			logger.Error("Failed to log decision", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) RunDrainSimulation(ctx context.Context, req DrainSimulationRequest) (*DrainSimulationResult, error) {
+	result, err := SimulateDrain(ctx, s.graphClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.decisionStore != nil {
+		_, err := s.decisionStore.LogDecision(storage.LogDecisionInput{
+			Timestamp:     time.Now().UTC().Format(time.RFC3339),
+			Type:          "drain",
+			Scenario:      req,
+			Result:        result,
+			CorrelationID: common.GetCorrelationID(ctx),
+		})
+		if err != nil {
+			logger.Error("Failed to log decision", err)
+		}
+	}
+
+	return result, nil
+}
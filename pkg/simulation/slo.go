@@ -0,0 +1,161 @@
+package simulation
+
+import (
+	"fmt"
+	"sort"
+
+	"predictive-analysis-engine/pkg/logger"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+const (
+	SLOObjectiveAvailability = "availability"
+	SLOObjectiveLatency      = "latency"
+)
+
+// SLOSource lists registered SLOs, for every service when serviceID is
+// empty. It is satisfied by storage.SLOStore.
+type SLOSource interface {
+	ListSLOs(serviceID string) ([]storage.SLO, error)
+}
+
+// SLOBreach is an SLO objective a scenario would push past its target.
+// Projected is the availability fraction or latency in milliseconds the
+// service is expected to have under the scenario.
+type SLOBreach struct {
+	SLOID     int64   `json:"sloId"`
+	Name      string  `json:"name"`
+	ServiceID string  `json:"serviceId"`
+	Objective string  `json:"objective"`
+	Target    float64 `json:"target"`
+	Projected float64 `json:"projected"`
+	Reason    string  `json:"reason"`
+}
+
+// loadSLOs returns the registered SLOs by canonical service ID. A failed
+// lookup is logged and treated as no SLOs, so simulations still run.
+func loadSLOs(slos SLOSource) map[string][]storage.SLO {
+	out := map[string][]storage.SLO{}
+	if slos == nil {
+		return out
+	}
+	list, err := slos.ListSLOs("")
+	if err != nil {
+		logger.Error("Failed to load SLOs; skipping SLO breach check", err)
+		return out
+	}
+	for _, slo := range list {
+		id := CanonicalServiceId(slo.ServiceID)
+		out[id] = append(out[id], slo)
+	}
+	return out
+}
+
+// failureSLOBreaches reports availability SLOs broken by the loss of the
+// target: the target itself serves nothing, and each direct caller is
+// assumed to fail every request whose call to the target is lost.
+func failureSLOBreaches(snapshot *GraphSnapshot, targetID string, callers []AffectedCaller, slos SLOSource) []SLOBreach {
+	breaches := []SLOBreach{}
+	byService := loadSLOs(slos)
+	if len(byService) == 0 {
+		return breaches
+	}
+
+	for _, slo := range byService[targetID] {
+		if slo.AvailabilityTarget == nil {
+			continue
+		}
+		breaches = append(breaches, SLOBreach{
+			SLOID:     slo.ID,
+			Name:      slo.Name,
+			ServiceID: targetID,
+			Objective: SLOObjectiveAvailability,
+			Target:    *slo.AvailabilityTarget,
+			Projected: 0,
+			Reason:    fmt.Sprintf("%s is down and serves no requests", targetID),
+		})
+	}
+
+	for _, c := range callers {
+		callerID := CanonicalServiceId(c.Namespace + ":" + c.Name)
+		var total float64
+		for _, e := range snapshot.OutgoingEdges[c.ServiceId] {
+			total += e.Rate
+		}
+		if total <= 0 {
+			continue
+		}
+		projected := 1 - c.LostTrafficRps/total
+		for _, slo := range byService[callerID] {
+			if slo.AvailabilityTarget == nil || projected >= *slo.AvailabilityTarget {
+				continue
+			}
+			breaches = append(breaches, SLOBreach{
+				SLOID:     slo.ID,
+				Name:      slo.Name,
+				ServiceID: callerID,
+				Objective: SLOObjectiveAvailability,
+				Target:    *slo.AvailabilityTarget,
+				Projected: projected,
+				Reason:    fmt.Sprintf("%.1f%% of %s's outgoing calls go to %s", 100*c.LostTrafficRps/total, callerID, targetID),
+			})
+		}
+	}
+	sortSLOBreaches(breaches)
+	return breaches
+}
+
+// scalingSLOBreaches reports latency SLOs on latencyMetric that the projected
+// latencies would exceed. A caller's latency is taken as the rate-weighted
+// latency of its incoming edges plus the change in its outgoing edges.
+func scalingSLOBreaches(snapshot *GraphSnapshot, targetID string, latencyMetric string, projectedMs *float64, callers []AffectedCallerScaling, slos SLOSource) []SLOBreach {
+	breaches := []SLOBreach{}
+	byService := loadSLOs(slos)
+	if len(byService) == 0 {
+		return breaches
+	}
+
+	check := func(serviceID string, projected float64, reason string) {
+		for _, slo := range byService[serviceID] {
+			if slo.LatencyTargetMs == nil || slo.LatencyMetric != latencyMetric || projected <= *slo.LatencyTargetMs {
+				continue
+			}
+			breaches = append(breaches, SLOBreach{
+				SLOID:     slo.ID,
+				Name:      slo.Name,
+				ServiceID: serviceID,
+				Objective: SLOObjectiveLatency,
+				Target:    *slo.LatencyTargetMs,
+				Projected: projected,
+				Reason:    reason,
+			})
+		}
+	}
+
+	if projectedMs != nil {
+		check(targetID, *projectedMs, fmt.Sprintf("projected %s of %s is %.1fms", latencyMetric, targetID, *projectedMs))
+	}
+	for _, c := range callers {
+		if c.DeltaMs == nil {
+			continue
+		}
+		current := computeWeightedMeanLatency(snapshot.IncomingEdges[c.ServiceId], latencyMetric, nil)
+		if current == nil {
+			continue
+		}
+		callerID := CanonicalServiceId(c.Namespace + ":" + c.Name)
+		projected := *current + *c.DeltaMs
+		check(callerID, projected, fmt.Sprintf("%s of %s moves from %.1fms to %.1fms", latencyMetric, callerID, *current, projected))
+	}
+	sortSLOBreaches(breaches)
+	return breaches
+}
+
+func sortSLOBreaches(breaches []SLOBreach) {
+	sort.Slice(breaches, func(i, j int) bool {
+		if breaches[i].ServiceID != breaches[j].ServiceID {
+			return breaches[i].ServiceID < breaches[j].ServiceID
+		}
+		return breaches[i].SLOID < breaches[j].SLOID
+	})
+}
@@ -1,5 +1,7 @@
 package simulation
 
+import "predictive-analysis-engine/pkg/analysis"
+
 const (
 	MaxTraversalDepth = 2
 	MaxPathsReturned  = 5
@@ -21,6 +23,7 @@ type FailureSimulationResult struct {
 	UnreachableServices []UnreachableService    `json:"unreachableServices"`
 	CriticalPaths       []BrokenPath            `json:"criticalPathsToTarget"`
 	TotalLostTrafficRps float64                 `json:"totalLostTrafficRps"`
+	SLOBreaches         []SLOBreach             `json:"sloBreaches"`
 	Recommendations     []FailureRecommendation `json:"recommendations"`
 }
 
@@ -170,9 +173,21 @@ type Edge struct {
 	P99       *float64
 }
 
+// ScalingModel selects the latency model for a scaling simulation. Source
+// and Calibration are only set on results, to report where the parameters
+// came from: "request", "calibration" or "config".
 type ScalingModel struct {
-	Type  string   `json:"type"`
-	Alpha *float64 `json:"alpha,omitempty"`
+	Type             string            `json:"type"`
+	Alpha            *float64          `json:"alpha,omitempty"`
+	MinLatencyFactor *float64          `json:"minLatencyFactor,omitempty"`
+	Source           string            `json:"source,omitempty"`
+	Calibration      *ModelCalibration `json:"calibration,omitempty"`
+}
+
+type ModelCalibration struct {
+	Samples  int     `json:"samples"`
+	RMSE     float64 `json:"rmse"`
+	FittedAt string  `json:"fittedAt"`
 }
 
 type ScalingSimulationRequest struct {
@@ -183,6 +198,18 @@ type ScalingSimulationRequest struct {
 	Model         *ScalingModel `json:"model,omitempty"`
 	MaxDepth      int           `json:"maxDepth,omitempty"`
 	TimeWindow    string        `json:"timeWindow,omitempty"`
+	// Baseline is "current" (the default) or "forecast@<RFC3339 time>" to
+	// replace edge metrics with their forecast at that time.
+	Baseline string `json:"baseline,omitempty"`
+}
+
+// ScalingBaseline reports where the edge metrics of a scaling simulation
+// came from. Edges without enough history keep their current metrics.
+type ScalingBaseline struct {
+	Source        string `json:"source"`
+	At            string `json:"at,omitempty"`
+	ForecastEdges int    `json:"forecastEdges"`
+	CurrentEdges  int    `json:"currentEdges"`
 }
 
 type ScalingLatencyEstimate struct {
@@ -225,12 +252,14 @@ type ScalingSimulationResult struct {
 	Warnings         []string                `json:"warnings,omitempty"`
 	LatencyMetric    string                  `json:"latencyMetric"`
 	ScalingModel     ScalingModel            `json:"scalingModel"`
+	Baseline         *ScalingBaseline        `json:"baseline,omitempty"`
 	CurrentPods      int                     `json:"currentPods"`
 	NewPods          int                     `json:"newPods"`
 	LatencyEstimate  ScalingLatencyEstimate  `json:"latencyEstimate"`
 	ScalingDirection string                  `json:"scalingDirection"`
 	AffectedCallers  AffectedCallersList     `json:"affectedCallers"`
 	AffectedPaths    []AffectedPathScaling   `json:"affectedPaths"`
+	SLOBreaches      []SLOBreach             `json:"sloBreaches"`
 	Recommendations  []FailureRecommendation `json:"recommendations"`
 }
 
@@ -238,3 +267,233 @@ type AffectedCallersList struct {
 	Description string                  `json:"description"`
 	Items       []AffectedCallerScaling `json:"items"`
 }
+
+// RemoveSimulationRequest describes a planned decommission. Redirects maps
+// each caller to the service that takes over its calls; callers without a
+// redirect are assumed not to have been migrated.
+type RemoveSimulationRequest struct {
+	ServiceId     string            `json:"serviceId"`
+	Redirects     map[string]string `json:"redirects,omitempty"`
+	LatencyMetric string            `json:"latencyMetric,omitempty"`
+}
+
+type RedirectImpact struct {
+	CallerId      string   `json:"callerId"`
+	ReplacementId string   `json:"replacementId"`
+	Rps           float64  `json:"rps"`
+	BeforeMs      *float64 `json:"beforeMs"`
+	AfterMs       *float64 `json:"afterMs"`
+	DeltaMs       *float64 `json:"deltaMs"`
+}
+
+// ReplacementLoad is the projected load on a replacement service. Latency
+// is projected with the scaling model by treating the added load as an
+// equivalent loss of pods; SuggestedPods keeps the load per pod unchanged.
+// ScalingModel is the model used, with the replacement's calibrated
+// parameters when it has them.
+type ReplacementLoad struct {
+	ServiceId          string       `json:"serviceId"`
+	PodCount           int          `json:"podCount"`
+	CurrentRps         float64      `json:"currentRps"`
+	AddedRps           float64      `json:"addedRps"`
+	ProjectedRps       float64      `json:"projectedRps"`
+	LoadFactor         *float64     `json:"loadFactor"`
+	CurrentLatencyMs   *float64     `json:"currentLatencyMs"`
+	ProjectedLatencyMs *float64     `json:"projectedLatencyMs"`
+	DeltaMs            *float64     `json:"deltaMs"`
+	SuggestedPods      int          `json:"suggestedPods"`
+	ScalingModel       ScalingModel `json:"scalingModel"`
+}
+
+// DroppedCall is a call the removed service made, which stops unless a
+// replacement makes it instead.
+type DroppedCall struct {
+	ServiceId string  `json:"serviceId"`
+	Rps       float64 `json:"rps"`
+}
+
+// NodeRelease is the capacity a node gets back from the removed service's
+// pods. Pod CPU usage is taken as a percentage of the node's cores.
+type NodeRelease struct {
+	Node                 string  `json:"node"`
+	PodsRemoved          int     `json:"podsRemoved"`
+	CPUFreedCores        float64 `json:"cpuFreedCores"`
+	RAMFreedMB           float64 `json:"ramFreedMB"`
+	CPUTotal             float64 `json:"cpuTotal"`
+	RAMTotalMB           float64 `json:"ramTotalMB"`
+	CPUAvailableBefore   float64 `json:"cpuAvailableBefore"`
+	CPUAvailableAfter    float64 `json:"cpuAvailableAfter"`
+	RAMAvailableBeforeMB float64 `json:"ramAvailableBeforeMB"`
+	RAMAvailableAfterMB  float64 `json:"ramAvailableAfterMB"`
+}
+
+type FreedCapacity struct {
+	Pods     int           `json:"pods"`
+	CPUCores float64       `json:"cpuCores"`
+	RAMMB    float64       `json:"ramMB"`
+	Nodes    []NodeRelease `json:"nodes"`
+}
+
+type RemoveSimulationResult struct {
+	Target            ServiceRef              `json:"target"`
+	Confidence        string                  `json:"confidence"`
+	Explanation       string                  `json:"explanation"`
+	Warnings          []string                `json:"warnings"`
+	LatencyMetric     string                  `json:"latencyMetric"`
+	ScalingModel      ScalingModel            `json:"scalingModel"`
+	Redirects         []RedirectImpact        `json:"redirects"`
+	Replacements      []ReplacementLoad       `json:"replacements"`
+	UnmigratedCallers []AffectedCaller        `json:"unmigratedCallers"`
+	LostTrafficRps    float64                 `json:"lostTrafficRps"`
+	DroppedCalls      []DroppedCall           `json:"droppedCalls"`
+	FreedCapacity     FreedCapacity           `json:"freedCapacity"`
+	Recommendations   []FailureRecommendation `json:"recommendations"`
+}
+
+const (
+	RewireAddEdge    = "add_edge"
+	RewireRemoveEdge = "remove_edge"
+	RewireSetRate    = "set_rate"
+)
+
+// EdgeMutation is one change to the call graph. add_edge moves Percent of
+// the traffic Source sends to From onto the new edge, or adds Rate when From
+// is empty; remove_edge drops an edge and its traffic; set_rate changes the
+// rate of an existing edge.
+type EdgeMutation struct {
+	Op      string   `json:"op"`
+	Source  string   `json:"source"`
+	Target  string   `json:"target"`
+	From    string   `json:"from,omitempty"`
+	Percent *float64 `json:"percent,omitempty"`
+	Rate    *float64 `json:"rate,omitempty"`
+}
+
+type RewireSimulationRequest struct {
+	Mutations     []EdgeMutation `json:"mutations"`
+	Depth         int            `json:"depth,omitempty"`
+	LatencyMetric string         `json:"latencyMetric,omitempty"`
+}
+
+type AppliedMutation struct {
+	EdgeMutation
+	BeforeRps float64 `json:"beforeRps"`
+	AfterRps  float64 `json:"afterRps"`
+}
+
+type ServiceLoadChange struct {
+	ServiceId string  `json:"serviceId"`
+	BeforeRps float64 `json:"beforeRps"`
+	AfterRps  float64 `json:"afterRps"`
+	DeltaRps  float64 `json:"deltaRps"`
+}
+
+// CallerLatencyChange is the rate-weighted latency of a service's outgoing
+// calls before and after the mutations.
+type CallerLatencyChange struct {
+	ServiceId string   `json:"serviceId"`
+	BeforeMs  *float64 `json:"beforeMs"`
+	AfterMs   *float64 `json:"afterMs"`
+	DeltaMs   *float64 `json:"deltaMs"`
+}
+
+// SinglePointOfFailure is a service whose failure cuts services off from
+// every entry point of the graph.
+type SinglePointOfFailure struct {
+	ServiceId string   `json:"serviceId"`
+	CutOff    []string `json:"cutOff"`
+}
+
+// CycleComponentChange is a strongly connected component, or a set of
+// overlapping ones, that has too many cycles to enumerate before or after
+// the rewiring and whose members or internal calls changed. Its cycles are
+// not listed in NewCycles or RemovedCycles.
+type CycleComponentChange struct {
+	Services       []string `json:"services"`
+	BeforeServices []string `json:"beforeServices"`
+	AfterServices  []string `json:"afterServices"`
+}
+
+// RewiredPath is a path from a mutated caller whose existence or rate
+// changed. Change is "added", "removed" or "changed".
+type RewiredPath struct {
+	Path           []string `json:"path"`
+	Change         string   `json:"change"`
+	BeforeRps      *float64 `json:"beforeRps"`
+	AfterRps       *float64 `json:"afterRps"`
+	BeforeMs       *float64 `json:"beforeMs"`
+	AfterMs        *float64 `json:"afterMs"`
+	DeltaMs        *float64 `json:"deltaMs"`
+	IncompleteData bool     `json:"incompleteData"`
+}
+
+type RewireSimulationResult struct {
+	Neighborhood      NeighborhoodMeta        `json:"neighborhood"`
+	Confidence        string                  `json:"confidence"`
+	Explanation       string                  `json:"explanation"`
+	Warnings          []string                `json:"warnings"`
+	Assumptions       []string                `json:"assumptions"`
+	LatencyMetric     string                  `json:"latencyMetric"`
+	Mutations         []AppliedMutation       `json:"mutations"`
+	ServiceLoad       []ServiceLoadChange     `json:"serviceLoad"`
+	CallerLatency     []CallerLatencyChange   `json:"callerLatency"`
+	PathChanges       []RewiredPath           `json:"pathChanges"`
+	NewCycles         []analysis.Cycle        `json:"newCycles"`
+	RemovedCycles     []analysis.Cycle        `json:"removedCycles"`
+	ChangedComponents []CycleComponentChange  `json:"changedComponents"`
+	NewSPOFs          []SinglePointOfFailure  `json:"newSpofs"`
+	ResolvedSPOFs     []SinglePointOfFailure  `json:"resolvedSpofs"`
+	Recommendations   []FailureRecommendation `json:"recommendations"`
+}
+
+type DrainSimulationRequest struct {
+	Nodes []string `json:"nodes"`
+}
+
+// PodMove is a pod from a drained node and its resource requests, taken
+// from its observed usage. Pod CPU usage is a percentage of its node's cores.
+type PodMove struct {
+	Pod          string  `json:"pod"`
+	ServiceId    string  `json:"serviceId"`
+	FromNode     string  `json:"fromNode"`
+	ToNode       string  `json:"toNode,omitempty"`
+	CPURequest   float64 `json:"cpuRequest"`
+	RAMRequestMB float64 `json:"ramRequestMB"`
+	Reason       string  `json:"reason,omitempty"`
+}
+
+type NodeUtilization struct {
+	Node                 string  `json:"node"`
+	CPUTotal             float64 `json:"cpuTotal"`
+	RAMTotalMB           float64 `json:"ramTotalMB"`
+	CPUUsedBefore        float64 `json:"cpuUsedBefore"`
+	CPUUsedAfter         float64 `json:"cpuUsedAfter"`
+	RAMUsedBeforeMB      float64 `json:"ramUsedBeforeMB"`
+	RAMUsedAfterMB       float64 `json:"ramUsedAfterMB"`
+	CPUUtilizationBefore float64 `json:"cpuUtilizationBefore"`
+	CPUUtilizationAfter  float64 `json:"cpuUtilizationAfter"`
+	RAMUtilizationBefore float64 `json:"ramUtilizationBefore"`
+	RAMUtilizationAfter  float64 `json:"ramUtilizationAfter"`
+	PodsAdded            int     `json:"podsAdded"`
+}
+
+type DrainedService struct {
+	ServiceId      string `json:"serviceId"`
+	ReplicasBefore int    `json:"replicasBefore"`
+	ReplicasAfter  int    `json:"replicasAfter"`
+	PodsMoved      int    `json:"podsMoved"`
+	PodsLost       int    `json:"podsLost"`
+}
+
+type DrainSimulationResult struct {
+	DrainedNodes     []string                `json:"drainedNodes"`
+	Success          bool                    `json:"success"`
+	Confidence       string                  `json:"confidence"`
+	Explanation      string                  `json:"explanation"`
+	PodsToMove       int                     `json:"podsToMove"`
+	Rescheduled      []PodMove               `json:"rescheduled"`
+	Unschedulable    []PodMove               `json:"unschedulable"`
+	Nodes            []NodeUtilization       `json:"nodes"`
+	AffectedServices []DrainedService        `json:"affectedServices"`
+	Recommendations  []FailureRecommendation `json:"recommendations"`
+}
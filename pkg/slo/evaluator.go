@@ -0,0 +1,285 @@
+// Package slo evaluates registered SLOs against service_metrics: compliance
+// over the SLO window, remaining error budget and multi-window burn rates.
+package slo
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"predictive-analysis-engine/pkg/clients/telemetry"
+	"predictive-analysis-engine/pkg/common"
+	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/simulation"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+const (
+	StateOK       = "ok"
+	StateAtRisk   = "at_risk"
+	StateBreached = "breached"
+	StateNoData   = "no_data"
+)
+
+// DefaultLatencyObjective is the fraction of time latency must meet its
+// target when an SLO does not set one.
+const DefaultLatencyObjective = 0.99
+
+// LatencyMetrics lists the service_metrics percentiles a latency SLO can
+// target.
+func LatencyMetrics() []string {
+	return []string{"p95", "p99"}
+}
+
+// burnAlert fires when the error budget burns faster than Threshold over
+// both windows. The pairs follow the usual fast/slow burn alerting policy
+// for a 30 day budget: 2% of it gone in an hour, 5% in six hours, 10% in
+// three days.
+type burnAlert struct {
+	long      time.Duration
+	short     time.Duration
+	threshold float64
+}
+
+var burnAlerts = []burnAlert{
+	{long: time.Hour, short: 5 * time.Minute, threshold: 14.4},
+	{long: 6 * time.Hour, short: 30 * time.Minute, threshold: 6},
+	{long: 72 * time.Hour, short: 6 * time.Hour, threshold: 1},
+}
+
+var burnWindows = []time.Duration{5 * time.Minute, 30 * time.Minute, time.Hour, 6 * time.Hour, 24 * time.Hour, 72 * time.Hour}
+
+// MetricsSource is the subset of the telemetry client the evaluator reads
+// from.
+type MetricsSource interface {
+	CheckStatus() (bool, string)
+	GetServiceMetrics(ctx context.Context, service string, from, to string, stepSeconds int) ([]telemetry.ServiceMetric, error)
+}
+
+// BurnRate is how fast the error budget was spent over a trailing window,
+// relative to spending exactly the whole budget over the SLO window. Rate is
+// nil when the window has no traffic.
+type BurnRate struct {
+	Window string   `json:"window"`
+	Rate   *float64 `json:"rate"`
+}
+
+// BurnAlert is a multi-window burn rate condition that is currently met.
+type BurnAlert struct {
+	LongWindow  string  `json:"longWindow"`
+	ShortWindow string  `json:"shortWindow"`
+	Threshold   float64 `json:"threshold"`
+}
+
+// ObjectiveStatus is the state of one objective of an SLO. Compliance is
+// the fraction of good requests (availability) or good time (latency) over
+// the SLO window; ErrorBudgetRemaining is negative once the budget is spent.
+type ObjectiveStatus struct {
+	Objective            string      `json:"objective"`
+	Target               float64     `json:"target"`
+	LatencyMetric        string      `json:"latencyMetric,omitempty"`
+	LatencyTargetMs      *float64    `json:"latencyTargetMs,omitempty"`
+	State                string      `json:"state"`
+	Compliance           *float64    `json:"compliance"`
+	ErrorBudgetRemaining *float64    `json:"errorBudgetRemaining"`
+	BurnRates            []BurnRate  `json:"burnRates"`
+	Alerts               []BurnAlert `json:"alerts"`
+}
+
+type Status struct {
+	SLO         storage.SLO       `json:"slo"`
+	State       string            `json:"state"`
+	EvaluatedAt string            `json:"evaluatedAt"`
+	WindowFrom  string            `json:"windowFrom"`
+	WindowTo    string            `json:"windowTo"`
+	StepSeconds int               `json:"stepSeconds"`
+	Objectives  []ObjectiveStatus `json:"objectives"`
+}
+
+type Evaluator struct {
+	cfg       config.SLOConfig
+	telemetry MetricsSource
+}
+
+func NewEvaluator(cfg config.SLOConfig, ts MetricsSource) *Evaluator {
+	return &Evaluator{cfg: cfg, telemetry: ts}
+}
+
+// bucket is the good and total weight of one service_metrics step: request
+// rates for availability, a single unit of time for latency.
+type bucket struct {
+	at          time.Time
+	good, total float64
+}
+
+// Evaluate computes the current state of every objective of slo from
+// service_metrics over its window.
+func (e *Evaluator) Evaluate(ctx context.Context, slo storage.SLO) (*Status, error) {
+	if ok, reason := e.telemetry.CheckStatus(); !ok {
+		return nil, common.NewError(common.CodeTelemetryUnavailable, "%s", reason)
+	}
+
+	stepSeconds := e.stepSeconds()
+	step := time.Duration(stepSeconds) * time.Second
+	now := time.Now().UTC()
+	to := now.Truncate(step)
+	window := time.Duration(slo.WindowDays) * 24 * time.Hour
+	from := to.Add(-window)
+	fetchFrom := from
+	if longest := burnWindows[len(burnWindows)-1]; window < longest {
+		fetchFrom = to.Add(-longest)
+	}
+
+	serviceID := simulation.CanonicalServiceId(slo.ServiceID)
+	_, name, _ := strings.Cut(serviceID, ":")
+	metrics, err := e.telemetry.GetServiceMetrics(ctx, name, fetchFrom.Format(time.RFC3339), to.Format(time.RFC3339), stepSeconds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeUpstreamError, err, "Service metrics query failed")
+	}
+	var samples []telemetry.ServiceMetric
+	for _, m := range metrics {
+		if simulation.CanonicalServiceId(m.Namespace+":"+m.Service) == serviceID {
+			samples = append(samples, m)
+		}
+	}
+
+	status := &Status{
+		SLO:         slo,
+		EvaluatedAt: now.Format(time.RFC3339),
+		WindowFrom:  from.Format(time.RFC3339),
+		WindowTo:    to.Format(time.RFC3339),
+		StepSeconds: stepSeconds,
+		Objectives:  []ObjectiveStatus{},
+	}
+	if slo.AvailabilityTarget != nil {
+		var buckets []bucket
+		for _, m := range samples {
+			at, err := time.Parse(time.RFC3339, m.Timestamp)
+			if err != nil || m.RequestRate <= 0 {
+				continue
+			}
+			errRate := min(max(m.ErrorRate, 0), 1)
+			buckets = append(buckets, bucket{at: at, good: m.RequestRate * (1 - errRate), total: m.RequestRate})
+		}
+		obj := evaluateObjective(buckets, *slo.AvailabilityTarget, from, to, window)
+		obj.Objective = simulation.SLOObjectiveAvailability
+		status.Objectives = append(status.Objectives, obj)
+	}
+	if slo.LatencyTargetMs != nil {
+		target := DefaultLatencyObjective
+		if slo.LatencyObjective != nil {
+			target = *slo.LatencyObjective
+		}
+		var buckets []bucket
+		for _, m := range samples {
+			at, err := time.Parse(time.RFC3339, m.Timestamp)
+			latency := m.P95
+			if slo.LatencyMetric == "p99" {
+				latency = m.P99
+			}
+			if err != nil || m.RequestRate <= 0 || latency <= 0 {
+				continue
+			}
+			b := bucket{at: at, total: 1}
+			if latency <= *slo.LatencyTargetMs {
+				b.good = 1
+			}
+			buckets = append(buckets, b)
+		}
+		obj := evaluateObjective(buckets, target, from, to, window)
+		obj.Objective = simulation.SLOObjectiveLatency
+		obj.LatencyMetric = slo.LatencyMetric
+		obj.LatencyTargetMs = slo.LatencyTargetMs
+		status.Objectives = append(status.Objectives, obj)
+	}
+
+	status.State = StateOK
+	for _, obj := range status.Objectives {
+		if stateRank(obj.State) > stateRank(status.State) {
+			status.State = obj.State
+		}
+	}
+	return status, nil
+}
+
+func evaluateObjective(buckets []bucket, target float64, from, to time.Time, window time.Duration) ObjectiveStatus {
+	obj := ObjectiveStatus{Target: target, State: StateNoData, BurnRates: []BurnRate{}, Alerts: []BurnAlert{}}
+	budget := 1 - target
+
+	rates := map[time.Duration]*float64{}
+	for _, w := range burnWindows {
+		good, total := sum(buckets, to.Add(-w), to)
+		var rate *float64
+		if total > 0 {
+			r := (1 - good/total) / budget
+			rate = &r
+		}
+		rates[w] = rate
+		obj.BurnRates = append(obj.BurnRates, BurnRate{Window: formatWindow(w), Rate: rate})
+	}
+
+	good, total := sum(buckets, from, to)
+	if total == 0 {
+		return obj
+	}
+	compliance := good / total
+	remaining := 1 - (1-compliance)/budget
+	obj.Compliance, obj.ErrorBudgetRemaining = &compliance, &remaining
+
+	// Thresholds assume a 30 day budget; shorter windows have less budget
+	// per hour, so the same burn rate spends a larger share of it.
+	scale := float64(30*24*time.Hour) / float64(window)
+	for _, a := range burnAlerts {
+		long, short := rates[a.long], rates[a.short]
+		if long != nil && short != nil && *long >= a.threshold/scale && *short >= a.threshold/scale {
+			obj.Alerts = append(obj.Alerts, BurnAlert{LongWindow: formatWindow(a.long), ShortWindow: formatWindow(a.short), Threshold: a.threshold / scale})
+		}
+	}
+
+	switch {
+	case compliance < target:
+		obj.State = StateBreached
+	case len(obj.Alerts) > 0:
+		obj.State = StateAtRisk
+	default:
+		obj.State = StateOK
+	}
+	return obj
+}
+
+func sum(buckets []bucket, from, to time.Time) (good, total float64) {
+	for _, b := range buckets {
+		if b.at.Before(from) || !b.at.Before(to) {
+			continue
+		}
+		good += b.good
+		total += b.total
+	}
+	return good, total
+}
+
+func stateRank(state string) int {
+	switch state {
+	case StateNoData:
+		return 1
+	case StateAtRisk:
+		return 2
+	case StateBreached:
+		return 3
+	}
+	return 0
+}
+
+func formatWindow(d time.Duration) string {
+	if d < time.Hour {
+		return strings.TrimSuffix(d.String(), "0s")
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(d.String(), "0s"), "0m")
+}
+
+func (e *Evaluator) stepSeconds() int {
+	if e.cfg.StepSeconds <= 0 {
+		return 300
+	}
+	return e.cfg.StepSeconds
+}
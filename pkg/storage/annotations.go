@@ -0,0 +1,334 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	ApprovalProposed = "proposed"
+	ApprovalApproved = "approved"
+	ApprovalRejected = "rejected"
+	ApprovalApplied  = "applied"
+)
+
+// ApprovalTransitions lists the states each approval state may move to.
+// Applied is final; a rejected decision can be proposed again.
+var ApprovalTransitions = map[string][]string{
+	ApprovalProposed: {ApprovalApproved, ApprovalRejected},
+	ApprovalApproved: {ApprovalApplied, ApprovalRejected, ApprovalProposed},
+	ApprovalRejected: {ApprovalProposed},
+	ApprovalApplied:  {},
+}
+
+const (
+	MaxTagLength = 64
+	MaxTags      = 20
+)
+
+var (
+	ErrAnnotationNotFound = errors.New("annotation not found")
+	ErrTooManyTags        = fmt.Errorf("a decision can carry at most %d tags", MaxTags)
+)
+
+// TransitionError reports an approval change that ApprovalTransitions does
+// not allow.
+type TransitionError struct {
+	From string
+	To   string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("cannot move decision from %s to %s", e.From, e.To)
+}
+
+type Approval struct {
+	State     string `json:"state"`
+	Actor     string `json:"actor,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+type ApprovalInput struct {
+	DecisionID int64
+	State      string
+	Actor      string
+	Comment    string
+}
+
+// ApprovalEvent is one entry of a decision's approval history.
+type ApprovalEvent struct {
+	ID        int64  `json:"id"`
+	FromState string `json:"fromState"`
+	State     string `json:"state"`
+	Actor     string `json:"actor"`
+	Comment   string `json:"comment,omitempty"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type AnnotationInput struct {
+	DecisionID int64
+	ParentID   *int64
+	Author     string
+	Body       string
+}
+
+// Annotation is a comment on a decision. Replies set ParentID to the
+// annotation they answer, which must belong to the same decision.
+type Annotation struct {
+	ID         int64  `json:"id"`
+	DecisionID int64  `json:"decisionId"`
+	ParentID   *int64 `json:"parentId,omitempty"`
+	Author     string `json:"author"`
+	Body       string `json:"body"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+func IsApprovalState(state string) bool {
+	_, ok := ApprovalTransitions[state]
+	return ok
+}
+
+// SetApproval moves a decision to a new approval state and records the
+// change in its approval history.
+func (s *DecisionStore) SetApproval(input ApprovalInput) (*Approval, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var current string
+	err = tx.QueryRow(s.dialect.rebind("SELECT approval_state FROM decisions WHERE id = ?"), input.DecisionID).Scan(&current)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDecisionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read approval state: %w", err)
+	}
+
+	allowed := false
+	for _, next := range ApprovalTransitions[current] {
+		allowed = allowed || next == input.State
+	}
+	if !allowed {
+		return nil, &TransitionError{From: current, To: input.State}
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	// The state check guards against a concurrent change between the read
+	// and the update on databases without serializable transactions.
+	res, err := tx.Exec(s.dialect.rebind("UPDATE decisions SET approval_state = ?, approval_actor = ?, approval_updated_at = ? WHERE id = ? AND approval_state = ?"),
+		input.State, input.Actor, now, input.DecisionID, current)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update approval state: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return nil, &TransitionError{From: current, To: input.State}
+	}
+	_, err = tx.Exec(s.dialect.rebind("INSERT INTO decision_approvals (decision_id, from_state, state, actor, comment, created_at) VALUES (?, ?, ?, ?, ?, ?)"),
+		input.DecisionID, current, input.State, input.Actor, nullString(input.Comment), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record approval: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit approval: %w", err)
+	}
+	return &Approval{State: input.State, Actor: input.Actor, UpdatedAt: now}, nil
+}
+
+// ApprovalHistory returns the approval changes of a decision, oldest first.
+func (s *DecisionStore) ApprovalHistory(decisionID int64) ([]ApprovalEvent, error) {
+	rows, err := s.db.Query(s.dialect.rebind("SELECT id, from_state, state, actor, comment, created_at FROM decision_approvals WHERE decision_id = ? ORDER BY id"), decisionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query approval history: %w", err)
+	}
+	defer rows.Close()
+
+	events := []ApprovalEvent{}
+	for rows.Next() {
+		var e ApprovalEvent
+		var comment sql.NullString
+		if err := rows.Scan(&e.ID, &e.FromState, &e.State, &e.Actor, &comment, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan approval: %w", err)
+		}
+		e.Comment = comment.String
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+// AddTags attaches tags to a decision, ignoring ones it already carries, and
+// returns the resulting tag set.
+func (s *DecisionStore) AddTags(decisionID int64, tags []string) ([]string, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	current, err := s.tagsOf(tx, decisionID)
+	if err != nil {
+		return nil, err
+	}
+	have := map[string]bool{}
+	for _, t := range current {
+		have[t] = true
+	}
+	var added []string
+	for _, t := range normalizeTags(tags) {
+		if !have[t] {
+			added = append(added, t)
+			current = append(current, t)
+		}
+	}
+	if len(current) > MaxTags {
+		return nil, ErrTooManyTags
+	}
+	if err := s.insertTags(tx, decisionID, added); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit tags: %w", err)
+	}
+	sort.Strings(current)
+	return current, nil
+}
+
+// RemoveTag detaches a tag from a decision and returns the remaining tags.
+func (s *DecisionStore) RemoveTag(decisionID int64, tag string) ([]string, error) {
+	if _, err := s.db.Exec(s.dialect.rebind("DELETE FROM decision_tags WHERE decision_id = ? AND tag = ?"), decisionID, strings.TrimSpace(tag)); err != nil {
+		return nil, fmt.Errorf("failed to remove tag: %w", err)
+	}
+	return s.tagsOf(s.db, decisionID)
+}
+
+func (s *DecisionStore) AddAnnotation(input AnnotationInput) (*Annotation, error) {
+	if input.ParentID != nil {
+		var parentDecision int64
+		err := s.db.QueryRow(s.dialect.rebind("SELECT decision_id FROM decision_annotations WHERE id = ?"), *input.ParentID).Scan(&parentDecision)
+		if errors.Is(err, sql.ErrNoRows) || (err == nil && parentDecision != input.DecisionID) {
+			return nil, ErrAnnotationNotFound
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read parent annotation: %w", err)
+		}
+	}
+
+	a := Annotation{
+		DecisionID: input.DecisionID,
+		ParentID:   input.ParentID,
+		Author:     input.Author,
+		Body:       input.Body,
+		CreatedAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+	err := s.db.QueryRow(s.dialect.rebind("INSERT INTO decision_annotations (decision_id, parent_id, author, body, created_at) VALUES (?, ?, ?, ?, ?) RETURNING id"),
+		a.DecisionID, a.ParentID, a.Author, a.Body, a.CreatedAt).Scan(&a.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert annotation: %w", err)
+	}
+	return &a, nil
+}
+
+// ListAnnotations returns the annotations of a decision in the order they
+// were written; clients rebuild threads from ParentID.
+func (s *DecisionStore) ListAnnotations(decisionID int64) ([]Annotation, error) {
+	rows, err := s.db.Query(s.dialect.rebind("SELECT id, decision_id, parent_id, author, body, created_at FROM decision_annotations WHERE decision_id = ? ORDER BY id"), decisionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query annotations: %w", err)
+	}
+	defer rows.Close()
+
+	out := []Annotation{}
+	for rows.Next() {
+		var a Annotation
+		var parent sql.NullInt64
+		if err := rows.Scan(&a.ID, &a.DecisionID, &parent, &a.Author, &a.Body, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan annotation: %w", err)
+		}
+		if parent.Valid {
+			a.ParentID = &parent.Int64
+		}
+		out = append(out, a)
+	}
+	return out, rows.Err()
+}
+
+type queryer interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func (s *DecisionStore) tagsOf(q queryer, decisionID int64) ([]string, error) {
+	rows, err := q.Query(s.dialect.rebind("SELECT tag FROM decision_tags WHERE decision_id = ? ORDER BY tag"), decisionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := []string{}
+	for rows.Next() {
+		var t string
+		if err := rows.Scan(&t); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, t)
+	}
+	return tags, rows.Err()
+}
+
+func (s *DecisionStore) insertTags(tx *sql.Tx, decisionID int64, tags []string) error {
+	for _, t := range tags {
+		if _, err := tx.Exec(s.dialect.rebind("INSERT INTO decision_tags (decision_id, tag) VALUES (?, ?)"), decisionID, t); err != nil {
+			return fmt.Errorf("failed to insert tag: %w", err)
+		}
+	}
+	return nil
+}
+
+// attachTags fills Tags on records with one query for the whole page.
+func (s *DecisionStore) attachTags(records []DecisionRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	index := map[int64]int{}
+	args := make([]interface{}, len(records))
+	for i, r := range records {
+		index[r.ID] = i
+		args[i] = r.ID
+	}
+	rows, err := s.db.Query(s.dialect.rebind("SELECT decision_id, tag FROM decision_tags WHERE decision_id IN ("+placeholders(len(records))+") ORDER BY tag"), args...)
+	if err != nil {
+		return fmt.Errorf("failed to query tags: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var t string
+		if err := rows.Scan(&id, &t); err != nil {
+			return fmt.Errorf("failed to scan tag: %w", err)
+		}
+		r := &records[index[id]]
+		r.Tags = append(r.Tags, t)
+	}
+	return rows.Err()
+}
+
+// normalizeTags trims tags and drops empty and duplicate ones. Length is
+// validated by the API.
+func normalizeTags(tags []string) []string {
+	out := []string{}
+	seen := map[string]bool{}
+	for _, t := range tags {
+		t = strings.TrimSpace(t)
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
@@ -0,0 +1,131 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+const (
+	AnomalyKindService = "service"
+	AnomalyKindEdge    = "edge"
+)
+
+// AnomalyEvent is a metric value that deviated from its baseline. For edge
+// anomalies ServiceID is the caller and TargetID the callee.
+type AnomalyEvent struct {
+	ID             int64   `json:"id"`
+	ObservedAt     string  `json:"observedAt"`
+	DetectedAt     string  `json:"detectedAt"`
+	Kind           string  `json:"kind"`
+	ServiceID      string  `json:"serviceId"`
+	TargetID       string  `json:"targetId,omitempty"`
+	Metric         string  `json:"metric"`
+	Value          float64 `json:"value"`
+	Expected       float64 `json:"expected"`
+	Direction      string  `json:"direction"`
+	DeviationScore float64 `json:"deviationScore"`
+	Severity       string  `json:"severity"`
+	Method         string  `json:"method"`
+}
+
+// AnomalyFilter selects anomaly events. ServiceID matches service anomalies
+// and edges on either side of the service.
+type AnomalyFilter struct {
+	From       string
+	To         string
+	ServiceID  string
+	Metrics    []string
+	Severities []string
+	Limit      int
+}
+
+const anomalyColumns = "id, observed_at, detected_at, kind, service_id, target_id, metric, value, expected, direction, deviation_score, severity, method"
+
+// SaveAnomalies stores events and sets their IDs.
+func (s *DecisionStore) SaveAnomalies(events []AnomalyEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := s.dialect.rebind(`
+		INSERT INTO anomaly_events (observed_at, detected_at, kind, service_id, target_id, metric, value, expected, direction, deviation_score, severity, method)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING id
+	`)
+	for i := range events {
+		e := &events[i]
+		err := tx.QueryRow(query, e.ObservedAt, e.DetectedAt, e.Kind, e.ServiceID, nullString(e.TargetID), e.Metric,
+			e.Value, e.Expected, e.Direction, e.DeviationScore, e.Severity, e.Method).Scan(&e.ID)
+		if err != nil {
+			return fmt.Errorf("failed to insert anomaly: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit anomalies: %w", err)
+	}
+	return nil
+}
+
+// ListAnomalies returns the events matching f, most recent first.
+func (s *DecisionStore) ListAnomalies(f AnomalyFilter) ([]AnomalyEvent, error) {
+	var where []string
+	var args []interface{}
+	if f.From != "" {
+		where = append(where, "observed_at >= ?")
+		args = append(args, f.From)
+	}
+	if f.To != "" {
+		where = append(where, "observed_at <= ?")
+		args = append(args, f.To)
+	}
+	if f.ServiceID != "" {
+		where = append(where, "(service_id = ? OR target_id = ?)")
+		args = append(args, f.ServiceID, f.ServiceID)
+	}
+	for _, in := range []struct {
+		column string
+		values []string
+	}{{"metric", f.Metrics}, {"severity", f.Severities}} {
+		if len(in.values) == 0 {
+			continue
+		}
+		where = append(where, in.column+" IN ("+placeholders(len(in.values))+")")
+		for _, v := range in.values {
+			args = append(args, v)
+		}
+	}
+
+	query := "SELECT " + anomalyColumns + " FROM anomaly_events"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY observed_at DESC, id DESC"
+	if f.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, f.Limit)
+	}
+
+	rows, err := s.db.Query(s.dialect.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query anomalies: %w", err)
+	}
+	defer rows.Close()
+
+	events := []AnomalyEvent{}
+	for rows.Next() {
+		var e AnomalyEvent
+		var target sql.NullString
+		if err := rows.Scan(&e.ID, &e.ObservedAt, &e.DetectedAt, &e.Kind, &e.ServiceID, &target, &e.Metric,
+			&e.Value, &e.Expected, &e.Direction, &e.DeviationScore, &e.Severity, &e.Method); err != nil {
+			return nil, fmt.Errorf("failed to scan anomaly: %w", err)
+		}
+		e.TargetID = target.String
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+var ErrCalibrationNotFound = errors.New("calibration not found")
+
+// ScalingCalibration holds bounded_sqrt parameters fitted for one service
+// from its observed pod-count changes. RMSE is measured on the latency ratio
+// (new/old), not in milliseconds.
+type ScalingCalibration struct {
+	ServiceID        string  `json:"serviceId"`
+	Metric           string  `json:"metric"`
+	Alpha            float64 `json:"alpha"`
+	MinLatencyFactor float64 `json:"minLatencyFactor"`
+	Samples          int     `json:"samples"`
+	RMSE             float64 `json:"rmse"`
+	WindowStart      string  `json:"windowStart"`
+	WindowEnd        string  `json:"windowEnd"`
+	FittedAt         string  `json:"fittedAt"`
+}
+
+func (s *DecisionStore) SaveCalibration(c ScalingCalibration) error {
+	query := `
+		INSERT INTO scaling_calibrations (service_id, metric, alpha, min_latency_factor, samples, rmse, window_start, window_end, fitted_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(service_id) DO UPDATE SET
+			metric = excluded.metric,
+			alpha = excluded.alpha,
+			min_latency_factor = excluded.min_latency_factor,
+			samples = excluded.samples,
+			rmse = excluded.rmse,
+			window_start = excluded.window_start,
+			window_end = excluded.window_end,
+			fitted_at = excluded.fitted_at
+	`
+	_, err := s.db.Exec(s.dialect.rebind(query), c.ServiceID, c.Metric, c.Alpha, c.MinLatencyFactor, c.Samples, c.RMSE, c.WindowStart, c.WindowEnd, c.FittedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save calibration: %w", err)
+	}
+	return nil
+}
+
+func (s *DecisionStore) GetCalibration(serviceID string) (*ScalingCalibration, error) {
+	row := s.db.QueryRow(s.dialect.rebind(`
+		SELECT service_id, metric, alpha, min_latency_factor, samples, rmse, window_start, window_end, fitted_at
+		FROM scaling_calibrations WHERE service_id = ?
+	`), serviceID)
+	c, err := scanCalibration(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrCalibrationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query calibration: %w", err)
+	}
+	return c, nil
+}
+
+func (s *DecisionStore) ListCalibrations() ([]ScalingCalibration, error) {
+	rows, err := s.db.Query(`
+		SELECT service_id, metric, alpha, min_latency_factor, samples, rmse, window_start, window_end, fitted_at
+		FROM scaling_calibrations ORDER BY service_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query calibrations: %w", err)
+	}
+	defer rows.Close()
+
+	calibrations := []ScalingCalibration{}
+	for rows.Next() {
+		c, err := scanCalibration(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan calibration row: %w", err)
+		}
+		calibrations = append(calibrations, *c)
+	}
+	return calibrations, rows.Err()
+}
+
+func scanCalibration(row rowScanner) (*ScalingCalibration, error) {
+	var c ScalingCalibration
+	err := row.Scan(&c.ServiceID, &c.Metric, &c.Alpha, &c.MinLatencyFactor, &c.Samples, &c.RMSE, &c.WindowStart, &c.WindowEnd, &c.FittedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
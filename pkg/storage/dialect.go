@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+)
+
+// dialect captures the SQL differences between the supported databases.
+// Queries are written with SQLite's "?" placeholders and rebound.
+type dialect struct {
+	name          string
+	sqlDriver     string
+	migrationsDir string
+	numbered      bool
+}
+
+var (
+	sqliteDialect   = dialect{name: DriverSQLite, sqlDriver: "sqlite3", migrationsDir: "migrations/sqlite"}
+	postgresDialect = dialect{name: DriverPostgres, sqlDriver: "pgx", migrationsDir: "migrations/postgres", numbered: true}
+)
+
+func dialectFor(driver string) (dialect, error) {
+	switch driver {
+	case DriverSQLite, "":
+		return sqliteDialect, nil
+	case DriverPostgres:
+		return postgresDialect, nil
+	}
+	return dialect{}, fmt.Errorf("unsupported decision store driver: %s (allowed: %s, %s)", driver, DriverSQLite, DriverPostgres)
+}
+
+// rebind rewrites "?" placeholders to "$1", "$2", ... for PostgreSQL.
+func (d dialect) rebind(query string) string {
+	if !d.numbered {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// jsonText extracts a top-level key of a JSON column as text.
+func (d dialect) jsonText(column, key string) string {
+	if d.name == DriverPostgres {
+		return fmt.Sprintf("%s->>'%s'", column, key)
+	}
+	return fmt.Sprintf("json_extract(%s, '$.%s')", column, key)
+}
@@ -0,0 +1,45 @@
+package storage
+
+import "testing"
+
+func TestRebind(t *testing.T) {
+	tests := []struct {
+		query    string
+		sqlite   string
+		postgres string
+	}{
+		{"SELECT 1", "SELECT 1", "SELECT 1"},
+		{"id = ?", "id = ?", "id = $1"},
+		{"a IN (?,?,?) AND b < ? LIMIT ?", "a IN (?,?,?) AND b < ? LIMIT ?", "a IN ($1,$2,$3) AND b < $4 LIMIT $5"},
+		{"name = ? AND note = 'é'", "name = ? AND note = 'é'", "name = $1 AND note = 'é'"},
+	}
+	for _, tt := range tests {
+		if got := sqliteDialect.rebind(tt.query); got != tt.sqlite {
+			t.Errorf("sqlite rebind(%q) = %q, want %q", tt.query, got, tt.sqlite)
+		}
+		if got := postgresDialect.rebind(tt.query); got != tt.postgres {
+			t.Errorf("postgres rebind(%q) = %q, want %q", tt.query, got, tt.postgres)
+		}
+	}
+}
+
+func TestJSONText(t *testing.T) {
+	if got := sqliteDialect.jsonText("result", "confidence"); got != "json_extract(result, '$.confidence')" {
+		t.Errorf("sqlite jsonText = %q", got)
+	}
+	if got := postgresDialect.jsonText("result", "confidence"); got != "result->>'confidence'" {
+		t.Errorf("postgres jsonText = %q", got)
+	}
+}
+
+func TestDialectFor(t *testing.T) {
+	for driver, want := range map[string]string{"": DriverSQLite, DriverSQLite: DriverSQLite, DriverPostgres: DriverPostgres} {
+		d, err := dialectFor(driver)
+		if err != nil || d.name != want {
+			t.Errorf("dialectFor(%q) = %q, %v; want %q", driver, d.name, err, want)
+		}
+	}
+	if _, err := dialectFor("mysql"); err == nil {
+		t.Error("dialectFor(mysql) succeeded")
+	}
+}
@@ -0,0 +1,292 @@
+package storage
+
+import (
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Migrations live in migrations/<driver>/ as NNNN_description.sql and are
+// applied in version order. Both drivers must have the same versions.
+// Applied migrations must never be edited; add a new file instead.
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+// ErrSchemaTooNew is returned when the database has migrations applied that
+// this binary does not know about, i.e. it was migrated by a newer release.
+var ErrSchemaTooNew = errors.New("database schema is newer than this binary supports")
+
+// ErrPendingMigrations is returned when the database is behind and the
+// store was opened without automatic migration.
+var ErrPendingMigrations = errors.New("database schema has pending migrations")
+
+type Migration struct {
+	Version int
+	Name    string
+	SQL     string
+}
+
+type MigrationStatus struct {
+	Version   int    `json:"version"`
+	Name      string `json:"name"`
+	Applied   bool   `json:"applied"`
+	AppliedAt string `json:"appliedAt,omitempty"`
+}
+
+// Database is an open decision database together with its SQL dialect. The
+// migrate subcommand works on it directly; the server reaches it through
+// DecisionStore.
+type Database struct {
+	db      *sql.DB
+	dialect dialect
+}
+
+func (d *Database) Driver() string {
+	return d.dialect.name
+}
+
+func (d *Database) Close() error {
+	return d.db.Close()
+}
+
+// Migrations returns the embedded migrations for the database's driver in
+// version order.
+func (d *Database) Migrations() ([]Migration, error) {
+	entries, err := migrationFiles.ReadDir(d.dialect.migrationsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	var migrations []Migration
+	seen := map[int]string{}
+	for _, e := range entries {
+		base := strings.TrimSuffix(e.Name(), ".sql")
+		prefix, name, ok := strings.Cut(base, "_")
+		version, err := strconv.Atoi(prefix)
+		if !ok || err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration file name: %s", e.Name())
+		}
+		if other, dup := seen[version]; dup {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", version, other, e.Name())
+		}
+		seen[version] = e.Name()
+
+		body, err := migrationFiles.ReadFile(path.Join(d.dialect.migrationsDir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", e.Name(), err)
+		}
+		migrations = append(migrations, Migration{Version: version, Name: name, SQL: string(body)})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// migrationLockID keys the PostgreSQL advisory lock that serialises
+// migrations between replicas sharing one database.
+const migrationLockID = 7_141_592_653
+
+// lockMigrations blocks until no other process is migrating. The lock is
+// held until tx ends. SQLite needs none: its writers are already serialised.
+func (d *Database) lockMigrations(tx *sql.Tx) error {
+	if d.dialect.name != DriverPostgres {
+		return nil
+	}
+	if _, err := tx.Exec("SELECT pg_advisory_xact_lock($1)", migrationLockID); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	return nil
+}
+
+func (d *Database) ensureMigrationsTable() error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Concurrent CREATE TABLE IF NOT EXISTS can still collide in PostgreSQL.
+	if err := d.lockMigrations(tx); err != nil {
+		return err
+	}
+	_, err = tx.Exec(`
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at TEXT NOT NULL
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return nil
+}
+
+func (d *Database) appliedMigrations() (map[int]string, error) {
+	rows, err := d.db.Query("SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := map[int]string{}
+	for rows.Next() {
+		var version int
+		var appliedAt string
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan schema_migrations row: %w", err)
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// SchemaVersion returns the highest migration version applied, or 0 for a
+// database that has never been migrated.
+func (d *Database) SchemaVersion() (int, error) {
+	if err := d.ensureMigrationsTable(); err != nil {
+		return 0, err
+	}
+	var version sql.NullInt64
+	if err := d.db.QueryRow("SELECT MAX(version) FROM schema_migrations").Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return int(version.Int64), nil
+}
+
+// MigrationsStatus lists every known migration and whether it is applied.
+func (d *Database) MigrationsStatus() ([]MigrationStatus, error) {
+	if err := d.ensureMigrationsTable(); err != nil {
+		return nil, err
+	}
+	migrations, err := d.Migrations()
+	if err != nil {
+		return nil, err
+	}
+	applied, err := d.appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]MigrationStatus, 0, len(migrations))
+	for _, m := range migrations {
+		appliedAt, ok := applied[m.Version]
+		status = append(status, MigrationStatus{Version: m.Version, Name: m.Name, Applied: ok, AppliedAt: appliedAt})
+	}
+	return status, nil
+}
+
+// CheckSchema returns ErrSchemaTooNew if the database has been migrated past
+// what this binary knows, and the number of migrations still to apply
+// otherwise.
+func (d *Database) CheckSchema() (int, error) {
+	current, err := d.SchemaVersion()
+	if err != nil {
+		return 0, err
+	}
+	migrations, err := d.Migrations()
+	if err != nil {
+		return 0, err
+	}
+	latest := 0
+	if len(migrations) > 0 {
+		latest = migrations[len(migrations)-1].Version
+	}
+	if current > latest {
+		return 0, fmt.Errorf("%w: database is at version %d, latest known is %d", ErrSchemaTooNew, current, latest)
+	}
+
+	applied, err := d.appliedMigrations()
+	if err != nil {
+		return 0, err
+	}
+	pending := 0
+	for _, m := range migrations {
+		if _, ok := applied[m.Version]; !ok {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+// MigrateUp applies pending migrations up to and including target, or all of
+// them when target is 0. Each migration runs in its own transaction together
+// with its schema_migrations row, so a failed migration leaves no trace.
+// Replicas starting together may race to migrate; each migration is applied
+// once and the others skip it.
+func (d *Database) MigrateUp(target int) ([]Migration, error) {
+	if _, err := d.CheckSchema(); err != nil {
+		return nil, err
+	}
+	migrations, err := d.Migrations()
+	if err != nil {
+		return nil, err
+	}
+	applied, err := d.appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, m := range migrations {
+		if target > 0 && m.Version > target {
+			break
+		}
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+		ran, err := d.applyMigration(m)
+		if err != nil {
+			return done, err
+		}
+		if ran {
+			done = append(done, m)
+		}
+	}
+	return done, nil
+}
+
+// applyMigration runs m unless another process applied it first, and
+// reports whether it ran.
+func (d *Database) applyMigration(m Migration) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin migration %d: %w", m.Version, err)
+	}
+	defer tx.Rollback()
+
+	if err := d.lockMigrations(tx); err != nil {
+		return false, err
+	}
+	// Re-read under the lock: the applied set read by MigrateUp may be stale.
+	var exists int
+	err = tx.QueryRow(d.dialect.rebind("SELECT COUNT(*) FROM schema_migrations WHERE version = ?"), m.Version).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check migration %d: %w", m.Version, err)
+	}
+	if exists > 0 {
+		return false, nil
+	}
+
+	if _, err := tx.Exec(m.SQL); err != nil {
+		return false, fmt.Errorf("migration %d (%s) failed: %w", m.Version, m.Name, err)
+	}
+	if _, err := tx.Exec(d.dialect.rebind("INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)"),
+		m.Version, m.Name, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return false, fmt.Errorf("failed to record migration %d: %w", m.Version, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit migration %d: %w", m.Version, err)
+	}
+	return true, nil
+}
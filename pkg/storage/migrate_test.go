@@ -0,0 +1,220 @@
+package storage
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func openTestDatabase(t *testing.T, driver, dsn string) *Database {
+	t.Helper()
+	db, err := OpenDatabase(driver, dsn)
+	if err != nil {
+		t.Fatalf("OpenDatabase: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	ts, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ts
+}
+
+func TestMigrationsMatchAcrossDrivers(t *testing.T) {
+	sqlite, err := (&Database{dialect: sqliteDialect}).Migrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	postgres, err := (&Database{dialect: postgresDialect}).Migrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sqlite) != len(postgres) {
+		t.Fatalf("sqlite has %d migrations, postgres %d", len(sqlite), len(postgres))
+	}
+	for i := range sqlite {
+		if sqlite[i].Version != postgres[i].Version || sqlite[i].Name != postgres[i].Name {
+			t.Errorf("migration %d: sqlite %d_%s, postgres %d_%s", i, sqlite[i].Version, sqlite[i].Name, postgres[i].Version, postgres[i].Name)
+		}
+	}
+}
+
+// Migration 0004 backfills the search columns of existing decisions with
+// each database's JSON functions.
+func TestMigrateUpBackfillsSearchColumns(t *testing.T) {
+	for _, driver := range []string{DriverSQLite, DriverPostgres} {
+		t.Run(driver, func(t *testing.T) {
+			db := openTestDatabase(t, driver, testDSN(t, driver))
+
+			if _, err := db.MigrateUp(3); err != nil {
+				t.Fatalf("MigrateUp(3): %v", err)
+			}
+			if pending, err := db.CheckSchema(); err != nil || pending == 0 {
+				t.Fatalf("CheckSchema = %d, %v; want pending migrations", pending, err)
+			}
+			_, err := db.db.Exec(db.dialect.rebind("INSERT INTO decisions (timestamp, type, scenario, result) VALUES (?, ?, ?, ?)"),
+				"2026-01-01T00:00:00Z", "failure",
+				`{"serviceName": "cart"}`,
+				`{"confidence": "low", "totalLostTrafficRps": 7.5, "recommendations": [{"type": "retry"}, {"type": "retry"}, {"type": "circuit-breaker"}]}`)
+			if err != nil {
+				t.Fatalf("insert legacy decision: %v", err)
+			}
+
+			applied, err := db.MigrateUp(0)
+			if err != nil {
+				t.Fatalf("MigrateUp: %v", err)
+			}
+			if len(applied) == 0 || applied[0].Version != 4 {
+				t.Fatalf("applied = %+v, want to start at 4", applied)
+			}
+			if again, err := db.MigrateUp(0); err != nil || len(again) != 0 {
+				t.Errorf("second MigrateUp = %+v, %v; want nothing to do", again, err)
+			}
+
+			s := &DecisionStore{Database: db}
+			min := 5.0
+			page, err := s.SearchDecisions(SearchOptions{
+				ServiceID:           "default:cart",
+				Confidence:          []string{"low"},
+				MinLostTrafficRps:   &min,
+				RecommendationTypes: []string{"circuit-breaker"},
+			})
+			if err != nil || len(page.Decisions) != 1 {
+				t.Fatalf("search backfilled decision = %+v, %v", page, err)
+			}
+
+			var n int
+			if err := db.db.QueryRow("SELECT COUNT(*) FROM decision_recommendations").Scan(&n); err != nil || n != 2 {
+				t.Errorf("decision_recommendations rows = %d, %v; want 2", n, err)
+			}
+			var confidence string
+			if err := db.db.QueryRow("SELECT " + db.dialect.jsonText("result", "confidence") + " FROM decisions").Scan(&confidence); err != nil || confidence != "low" {
+				t.Errorf("jsonText(result, confidence) = %q, %v", confidence, err)
+			}
+		})
+	}
+}
+
+// Migration 0009 rewrites decision timestamps logged with an offset to UTC,
+// so text comparisons against UTC bounds order them correctly.
+func TestMigrateUpNormalizesDecisionTimestamps(t *testing.T) {
+	for _, driver := range []string{DriverSQLite, DriverPostgres} {
+		t.Run(driver, func(t *testing.T) {
+			db := openTestDatabase(t, driver, testDSN(t, driver))
+
+			if _, err := db.MigrateUp(8); err != nil {
+				t.Fatalf("MigrateUp(8): %v", err)
+			}
+			stamps := []string{"2026-01-01T10:00:00+02:00", "2026-01-01T07:30:00.250-01:00", "2026-01-01T09:00:00Z"}
+			for _, ts := range stamps {
+				if _, err := db.db.Exec(db.dialect.rebind("INSERT INTO decisions (timestamp, type, scenario, result) VALUES (?, ?, ?, ?)"), ts, "failure", `{}`, `{}`); err != nil {
+					t.Fatalf("insert legacy decision: %v", err)
+				}
+			}
+			if _, err := db.MigrateUp(0); err != nil {
+				t.Fatalf("MigrateUp: %v", err)
+			}
+
+			rows, err := db.db.Query("SELECT timestamp FROM decisions ORDER BY id")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rows.Close()
+			var got []string
+			for rows.Next() {
+				var ts string
+				if err := rows.Scan(&ts); err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, ts)
+			}
+			want := []string{"2026-01-01T08:00:00Z", "2026-01-01T08:30:00Z", "2026-01-01T09:00:00Z"}
+			if !slices.Equal(got, want) {
+				t.Errorf("timestamps = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestNewDecisionStoreChecksSchema(t *testing.T) {
+	for _, driver := range []string{DriverSQLite, DriverPostgres} {
+		t.Run(driver, func(t *testing.T) {
+			dsn := testDSN(t, driver)
+			db := openTestDatabase(t, driver, dsn)
+
+			if _, err := NewDecisionStore(driver, dsn, false); !errors.Is(err, ErrPendingMigrations) {
+				t.Fatalf("unmigrated store error = %v, want ErrPendingMigrations", err)
+			}
+			s, err := NewDecisionStore(driver, dsn, true)
+			if err != nil {
+				t.Fatalf("NewDecisionStore(autoMigrate): %v", err)
+			}
+			s.Close()
+
+			status, err := db.MigrationsStatus()
+			if err != nil {
+				t.Fatalf("MigrationsStatus: %v", err)
+			}
+			for _, m := range status {
+				if !m.Applied {
+					t.Errorf("migration %d not applied", m.Version)
+				}
+			}
+
+			if _, err := db.db.Exec(db.dialect.rebind("INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)"), 9999, "future", "2030-01-01T00:00:00Z"); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := NewDecisionStore(driver, dsn, true); !errors.Is(err, ErrSchemaTooNew) {
+				t.Errorf("newer schema error = %v, want ErrSchemaTooNew", err)
+			}
+		})
+	}
+}
+
+// Replicas sharing one PostgreSQL database migrate it on startup at the
+// same time; every one of them must come up.
+func TestConcurrentMigrateUp(t *testing.T) {
+	dsn := testDSN(t, DriverPostgres)
+
+	const replicas = 6
+	var wg sync.WaitGroup
+	applied := make([]int, replicas)
+	errs := make([]error, replicas)
+	for i := 0; i < replicas; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			db, err := OpenDatabase(DriverPostgres, dsn)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			defer db.Close()
+			done, err := db.MigrateUp(0)
+			applied[i], errs[i] = len(done), err
+		}(i)
+	}
+	wg.Wait()
+
+	total := 0
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("replica %d: %v", i, err)
+		}
+		total += applied[i]
+	}
+	migrations, err := (&Database{dialect: postgresDialect}).Migrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != len(migrations) {
+		t.Errorf("replicas applied %d migrations in total, want each of the %d once", total, len(migrations))
+	}
+}
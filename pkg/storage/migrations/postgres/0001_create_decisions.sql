@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS decisions (
+	id BIGSERIAL PRIMARY KEY,
+	timestamp TEXT NOT NULL,
+	type TEXT NOT NULL,
+	scenario JSONB NOT NULL,
+	result JSONB NOT NULL,
+	correlation_id TEXT,
+	created_at TEXT DEFAULT to_char(now() AT TIME ZONE 'UTC', 'YYYY-MM-DD HH24:MI:SS')
+);
+
+CREATE INDEX IF NOT EXISTS idx_decisions_timestamp ON decisions(timestamp DESC);
+CREATE INDEX IF NOT EXISTS idx_decisions_type ON decisions(type);
+CREATE INDEX IF NOT EXISTS idx_decisions_correlation_id ON decisions(correlation_id);
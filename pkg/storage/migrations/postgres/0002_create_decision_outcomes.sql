@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS decision_outcomes (
+	id BIGSERIAL PRIMARY KEY,
+	decision_id BIGINT NOT NULL UNIQUE REFERENCES decisions(id),
+	source TEXT NOT NULL,
+	metric TEXT NOT NULL,
+	observed_ms DOUBLE PRECISION NOT NULL,
+	predicted_ms DOUBLE PRECISION,
+	baseline_ms DOUBLE PRECISION,
+	model_type TEXT,
+	window_start TEXT,
+	window_end TEXT,
+	notes TEXT,
+	recorded_at TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_decision_outcomes_model_type ON decision_outcomes(model_type);
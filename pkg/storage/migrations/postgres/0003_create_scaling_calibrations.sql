@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS scaling_calibrations (
+	service_id TEXT PRIMARY KEY,
+	metric TEXT NOT NULL,
+	alpha DOUBLE PRECISION NOT NULL,
+	min_latency_factor DOUBLE PRECISION NOT NULL,
+	samples INTEGER NOT NULL,
+	rmse DOUBLE PRECISION NOT NULL,
+	window_start TEXT NOT NULL,
+	window_end TEXT NOT NULL,
+	fitted_at TEXT NOT NULL
+);
@@ -0,0 +1,30 @@
+ALTER TABLE decisions ADD COLUMN IF NOT EXISTS service_id TEXT;
+ALTER TABLE decisions ADD COLUMN IF NOT EXISTS confidence TEXT;
+ALTER TABLE decisions ADD COLUMN IF NOT EXISTS lost_traffic_rps DOUBLE PRECISION;
+
+UPDATE decisions SET
+	service_id = (
+		SELECT CASE WHEN strpos(ref, ':') > 1 THEN ref ELSE 'default:' || ref END
+		FROM (SELECT COALESCE(NULLIF(scenario->>'serviceId', ''), NULLIF(scenario->>'serviceName', '')) AS ref) s
+	),
+	confidence = result->>'confidence',
+	lost_traffic_rps = (result->>'totalLostTrafficRps')::DOUBLE PRECISION;
+
+CREATE TABLE IF NOT EXISTS decision_recommendations (
+	decision_id BIGINT NOT NULL REFERENCES decisions(id) ON DELETE CASCADE,
+	type TEXT NOT NULL,
+	PRIMARY KEY (decision_id, type)
+);
+
+INSERT INTO decision_recommendations (decision_id, type)
+SELECT d.id, r->>'type'
+FROM decisions d,
+	jsonb_array_elements(CASE WHEN jsonb_typeof(d.result->'recommendations') = 'array' THEN d.result->'recommendations' ELSE '[]'::jsonb END) r
+WHERE r->>'type' IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS idx_decisions_service_id ON decisions(service_id, timestamp DESC);
+CREATE INDEX IF NOT EXISTS idx_decisions_confidence ON decisions(confidence);
+CREATE INDEX IF NOT EXISTS idx_decisions_lost_traffic_rps ON decisions(lost_traffic_rps);
+CREATE INDEX IF NOT EXISTS idx_decisions_timestamp_id ON decisions(timestamp DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_decision_recommendations_type ON decision_recommendations(type, decision_id);
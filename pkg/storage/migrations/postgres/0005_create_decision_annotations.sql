@@ -0,0 +1,36 @@
+ALTER TABLE decisions ADD COLUMN IF NOT EXISTS approval_state TEXT NOT NULL DEFAULT 'proposed';
+ALTER TABLE decisions ADD COLUMN IF NOT EXISTS approval_actor TEXT;
+ALTER TABLE decisions ADD COLUMN IF NOT EXISTS approval_updated_at TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_decisions_approval_state ON decisions(approval_state, timestamp DESC);
+
+CREATE TABLE IF NOT EXISTS decision_tags (
+	decision_id BIGINT NOT NULL REFERENCES decisions(id) ON DELETE CASCADE,
+	tag TEXT NOT NULL,
+	PRIMARY KEY (decision_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_decision_tags_tag ON decision_tags(tag, decision_id);
+
+CREATE TABLE IF NOT EXISTS decision_annotations (
+	id BIGSERIAL PRIMARY KEY,
+	decision_id BIGINT NOT NULL REFERENCES decisions(id) ON DELETE CASCADE,
+	parent_id BIGINT REFERENCES decision_annotations(id),
+	author TEXT NOT NULL,
+	body TEXT NOT NULL,
+	created_at TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_decision_annotations_decision_id ON decision_annotations(decision_id, id);
+
+CREATE TABLE IF NOT EXISTS decision_approvals (
+	id BIGSERIAL PRIMARY KEY,
+	decision_id BIGINT NOT NULL REFERENCES decisions(id) ON DELETE CASCADE,
+	from_state TEXT NOT NULL,
+	state TEXT NOT NULL,
+	actor TEXT NOT NULL,
+	comment TEXT,
+	created_at TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_decision_approvals_decision_id ON decision_approvals(decision_id, id);
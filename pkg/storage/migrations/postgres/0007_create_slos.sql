@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS slos (
+	id BIGSERIAL PRIMARY KEY,
+	service_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	availability_target DOUBLE PRECISION,
+	latency_metric TEXT,
+	latency_target_ms DOUBLE PRECISION,
+	latency_objective DOUBLE PRECISION,
+	window_days INTEGER NOT NULL,
+	created_at TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_slos_service_name ON slos(service_id, name);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS topology_events (
+	id BIGSERIAL PRIMARY KEY,
+	detected_at TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	event_key TEXT NOT NULL,
+	services TEXT NOT NULL,
+	rps DOUBLE PRECISION NOT NULL,
+	severity TEXT NOT NULL,
+	detail TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_topology_events_detected_at ON topology_events(detected_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_topology_events_kind_key ON topology_events(kind, event_key);
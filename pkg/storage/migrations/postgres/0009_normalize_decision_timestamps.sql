@@ -0,0 +1,6 @@
+-- Decision timestamps are compared as text, so rewrite those logged with an
+-- offset or fractional seconds to the UTC RFC 3339 layout used since.
+UPDATE decisions
+SET timestamp = to_char(timestamp::timestamptz AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+WHERE timestamp ~ '^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$'
+	AND timestamp !~ '^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$';
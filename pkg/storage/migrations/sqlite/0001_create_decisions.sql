@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS decisions (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	timestamp TEXT NOT NULL,
+	type TEXT NOT NULL,
+	scenario TEXT NOT NULL,
+	result TEXT NOT NULL,
+	correlation_id TEXT,
+	created_at TEXT DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_decisions_timestamp ON decisions(timestamp DESC);
+CREATE INDEX IF NOT EXISTS idx_decisions_type ON decisions(type);
+CREATE INDEX IF NOT EXISTS idx_decisions_correlation_id ON decisions(correlation_id);
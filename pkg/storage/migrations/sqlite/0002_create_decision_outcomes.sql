@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS decision_outcomes (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	decision_id INTEGER NOT NULL UNIQUE REFERENCES decisions(id),
+	source TEXT NOT NULL,
+	metric TEXT NOT NULL,
+	observed_ms REAL NOT NULL,
+	predicted_ms REAL,
+	baseline_ms REAL,
+	model_type TEXT,
+	window_start TEXT,
+	window_end TEXT,
+	notes TEXT,
+	recorded_at TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_decision_outcomes_model_type ON decision_outcomes(model_type);
@@ -0,0 +1,29 @@
+ALTER TABLE decisions ADD COLUMN service_id TEXT;
+ALTER TABLE decisions ADD COLUMN confidence TEXT;
+ALTER TABLE decisions ADD COLUMN lost_traffic_rps REAL;
+
+UPDATE decisions SET
+	service_id = (
+		SELECT CASE WHEN instr(ref, ':') > 1 THEN ref ELSE 'default:' || ref END
+		FROM (SELECT COALESCE(NULLIF(json_extract(scenario, '$.serviceId'), ''), NULLIF(json_extract(scenario, '$.serviceName'), '')) AS ref)
+	),
+	confidence = json_extract(result, '$.confidence'),
+	lost_traffic_rps = json_extract(result, '$.totalLostTrafficRps');
+
+CREATE TABLE IF NOT EXISTS decision_recommendations (
+	decision_id INTEGER NOT NULL REFERENCES decisions(id) ON DELETE CASCADE,
+	type TEXT NOT NULL,
+	PRIMARY KEY (decision_id, type)
+);
+
+INSERT OR IGNORE INTO decision_recommendations (decision_id, type)
+SELECT d.id, json_extract(r.value, '$.type')
+FROM decisions d, json_each(d.result, '$.recommendations') r
+WHERE json_type(d.result, '$.recommendations') = 'array'
+	AND json_extract(r.value, '$.type') IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_decisions_service_id ON decisions(service_id, timestamp DESC);
+CREATE INDEX IF NOT EXISTS idx_decisions_confidence ON decisions(confidence);
+CREATE INDEX IF NOT EXISTS idx_decisions_lost_traffic_rps ON decisions(lost_traffic_rps);
+CREATE INDEX IF NOT EXISTS idx_decisions_timestamp_id ON decisions(timestamp DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_decision_recommendations_type ON decision_recommendations(type, decision_id);
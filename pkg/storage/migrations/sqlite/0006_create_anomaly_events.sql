@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS anomaly_events (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	observed_at TEXT NOT NULL,
+	detected_at TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	service_id TEXT NOT NULL,
+	target_id TEXT,
+	metric TEXT NOT NULL,
+	value REAL NOT NULL,
+	expected REAL NOT NULL,
+	direction TEXT NOT NULL,
+	deviation_score REAL NOT NULL,
+	severity TEXT NOT NULL,
+	method TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_anomaly_events_observed_at ON anomaly_events(observed_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_anomaly_events_service_id ON anomaly_events(service_id, observed_at DESC);
+CREATE INDEX IF NOT EXISTS idx_anomaly_events_target_id ON anomaly_events(target_id, observed_at DESC);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS slos (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	service_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	availability_target REAL,
+	latency_metric TEXT,
+	latency_target_ms REAL,
+	latency_objective REAL,
+	window_days INTEGER NOT NULL,
+	created_at TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_slos_service_name ON slos(service_id, name);
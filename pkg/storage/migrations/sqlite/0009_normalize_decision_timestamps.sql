@@ -0,0 +1,6 @@
+-- Decision timestamps are compared as text, so rewrite those logged with an
+-- offset or fractional seconds to the UTC RFC 3339 layout used since.
+UPDATE decisions
+SET timestamp = strftime('%Y-%m-%dT%H:%M:%SZ', timestamp)
+WHERE strftime('%Y-%m-%dT%H:%M:%SZ', timestamp) IS NOT NULL
+	AND timestamp <> strftime('%Y-%m-%dT%H:%M:%SZ', timestamp);
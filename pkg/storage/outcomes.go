@@ -0,0 +1,184 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrOutcomeNotFound = errors.New("outcome not found")
+
+// OutcomeInput is an observed result attached to a decision. Recording an
+// outcome for a decision that already has one replaces it.
+type OutcomeInput struct {
+	DecisionID  int64
+	Source      string
+	Metric      string
+	ObservedMs  float64
+	PredictedMs *float64
+	BaselineMs  *float64
+	ModelType   string
+	WindowStart string
+	WindowEnd   string
+	Notes       string
+}
+
+type Outcome struct {
+	DecisionID  int64    `json:"decisionId"`
+	Source      string   `json:"source"`
+	Metric      string   `json:"metric"`
+	ObservedMs  float64  `json:"observedMs"`
+	PredictedMs *float64 `json:"predictedMs"`
+	BaselineMs  *float64 `json:"baselineMs"`
+	ErrorMs     *float64 `json:"errorMs"`
+	ModelType   string   `json:"modelType,omitempty"`
+	WindowStart string   `json:"windowStart,omitempty"`
+	WindowEnd   string   `json:"windowEnd,omitempty"`
+	Notes       string   `json:"notes,omitempty"`
+	RecordedAt  string   `json:"recordedAt"`
+}
+
+func (s *DecisionStore) RecordOutcome(input OutcomeInput) (*Outcome, error) {
+	recordedAt := time.Now().UTC().Format(time.RFC3339)
+	query := `
+		INSERT INTO decision_outcomes (decision_id, source, metric, observed_ms, predicted_ms, baseline_ms, model_type, window_start, window_end, notes, recorded_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(decision_id) DO UPDATE SET
+			source = excluded.source,
+			metric = excluded.metric,
+			observed_ms = excluded.observed_ms,
+			predicted_ms = excluded.predicted_ms,
+			baseline_ms = excluded.baseline_ms,
+			model_type = excluded.model_type,
+			window_start = excluded.window_start,
+			window_end = excluded.window_end,
+			notes = excluded.notes,
+			recorded_at = excluded.recorded_at
+	`
+	_, err := s.db.Exec(s.dialect.rebind(query), input.DecisionID, input.Source, input.Metric, input.ObservedMs, input.PredictedMs, input.BaselineMs,
+		nullString(input.ModelType), nullString(input.WindowStart), nullString(input.WindowEnd), nullString(input.Notes), recordedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record outcome: %w", err)
+	}
+	return s.GetOutcome(input.DecisionID)
+}
+
+const outcomeColumns = "decision_id, source, metric, observed_ms, predicted_ms, baseline_ms, model_type, window_start, window_end, notes, recorded_at"
+
+func (s *DecisionStore) GetOutcome(decisionID int64) (*Outcome, error) {
+	o, err := scanOutcome(s.db.QueryRow(s.dialect.rebind("SELECT "+outcomeColumns+" FROM decision_outcomes WHERE decision_id = ?"), decisionID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrOutcomeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query outcome: %w", err)
+	}
+	return o, nil
+}
+
+func scanOutcome(row rowScanner) (*Outcome, error) {
+	var o Outcome
+	var predicted, baseline sql.NullFloat64
+	var modelType, windowStart, windowEnd, notes sql.NullString
+	err := row.Scan(&o.DecisionID, &o.Source, &o.Metric, &o.ObservedMs, &predicted, &baseline,
+		&modelType, &windowStart, &windowEnd, &notes, &o.RecordedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if predicted.Valid {
+		o.PredictedMs = &predicted.Float64
+		errMs := predicted.Float64 - o.ObservedMs
+		o.ErrorMs = &errMs
+	}
+	if baseline.Valid {
+		o.BaselineMs = &baseline.Float64
+	}
+	o.ModelType = modelType.String
+	o.WindowStart = windowStart.String
+	o.WindowEnd = windowEnd.String
+	o.Notes = notes.String
+	return &o, nil
+}
+
+// PendingScalingDecisions returns scaling decisions made before cutoff that
+// do not have an outcome yet, oldest first.
+func (s *DecisionStore) PendingScalingDecisions(cutoff time.Time, limit int) ([]DecisionRecord, error) {
+	query := `
+		SELECT d.id, d.timestamp, d.type, d.scenario, d.result, d.correlation_id, d.created_at,
+			d.approval_state, d.approval_actor, d.approval_updated_at
+		FROM decisions d
+		LEFT JOIN decision_outcomes o ON o.decision_id = d.id
+		WHERE d.type = 'scaling' AND o.id IS NULL AND d.timestamp <= ?
+		ORDER BY d.timestamp ASC
+		LIMIT ?
+	`
+	rows, err := s.db.Query(s.dialect.rebind(query), cutoff.UTC().Format(time.RFC3339), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pending decisions: %w", err)
+	}
+	defer rows.Close()
+
+	var records []DecisionRecord
+	for rows.Next() {
+		r, err := scanDecision(rows)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, *r)
+	}
+	return records, rows.Err()
+}
+
+// ModelAccuracy aggregates prediction error for one scaling model type.
+// ErrorMs is predicted minus observed, so a positive bias means the model
+// over-estimates latency.
+type ModelAccuracy struct {
+	ModelType  string  `json:"modelType"`
+	Samples    int     `json:"samples"`
+	MAEMs      float64 `json:"maeMs"`
+	MAPEPct    float64 `json:"mapePct"`
+	BiasMs     float64 `json:"biasMs"`
+	MaxErrorMs float64 `json:"maxErrorMs"`
+}
+
+func (s *DecisionStore) GetAccuracy(since string) ([]ModelAccuracy, error) {
+	query := `
+		SELECT COALESCE(model_type, 'unknown'),
+			COUNT(*),
+			AVG(ABS(predicted_ms - observed_ms)),
+			AVG(CASE WHEN observed_ms > 0 THEN ABS(predicted_ms - observed_ms) / observed_ms * 100 END),
+			AVG(predicted_ms - observed_ms),
+			MAX(ABS(predicted_ms - observed_ms))
+		FROM decision_outcomes
+		WHERE predicted_ms IS NOT NULL`
+	args := []interface{}{}
+	if since != "" {
+		query += " AND recorded_at >= ?"
+		args = append(args, since)
+	}
+	query += " GROUP BY COALESCE(model_type, 'unknown') ORDER BY 1"
+
+	rows, err := s.db.Query(s.dialect.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query accuracy: %w", err)
+	}
+	defer rows.Close()
+
+	accuracy := []ModelAccuracy{}
+	for rows.Next() {
+		var a ModelAccuracy
+		var mape sql.NullFloat64
+		if err := rows.Scan(&a.ModelType, &a.Samples, &a.MAEMs, &mape, &a.BiasMs, &a.MaxErrorMs); err != nil {
+			return nil, fmt.Errorf("failed to scan accuracy row: %w", err)
+		}
+		a.MAPEPct = mape.Float64
+		accuracy = append(accuracy, a)
+	}
+	return accuracy, rows.Err()
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
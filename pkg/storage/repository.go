@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"context"
+	"time"
+)
+
+// DecisionWriter records simulation decisions.
+type DecisionWriter interface {
+	LogDecision(input LogDecisionInput) (*DecisionRecord, error)
+}
+
+// DecisionReader reads decisions back by id or page by page.
+type DecisionReader interface {
+	GetHistory(opts GetHistoryOptions) ([]DecisionRecord, error)
+	GetByID(id int64) (*DecisionRecord, error)
+	GetCount(opts GetHistoryOptions) (int, error)
+}
+
+// DecisionSearcher filters decisions on their extracted search columns.
+type DecisionSearcher interface {
+	SearchDecisions(opts SearchOptions) (*SearchPage, error)
+	StreamDecisions(ctx context.Context, opts SearchOptions, fn func(*DecisionRow) error) error
+}
+
+// DecisionPruner finds and deletes decisions past their retention.
+type DecisionPruner interface {
+	ExpiredDecisions(f ExpiryFilter, limit int) ([]DecisionRow, error)
+	DeleteDecisions(ids []int64) (int, error)
+}
+
+// AnnotationStore manages the tags, notes and approval state of decisions.
+type AnnotationStore interface {
+	AddTags(decisionID int64, tags []string) ([]string, error)
+	RemoveTag(decisionID int64, tag string) ([]string, error)
+	AddAnnotation(input AnnotationInput) (*Annotation, error)
+	ListAnnotations(decisionID int64) ([]Annotation, error)
+	SetApproval(input ApprovalInput) (*Approval, error)
+	ApprovalHistory(decisionID int64) ([]ApprovalEvent, error)
+}
+
+// OutcomeStore records what actually happened after scaling decisions.
+type OutcomeStore interface {
+	RecordOutcome(input OutcomeInput) (*Outcome, error)
+	GetOutcome(decisionID int64) (*Outcome, error)
+	PendingScalingDecisions(cutoff time.Time, limit int) ([]DecisionRecord, error)
+	GetAccuracy(since string) ([]ModelAccuracy, error)
+}
+
+// CalibrationStore keeps the fitted bounded_sqrt parameters per service.
+type CalibrationStore interface {
+	SaveCalibration(c ScalingCalibration) error
+	GetCalibration(serviceID string) (*ScalingCalibration, error)
+	ListCalibrations() ([]ScalingCalibration, error)
+}
+
+// AnomalyStore keeps anomalies detected in telemetry.
+type AnomalyStore interface {
+	SaveAnomalies(events []AnomalyEvent) error
+	ListAnomalies(f AnomalyFilter) ([]AnomalyEvent, error)
+}
+
+// SLOStore manages the SLOs services are held to.
+type SLOStore interface {
+	CreateSLO(slo SLO) (*SLO, error)
+	UpdateSLO(slo SLO) (*SLO, error)
+	GetSLO(id int64) (*SLO, error)
+	ListSLOs(serviceID string) ([]SLO, error)
+	DeleteSLO(id int64) error
+}
+
+// TopologyStore keeps risky changes in the dependency topology.
+type TopologyStore interface {
+	SaveTopologyEvents(events []TopologyEvent) error
+	ListTopologyEvents(f TopologyEventFilter) ([]TopologyEvent, error)
+}
+
+// DecisionRepository is everything the decision database holds.
+// DecisionStore implements it for SQLite and PostgreSQL; use PostgreSQL when
+// several replicas must share one history. Consumers should depend on the
+// narrower interfaces above, or on their own, rather than on this one.
+type DecisionRepository interface {
+	DecisionWriter
+	DecisionReader
+	DecisionSearcher
+	DecisionPruner
+	AnnotationStore
+	OutcomeStore
+	CalibrationStore
+	AnomalyStore
+	SLOStore
+	TopologyStore
+
+	Close() error
+}
+
+var _ DecisionRepository = (*DecisionStore)(nil)
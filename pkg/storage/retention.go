@@ -0,0 +1,295 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DecisionRow is a decision as exported or archived: the extracted search
+// columns, the scenario and result JSON exactly as stored, and every row
+// that refers to the decision, so that an archive keeps what pruning
+// deletes.
+type DecisionRow struct {
+	ID                  int64           `json:"id"`
+	Timestamp           string          `json:"timestamp"`
+	Type                string          `json:"type"`
+	ServiceID           string          `json:"serviceId,omitempty"`
+	Confidence          string          `json:"confidence,omitempty"`
+	LostTrafficRps      *float64        `json:"lostTrafficRps,omitempty"`
+	CorrelationID       string          `json:"correlationId,omitempty"`
+	ApprovalState       string          `json:"approvalState"`
+	ApprovalActor       string          `json:"approvalActor,omitempty"`
+	ApprovalUpdatedAt   string          `json:"approvalUpdatedAt,omitempty"`
+	CreatedAt           string          `json:"createdAt"`
+	Scenario            json.RawMessage `json:"scenario"`
+	Result              json.RawMessage `json:"result"`
+	RecommendationTypes []string        `json:"recommendationTypes"`
+	Tags                []string        `json:"tags"`
+	Outcome             *Outcome        `json:"outcome,omitempty"`
+	Annotations         []Annotation    `json:"annotations"`
+	Approvals           []ApprovalEvent `json:"approvals"`
+}
+
+// ExpiryFilter selects decisions older than Before. Types limits the match
+// to those decision types; ExcludeTypes skips types with their own policy.
+type ExpiryFilter struct {
+	Types        []string
+	ExcludeTypes []string
+	Before       string
+}
+
+const decisionRowColumns = "id, timestamp, type, service_id, confidence, lost_traffic_rps, correlation_id, approval_state, approval_actor, approval_updated_at, created_at, scenario, result"
+
+// streamBatchSize is how many decisions StreamDecisions reads, and attaches
+// child rows to, per query.
+const streamBatchSize = 500
+
+// StreamDecisions calls fn for every decision matching opts, oldest first,
+// reading them in batches of streamBatchSize. Cursor and Limit are ignored.
+func (s *DecisionStore) StreamDecisions(ctx context.Context, opts SearchOptions, fn func(*DecisionRow) error) error {
+	where, args := opts.filter()
+	var after *DecisionRow
+	for {
+		batchWhere, batchArgs := where, args
+		if after != nil {
+			batchWhere = append(slices.Clip(where), "(timestamp > ? OR (timestamp = ? AND id > ?))")
+			batchArgs = append(slices.Clip(args), after.Timestamp, after.Timestamp, after.ID)
+		}
+		query := "SELECT " + decisionRowColumns + " FROM decisions"
+		if len(batchWhere) > 0 {
+			query += " WHERE " + strings.Join(batchWhere, " AND ")
+		}
+		query += " ORDER BY timestamp, id LIMIT ?"
+		batchArgs = append(batchArgs, streamBatchSize)
+
+		rows, err := s.queryDecisionRows(ctx, query, batchArgs)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			if err := fn(&rows[i]); err != nil {
+				return err
+			}
+		}
+		if len(rows) < streamBatchSize {
+			return nil
+		}
+		after = &rows[len(rows)-1]
+	}
+}
+
+// retainedApprovalStates are never pruned: an approved or applied decision
+// is the audit record of a change.
+var retainedApprovalStates = []string{ApprovalApproved, ApprovalApplied}
+
+// ExpiredDecisions returns up to limit of the oldest decisions matching f.
+// Decisions in retainedApprovalStates never expire.
+func (s *DecisionStore) ExpiredDecisions(f ExpiryFilter, limit int) ([]DecisionRow, error) {
+	where := []string{"timestamp < ?", "approval_state NOT IN (" + placeholders(len(retainedApprovalStates)) + ")"}
+	args := []interface{}{f.Before}
+	for _, st := range retainedApprovalStates {
+		args = append(args, st)
+	}
+	if len(f.Types) > 0 {
+		where = append(where, "type IN ("+placeholders(len(f.Types))+")")
+		for _, t := range f.Types {
+			args = append(args, t)
+		}
+	}
+	if len(f.ExcludeTypes) > 0 {
+		where = append(where, "type NOT IN ("+placeholders(len(f.ExcludeTypes))+")")
+		for _, t := range f.ExcludeTypes {
+			args = append(args, t)
+		}
+	}
+	query := "SELECT " + decisionRowColumns + " FROM decisions WHERE " + strings.Join(where, " AND ") + " ORDER BY timestamp, id LIMIT ?"
+	args = append(args, limit)
+	return s.queryDecisionRows(context.Background(), query, args)
+}
+
+// queryDecisionRows runs a decision query selecting decisionRowColumns and
+// attaches the child rows of every decision it returns.
+func (s *DecisionStore) queryDecisionRows(ctx context.Context, query string, args []interface{}) ([]DecisionRow, error) {
+	rows, err := s.db.QueryContext(ctx, s.dialect.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query decisions: %w", err)
+	}
+	defer rows.Close()
+
+	out := []DecisionRow{}
+	for rows.Next() {
+		row, err := scanDecisionRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read decisions: %w", err)
+	}
+	rows.Close()
+
+	if err := s.attachDecisionChildren(ctx, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// attachDecisionChildren fills the recommendation types, tags, outcome,
+// annotations and approval history of rows with one query per table.
+func (s *DecisionStore) attachDecisionChildren(ctx context.Context, rows []DecisionRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	index := make(map[int64]*DecisionRow, len(rows))
+	args := make([]interface{}, len(rows))
+	for i := range rows {
+		r := &rows[i]
+		r.RecommendationTypes, r.Tags, r.Annotations, r.Approvals = []string{}, []string{}, []Annotation{}, []ApprovalEvent{}
+		index[r.ID] = r
+		args[i] = r.ID
+	}
+	in := "(" + placeholders(len(rows)) + ")"
+
+	each := func(what, query string, scan func(*sql.Rows) error) error {
+		res, err := s.db.QueryContext(ctx, s.dialect.rebind(query), args...)
+		if err != nil {
+			return fmt.Errorf("failed to query %s: %w", what, err)
+		}
+		defer res.Close()
+		for res.Next() {
+			if err := scan(res); err != nil {
+				return fmt.Errorf("failed to scan %s: %w", what, err)
+			}
+		}
+		return res.Err()
+	}
+
+	err := each("recommendations", "SELECT decision_id, type FROM decision_recommendations WHERE decision_id IN "+in+" ORDER BY type", func(res *sql.Rows) error {
+		var id int64
+		var t string
+		if err := res.Scan(&id, &t); err != nil {
+			return err
+		}
+		index[id].RecommendationTypes = append(index[id].RecommendationTypes, t)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	err = each("tags", "SELECT decision_id, tag FROM decision_tags WHERE decision_id IN "+in+" ORDER BY tag", func(res *sql.Rows) error {
+		var id int64
+		var t string
+		if err := res.Scan(&id, &t); err != nil {
+			return err
+		}
+		index[id].Tags = append(index[id].Tags, t)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	err = each("outcomes", "SELECT "+outcomeColumns+" FROM decision_outcomes WHERE decision_id IN "+in, func(res *sql.Rows) error {
+		o, err := scanOutcome(res)
+		if err != nil {
+			return err
+		}
+		index[o.DecisionID].Outcome = o
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	err = each("annotations", "SELECT id, decision_id, parent_id, author, body, created_at FROM decision_annotations WHERE decision_id IN "+in+" ORDER BY id", func(res *sql.Rows) error {
+		var a Annotation
+		var parent sql.NullInt64
+		if err := res.Scan(&a.ID, &a.DecisionID, &parent, &a.Author, &a.Body, &a.CreatedAt); err != nil {
+			return err
+		}
+		if parent.Valid {
+			a.ParentID = &parent.Int64
+		}
+		index[a.DecisionID].Annotations = append(index[a.DecisionID].Annotations, a)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return each("approvals", "SELECT decision_id, id, from_state, state, actor, comment, created_at FROM decision_approvals WHERE decision_id IN "+in+" ORDER BY id", func(res *sql.Rows) error {
+		var id int64
+		var e ApprovalEvent
+		var comment sql.NullString
+		if err := res.Scan(&id, &e.ID, &e.FromState, &e.State, &e.Actor, &comment, &e.CreatedAt); err != nil {
+			return err
+		}
+		e.Comment = comment.String
+		index[id].Approvals = append(index[id].Approvals, e)
+		return nil
+	})
+}
+
+// DeleteDecisions removes the given decisions together with their outcomes,
+// recommendation rows, tags, annotations and approval history, and returns
+// how many decisions were deleted. The rows ExpiredDecisions returns carry
+// all of these, so archiving them first loses nothing.
+func (s *DecisionStore) DeleteDecisions(ids []int64) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	in := "(" + placeholders(len(ids)) + ")"
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"decision_recommendations", "decision_outcomes", "decision_tags", "decision_annotations", "decision_approvals"} {
+		if _, err := tx.Exec(s.dialect.rebind("DELETE FROM "+table+" WHERE decision_id IN "+in), args...); err != nil {
+			return 0, fmt.Errorf("failed to delete from %s: %w", table, err)
+		}
+	}
+	res, err := tx.Exec(s.dialect.rebind("DELETE FROM decisions WHERE id IN "+in), args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete decisions: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete decisions: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit deletion: %w", err)
+	}
+	return int(n), nil
+}
+
+func scanDecisionRow(row rowScanner) (*DecisionRow, error) {
+	var r DecisionRow
+	var serviceID, confidence, corrID, approvalActor, approvalUpdatedAt, createdAt sql.NullString
+	var lost sql.NullFloat64
+	var scenario, result []byte
+
+	if err := row.Scan(&r.ID, &r.Timestamp, &r.Type, &serviceID, &confidence, &lost, &corrID, &r.ApprovalState, &approvalActor, &approvalUpdatedAt, &createdAt, &scenario, &result); err != nil {
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
+	r.ServiceID = serviceID.String
+	r.Confidence = confidence.String
+	r.CorrelationID = corrID.String
+	r.ApprovalActor = approvalActor.String
+	r.ApprovalUpdatedAt = approvalUpdatedAt.String
+	r.CreatedAt = createdAt.String
+	if lost.Valid {
+		r.LostTrafficRps = &lost.Float64
+	}
+	r.Scenario = json.RawMessage(scenario)
+	r.Result = json.RawMessage(result)
+	return &r, nil
+}
@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// SearchOptions filters decisions on the columns extracted when they are
+// logged and on their tags and approval state. Empty fields do not filter;
+// a decision must carry every tag in Tags. From and To bound the decision
+// timestamp (inclusive) and must use the same RFC 3339 layout as stored
+// timestamps to compare correctly.
+type SearchOptions struct {
+	Type                string
+	ServiceID           string
+	CorrelationID       string
+	From                string
+	To                  string
+	Confidence          []string
+	MinLostTrafficRps   *float64
+	RecommendationTypes []string
+	Tags                []string
+	ApprovalStates      []string
+	Cursor              string
+	Limit               int
+}
+
+// SearchPage is one page of search results, newest first. NextCursor is
+// empty on the last page.
+type SearchPage struct {
+	Decisions  []DecisionRecord `json:"decisions"`
+	NextCursor string           `json:"nextCursor,omitempty"`
+	Limit      int              `json:"limit"`
+}
+
+func (s *DecisionStore) SearchDecisions(opts SearchOptions) (*SearchPage, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	where, args := opts.filter()
+	if opts.Cursor != "" {
+		ts, id, err := decodeCursor(opts.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, "(timestamp < ? OR (timestamp = ? AND id < ?))")
+		args = append(args, ts, ts, id)
+	}
+
+	query := "SELECT " + decisionColumns + " FROM decisions"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	// Fetch one extra row to learn whether another page follows.
+	query += " ORDER BY timestamp DESC, id DESC LIMIT ?"
+	args = append(args, limit+1)
+
+	rows, err := s.db.Query(s.dialect.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search decisions: %w", err)
+	}
+	defer rows.Close()
+
+	page := &SearchPage{Decisions: []DecisionRecord{}, Limit: limit}
+	for rows.Next() {
+		r, err := scanDecision(rows)
+		if err != nil {
+			return nil, err
+		}
+		page.Decisions = append(page.Decisions, *r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search decisions: %w", err)
+	}
+
+	if len(page.Decisions) > limit {
+		page.Decisions = page.Decisions[:limit]
+		last := page.Decisions[limit-1]
+		page.NextCursor = encodeCursor(last.Timestamp, last.ID)
+	}
+	return page, s.attachTags(page.Decisions)
+}
+
+// filter builds the WHERE conditions shared by search and export. Cursor
+// and Limit are left to the caller.
+func (opts SearchOptions) filter() ([]string, []interface{}) {
+	where := []string{}
+	args := []interface{}{}
+	if opts.Type != "" {
+		where = append(where, "type = ?")
+		args = append(args, opts.Type)
+	}
+	if opts.ServiceID != "" {
+		where = append(where, "service_id = ?")
+		args = append(args, canonicalServiceID(opts.ServiceID))
+	}
+	if opts.CorrelationID != "" {
+		where = append(where, "correlation_id = ?")
+		args = append(args, opts.CorrelationID)
+	}
+	if opts.From != "" {
+		where = append(where, "timestamp >= ?")
+		args = append(args, opts.From)
+	}
+	if opts.To != "" {
+		where = append(where, "timestamp <= ?")
+		args = append(args, opts.To)
+	}
+	if len(opts.Confidence) > 0 {
+		where = append(where, "confidence IN ("+placeholders(len(opts.Confidence))+")")
+		for _, c := range opts.Confidence {
+			args = append(args, c)
+		}
+	}
+	if opts.MinLostTrafficRps != nil {
+		where = append(where, "lost_traffic_rps >= ?")
+		args = append(args, *opts.MinLostTrafficRps)
+	}
+	if len(opts.RecommendationTypes) > 0 {
+		where = append(where, "EXISTS (SELECT 1 FROM decision_recommendations r WHERE r.decision_id = decisions.id AND r.type IN ("+placeholders(len(opts.RecommendationTypes))+"))")
+		for _, t := range opts.RecommendationTypes {
+			args = append(args, t)
+		}
+	}
+	for _, tag := range opts.Tags {
+		where = append(where, "EXISTS (SELECT 1 FROM decision_tags t WHERE t.decision_id = decisions.id AND t.tag = ?)")
+		args = append(args, tag)
+	}
+	if len(opts.ApprovalStates) > 0 {
+		where = append(where, "approval_state IN ("+placeholders(len(opts.ApprovalStates))+")")
+		for _, st := range opts.ApprovalStates {
+			args = append(args, st)
+		}
+	}
+	return where, args
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
+
+// Cursors are opaque to clients: the (timestamp, id) of the last row served.
+func encodeCursor(timestamp string, id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(timestamp + "|" + strconv.FormatInt(id, 10)))
+}
+
+func decodeCursor(cursor string) (string, int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", 0, ErrInvalidCursor
+	}
+	i := strings.LastIndex(string(raw), "|")
+	if i < 0 {
+		return "", 0, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(string(raw[i+1:]), 10, 64)
+	if err != nil {
+		return "", 0, ErrInvalidCursor
+	}
+	return string(raw[:i]), id, nil
+}
@@ -0,0 +1,181 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrSLONotFound = errors.New("slo not found")
+	ErrSLOExists   = errors.New("an slo with this name already exists for the service")
+)
+
+// SLO is a service level objective. An SLO has an availability objective,
+// a latency objective, or both. AvailabilityTarget is the fraction of
+// requests that must succeed; LatencyObjective is the fraction of time
+// LatencyMetric must stay at or below LatencyTargetMs.
+type SLO struct {
+	ID                 int64    `json:"id"`
+	ServiceID          string   `json:"serviceId"`
+	Name               string   `json:"name"`
+	AvailabilityTarget *float64 `json:"availabilityTarget,omitempty"`
+	LatencyMetric      string   `json:"latencyMetric,omitempty"`
+	LatencyTargetMs    *float64 `json:"latencyTargetMs,omitempty"`
+	LatencyObjective   *float64 `json:"latencyObjective,omitempty"`
+	WindowDays         int      `json:"windowDays"`
+	CreatedAt          string   `json:"createdAt"`
+	UpdatedAt          string   `json:"updatedAt"`
+}
+
+const sloColumns = "id, service_id, name, availability_target, latency_metric, latency_target_ms, latency_objective, window_days, created_at, updated_at"
+
+func (s *DecisionStore) CreateSLO(slo SLO) (*SLO, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.checkSLOName(tx, slo); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	slo.CreatedAt, slo.UpdatedAt = now, now
+	err = tx.QueryRow(s.dialect.rebind(`
+		INSERT INTO slos (service_id, name, availability_target, latency_metric, latency_target_ms, latency_objective, window_days, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING id
+	`), slo.ServiceID, slo.Name, slo.AvailabilityTarget, nullString(slo.LatencyMetric), slo.LatencyTargetMs, slo.LatencyObjective,
+		slo.WindowDays, slo.CreatedAt, slo.UpdatedAt).Scan(&slo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert slo: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit slo: %w", err)
+	}
+	return &slo, nil
+}
+
+// UpdateSLO replaces every field of the SLO with the given ID except its
+// creation time.
+func (s *DecisionStore) UpdateSLO(slo SLO) (*SLO, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	current, err := scanSLO(tx.QueryRow(s.dialect.rebind("SELECT "+sloColumns+" FROM slos WHERE id = ?"), slo.ID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSLONotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query slo: %w", err)
+	}
+	if err := s.checkSLOName(tx, slo); err != nil {
+		return nil, err
+	}
+	slo.CreatedAt = current.CreatedAt
+	slo.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	_, err = tx.Exec(s.dialect.rebind(`
+		UPDATE slos SET service_id = ?, name = ?, availability_target = ?, latency_metric = ?, latency_target_ms = ?,
+			latency_objective = ?, window_days = ?, updated_at = ?
+		WHERE id = ?
+	`), slo.ServiceID, slo.Name, slo.AvailabilityTarget, nullString(slo.LatencyMetric), slo.LatencyTargetMs,
+		slo.LatencyObjective, slo.WindowDays, slo.UpdatedAt, slo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update slo: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit slo: %w", err)
+	}
+	return &slo, nil
+}
+
+func (s *DecisionStore) GetSLO(id int64) (*SLO, error) {
+	slo, err := scanSLO(s.db.QueryRow(s.dialect.rebind("SELECT "+sloColumns+" FROM slos WHERE id = ?"), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSLONotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query slo: %w", err)
+	}
+	return slo, nil
+}
+
+// ListSLOs returns the SLOs of a service, or of every service when
+// serviceID is empty.
+func (s *DecisionStore) ListSLOs(serviceID string) ([]SLO, error) {
+	query := "SELECT " + sloColumns + " FROM slos"
+	var args []interface{}
+	if serviceID != "" {
+		query += " WHERE service_id = ?"
+		args = append(args, serviceID)
+	}
+	query += " ORDER BY service_id, name"
+
+	rows, err := s.db.Query(s.dialect.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query slos: %w", err)
+	}
+	defer rows.Close()
+
+	slos := []SLO{}
+	for rows.Next() {
+		slo, err := scanSLO(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan slo: %w", err)
+		}
+		slos = append(slos, *slo)
+	}
+	return slos, rows.Err()
+}
+
+func (s *DecisionStore) DeleteSLO(id int64) error {
+	res, err := s.db.Exec(s.dialect.rebind("DELETE FROM slos WHERE id = ?"), id)
+	if err != nil {
+		return fmt.Errorf("failed to delete slo: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrSLONotFound
+	}
+	return nil
+}
+
+// checkSLOName returns ErrSLOExists if another SLO of the same service
+// already uses slo.Name.
+func (s *DecisionStore) checkSLOName(tx *sql.Tx, slo SLO) error {
+	var id int64
+	err := tx.QueryRow(s.dialect.rebind("SELECT id FROM slos WHERE service_id = ? AND name = ? AND id <> ?"), slo.ServiceID, slo.Name, slo.ID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check slo name: %w", err)
+	}
+	return ErrSLOExists
+}
+
+func scanSLO(row rowScanner) (*SLO, error) {
+	var slo SLO
+	var availability, latencyTarget, latencyObjective sql.NullFloat64
+	var latencyMetric sql.NullString
+	err := row.Scan(&slo.ID, &slo.ServiceID, &slo.Name, &availability, &latencyMetric, &latencyTarget, &latencyObjective,
+		&slo.WindowDays, &slo.CreatedAt, &slo.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	slo.AvailabilityTarget = nullFloat(availability)
+	slo.LatencyMetric = latencyMetric.String
+	slo.LatencyTargetMs = nullFloat(latencyTarget)
+	slo.LatencyObjective = nullFloat(latencyObjective)
+	return &slo, nil
+}
+
+func nullFloat(f sql.NullFloat64) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
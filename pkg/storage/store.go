@@ -3,71 +3,84 @@ package storage
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 )
 
+var ErrDecisionNotFound = errors.New("decision not found")
+
+// DecisionStore is the database/sql implementation of DecisionRepository,
+// backed by SQLite or PostgreSQL.
 type DecisionStore struct {
-	db *sql.DB
+	*Database
 }
 
-func NewDecisionStore(dbPath string) (*DecisionStore, error) {
+// OpenDatabase connects to the decision database without touching its
+// schema. For SQLite dsn is the file path, whose directory is created if
+// needed; for PostgreSQL it is a connection string.
+func OpenDatabase(driver, dsn string) (*Database, error) {
+	d, err := dialectFor(driver)
+	if err != nil {
+		return nil, err
+	}
+	if dsn == "" {
+		return nil, fmt.Errorf("no data source configured for the %s decision store", d.name)
+	}
 
-	if err := os.MkdirAll(filepath.Dir(dbPath), 0755); err != nil {
-		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	if d.name == DriverSQLite {
+		if err := os.MkdirAll(filepath.Dir(dsn), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create data directory: %w", err)
+		}
 	}
 
-	db, err := sql.Open("sqlite3", dbPath)
+	db, err := sql.Open(d.sqlDriver, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
 	if err := db.Ping(); err != nil {
+		db.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	if _, err := db.Exec("PRAGMA journal_mode = WAL;"); err != nil {
-		return nil, fmt.Errorf("failed to enable WAL mode: %w", err)
+	if d.name == DriverSQLite {
+		if _, err := db.Exec("PRAGMA journal_mode = WAL;"); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("failed to enable WAL mode: %w", err)
+		}
 	}
+	return &Database{db: db, dialect: d}, nil
+}
 
-	store := &DecisionStore{db: db}
-	if err := store.initSchema(); err != nil {
-		db.Close()
+// NewDecisionStore opens the store and checks its schema. It refuses to open
+// a database migrated by a newer release. Pending migrations are applied when
+// autoMigrate is set; otherwise they are reported as ErrPendingMigrations.
+func NewDecisionStore(driver, dsn string, autoMigrate bool) (*DecisionStore, error) {
+	d, err := OpenDatabase(driver, dsn)
+	if err != nil {
 		return nil, err
 	}
 
-	return store, nil
-}
-
-func (s *DecisionStore) initSchema() error {
-	schema := `
-	CREATE TABLE IF NOT EXISTS decisions (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		timestamp TEXT NOT NULL,
-		type TEXT NOT NULL,
-		scenario TEXT NOT NULL,
-		result TEXT NOT NULL,
-		correlation_id TEXT,
-		created_at TEXT DEFAULT CURRENT_TIMESTAMP
-	);
-
-	CREATE INDEX IF NOT EXISTS idx_decisions_timestamp ON decisions(timestamp DESC);
-	CREATE INDEX IF NOT EXISTS idx_decisions_type ON decisions(type);
-	CREATE INDEX IF NOT EXISTS idx_decisions_correlation_id ON decisions(correlation_id);
-	`
-	_, err := s.db.Exec(schema)
+	pending, err := d.CheckSchema()
+	if err == nil && pending > 0 {
+		if autoMigrate {
+			_, err = d.MigrateUp(0)
+		} else {
+			err = fmt.Errorf("%w: %d to apply, run the migrate subcommand", ErrPendingMigrations, pending)
+		}
+	}
 	if err != nil {
-		return fmt.Errorf("failed to init schema: %w", err)
+		d.Close()
+		return nil, err
 	}
-	return nil
-}
 
-func (s *DecisionStore) Close() error {
-	return s.db.Close()
+	return &DecisionStore{Database: d}, nil
 }
 
 type LogDecisionInput struct {
@@ -76,6 +89,7 @@ type LogDecisionInput struct {
 	Scenario      interface{} `json:"scenario"`
 	Result        interface{} `json:"result"`
 	CorrelationID string      `json:"correlationId"`
+	Tags          []string    `json:"tags,omitempty"`
 }
 
 type DecisionRecord struct {
@@ -86,8 +100,12 @@ type DecisionRecord struct {
 	Result        interface{} `json:"result"`
 	CorrelationID string      `json:"correlationId"`
 	CreatedAt     string      `json:"createdAt"`
+	Tags          []string    `json:"tags"`
+	Approval      Approval    `json:"approval"`
 }
 
+const decisionColumns = "id, timestamp, type, scenario, result, correlation_id, created_at, approval_state, approval_actor, approval_updated_at"
+
 func (s *DecisionStore) LogDecision(input LogDecisionInput) (*DecisionRecord, error) {
 	scenarioJSON, err := json.Marshal(input.Scenario)
 	if err != nil {
@@ -98,18 +116,36 @@ func (s *DecisionStore) LogDecision(input LogDecisionInput) (*DecisionRecord, er
 		return nil, fmt.Errorf("failed to marshal result: %w", err)
 	}
 
+	fields := extractSearchFields(scenarioJSON, resultJSON)
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	query := `
-		INSERT INTO decisions (timestamp, type, scenario, result, correlation_id)
-		VALUES (?, ?, ?, ?, ?)
+		INSERT INTO decisions (timestamp, type, scenario, result, correlation_id, service_id, confidence, lost_traffic_rps)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		RETURNING id
 	`
-	res, err := s.db.Exec(query, input.Timestamp, input.Type, string(scenarioJSON), string(resultJSON), input.CorrelationID)
+	var id int64
+	err = tx.QueryRow(s.dialect.rebind(query), input.Timestamp, input.Type, string(scenarioJSON), string(resultJSON), input.CorrelationID,
+		nullString(fields.serviceID), nullString(fields.confidence), fields.lostTrafficRps).Scan(&id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to insert decision: %w", err)
 	}
-
-	id, err := res.LastInsertId()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	for _, t := range fields.recommendationTypes {
+		if _, err := tx.Exec(s.dialect.rebind("INSERT INTO decision_recommendations (decision_id, type) VALUES (?, ?)"), id, t); err != nil {
+			return nil, fmt.Errorf("failed to insert decision recommendation: %w", err)
+		}
+	}
+	tags := normalizeTags(input.Tags)
+	if err := s.insertTags(tx, id, tags); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit decision: %w", err)
 	}
 
 	return &DecisionRecord{
@@ -120,13 +156,42 @@ func (s *DecisionStore) LogDecision(input LogDecisionInput) (*DecisionRecord, er
 		Result:        input.Result,
 		CorrelationID: input.CorrelationID,
 		CreatedAt:     time.Now().UTC().Format(time.RFC3339),
+		Tags:          tags,
+		Approval:      Approval{State: ApprovalProposed},
 	}, nil
 }
 
+// DecodeResult converts the stored result payload into dest, typically one
+// of the simulation result types.
+func (r *DecisionRecord) DecodeResult(dest interface{}) error {
+	data, err := json.Marshal(r.Result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result: %w", err)
+	}
+	if err := json.Unmarshal(data, dest); err != nil {
+		return fmt.Errorf("failed to decode result: %w", err)
+	}
+	return nil
+}
+
 type GetHistoryOptions struct {
-	Limit  int
-	Offset int
-	Type   string
+	Limit         int
+	Offset        int
+	Type          string
+	ServiceID     string
+	Tag           string
+	ApprovalState string
+}
+
+func (opts GetHistoryOptions) filter() ([]string, []interface{}) {
+	f := SearchOptions{Type: opts.Type, ServiceID: opts.ServiceID}
+	if opts.Tag != "" {
+		f.Tags = []string{opts.Tag}
+	}
+	if opts.ApprovalState != "" {
+		f.ApprovalStates = []string{opts.ApprovalState}
+	}
+	return f.filter()
 }
 
 func (s *DecisionStore) GetHistory(opts GetHistoryOptions) ([]DecisionRecord, error) {
@@ -142,18 +207,16 @@ func (s *DecisionStore) GetHistory(opts GetHistoryOptions) ([]DecisionRecord, er
 		offset = 0
 	}
 
-	query := "SELECT id, timestamp, type, scenario, result, correlation_id, created_at FROM decisions"
-	args := []interface{}{}
-
-	if opts.Type != "" {
-		query += " WHERE type = ?"
-		args = append(args, opts.Type)
+	query := "SELECT " + decisionColumns + " FROM decisions"
+	where, args := opts.filter()
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
 	}
 
 	query += " ORDER BY timestamp DESC LIMIT ? OFFSET ?"
 	args = append(args, limit, offset)
 
-	rows, err := s.db.Query(query, args...)
+	rows, err := s.db.Query(s.dialect.rebind(query), args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query decisions: %w", err)
 	}
@@ -161,41 +224,136 @@ func (s *DecisionStore) GetHistory(opts GetHistoryOptions) ([]DecisionRecord, er
 
 	var records []DecisionRecord
 	for rows.Next() {
-		var r DecisionRecord
-		var scenarioStr, resultStr string
-		var corrID sql.NullString
-
-		if err := rows.Scan(&r.ID, &r.Timestamp, &r.Type, &scenarioStr, &resultStr, &corrID, &r.CreatedAt); err != nil {
-			return nil, fmt.Errorf("failed to scan row: %w", err)
+		r, err := scanDecision(rows)
+		if err != nil {
+			return nil, err
 		}
+		records = append(records, *r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query decisions: %w", err)
+	}
 
-		if corrID.Valid {
-			r.CorrelationID = corrID.String
-		}
+	return records, s.attachTags(records)
+}
 
-		if err := json.Unmarshal([]byte(scenarioStr), &r.Scenario); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal scenario: %w", err)
-		}
-		if err := json.Unmarshal([]byte(resultStr), &r.Result); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal result: %w", err)
+// GetByID returns the decision with the given id, or ErrDecisionNotFound.
+func (s *DecisionStore) GetByID(id int64) (*DecisionRecord, error) {
+	row := s.db.QueryRow(s.dialect.rebind("SELECT "+decisionColumns+" FROM decisions WHERE id = ?"), id)
+	r, err := scanDecision(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDecisionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	records := []DecisionRecord{*r}
+	if err := s.attachTags(records); err != nil {
+		return nil, err
+	}
+	return &records[0], nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanDecision(row rowScanner) (*DecisionRecord, error) {
+	var r DecisionRecord
+	var scenarioStr, resultStr string
+	var corrID, approvalActor, approvalAt sql.NullString
+
+	if err := row.Scan(&r.ID, &r.Timestamp, &r.Type, &scenarioStr, &resultStr, &corrID, &r.CreatedAt,
+		&r.Approval.State, &approvalActor, &approvalAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
 		}
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
+
+	if corrID.Valid {
+		r.CorrelationID = corrID.String
+	}
+	r.Approval.Actor = approvalActor.String
+	r.Approval.UpdatedAt = approvalAt.String
+	r.Tags = []string{}
+
+	if err := json.Unmarshal([]byte(scenarioStr), &r.Scenario); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scenario: %w", err)
+	}
+	if err := json.Unmarshal([]byte(resultStr), &r.Result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal result: %w", err)
+	}
+
+	return &r, nil
+}
 
-		records = append(records, r)
+// canonicalServiceID mirrors simulation.CanonicalServiceId, which storage
+// cannot import.
+func canonicalServiceID(ref string) string {
+	if ref == "" {
+		return ""
 	}
+	if i := strings.Index(ref, ":"); i > 0 {
+		return ref
+	}
+	return "default:" + ref
+}
 
-	return records, nil
+type searchFields struct {
+	serviceID           string
+	confidence          string
+	lostTrafficRps      *float64
+	recommendationTypes []string
+}
+
+// extractSearchFields pulls the indexed search columns out of the encoded
+// scenario and result. Payloads of other shapes simply leave them empty.
+func extractSearchFields(scenarioJSON, resultJSON []byte) searchFields {
+	var scenario struct {
+		ServiceID   string `json:"serviceId"`
+		ServiceName string `json:"serviceName"`
+	}
+	var result struct {
+		Confidence          string   `json:"confidence"`
+		TotalLostTrafficRps *float64 `json:"totalLostTrafficRps"`
+		Recommendations     []struct {
+			Type string `json:"type"`
+		} `json:"recommendations"`
+	}
+	_ = json.Unmarshal(scenarioJSON, &scenario)
+	_ = json.Unmarshal(resultJSON, &result)
+
+	ref := scenario.ServiceID
+	if ref == "" {
+		ref = scenario.ServiceName
+	}
+	f := searchFields{
+		serviceID:      canonicalServiceID(ref),
+		confidence:     result.Confidence,
+		lostTrafficRps: result.TotalLostTrafficRps,
+	}
+	seen := map[string]bool{}
+	for _, r := range result.Recommendations {
+		if r.Type != "" && !seen[r.Type] {
+			seen[r.Type] = true
+			f.recommendationTypes = append(f.recommendationTypes, r.Type)
+		}
+	}
+	return f
 }
 
-func (s *DecisionStore) GetCount(decisionType string) (int, error) {
+// GetCount returns how many decisions match the filters of opts; Limit and
+// Offset are ignored.
+func (s *DecisionStore) GetCount(opts GetHistoryOptions) (int, error) {
 	query := "SELECT COUNT(*) FROM decisions"
-	args := []interface{}{}
-	if decisionType != "" {
-		query += " WHERE type = ?"
-		args = append(args, decisionType)
+	where, args := opts.filter()
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
 	}
 
 	var count int
-	err := s.db.QueryRow(query, args...).Scan(&count)
+	err := s.db.QueryRow(s.dialect.rebind(query), args...).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count decisions: %w", err)
 	}
@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	TopologyKindCycleDetected = "cycle_detected"
+	TopologyKindCycleResolved = "cycle_resolved"
+)
+
+// TopologyEvent is a change in the dependency graph that makes failures more
+// likely to spread, such as a new dependency cycle. Key identifies what the
+// event is about, e.g. the cycle path, so repeated detections can be matched.
+type TopologyEvent struct {
+	ID         int64    `json:"id"`
+	DetectedAt string   `json:"detectedAt"`
+	Kind       string   `json:"kind"`
+	Key        string   `json:"key"`
+	Services   []string `json:"services"`
+	RPS        float64  `json:"rps"`
+	Severity   string   `json:"severity"`
+	Detail     string   `json:"detail,omitempty"`
+}
+
+type TopologyEventFilter struct {
+	From  string
+	To    string
+	Kinds []string
+	Limit int
+}
+
+const topologyEventColumns = "id, detected_at, kind, event_key, services, rps, severity, detail"
+
+// SaveTopologyEvents stores events and sets their IDs.
+func (s *DecisionStore) SaveTopologyEvents(events []TopologyEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := s.dialect.rebind(`
+		INSERT INTO topology_events (detected_at, kind, event_key, services, rps, severity, detail)
+		VALUES (?, ?, ?, ?, ?, ?, ?) RETURNING id
+	`)
+	for i := range events {
+		e := &events[i]
+		services, err := json.Marshal(e.Services)
+		if err != nil {
+			return fmt.Errorf("failed to marshal services: %w", err)
+		}
+		err = tx.QueryRow(query, e.DetectedAt, e.Kind, e.Key, string(services), e.RPS, e.Severity, nullString(e.Detail)).Scan(&e.ID)
+		if err != nil {
+			return fmt.Errorf("failed to insert topology event: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit topology events: %w", err)
+	}
+	return nil
+}
+
+// ListTopologyEvents returns the events matching f, most recent first.
+func (s *DecisionStore) ListTopologyEvents(f TopologyEventFilter) ([]TopologyEvent, error) {
+	var where []string
+	var args []interface{}
+	if f.From != "" {
+		where = append(where, "detected_at >= ?")
+		args = append(args, f.From)
+	}
+	if f.To != "" {
+		where = append(where, "detected_at <= ?")
+		args = append(args, f.To)
+	}
+	if len(f.Kinds) > 0 {
+		where = append(where, "kind IN ("+placeholders(len(f.Kinds))+")")
+		for _, k := range f.Kinds {
+			args = append(args, k)
+		}
+	}
+
+	query := "SELECT " + topologyEventColumns + " FROM topology_events"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY detected_at DESC, id DESC"
+	if f.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, f.Limit)
+	}
+
+	rows, err := s.db.Query(s.dialect.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query topology events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []TopologyEvent{}
+	for rows.Next() {
+		var e TopologyEvent
+		var services string
+		var detail sql.NullString
+		if err := rows.Scan(&e.ID, &e.DetectedAt, &e.Kind, &e.Key, &services, &e.RPS, &e.Severity, &detail); err != nil {
+			return nil, fmt.Errorf("failed to scan topology event: %w", err)
+		}
+		if err := json.Unmarshal([]byte(services), &e.Services); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal services: %w", err)
+		}
+		e.Detail = detail.String
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
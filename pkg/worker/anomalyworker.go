@@ -0,0 +1,97 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"predictive-analysis-engine/pkg/anomaly"
+	"predictive-analysis-engine/pkg/config"
+)
+
+// AnomalyWorker periodically scores new service and edge metrics against
+// their baselines and records anomalies.
+type AnomalyWorker struct {
+	detector *anomaly.Detector
+	cfg      *config.Config
+	stopCh   chan struct{}
+	wg       sync.WaitGroup
+	running  bool
+	runLock  sync.Mutex
+}
+
+func NewAnomalyWorker(cfg *config.Config, detector *anomaly.Detector) *AnomalyWorker {
+	return &AnomalyWorker{
+		detector: detector,
+		cfg:      cfg,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+func (w *AnomalyWorker) Start() {
+	if !w.cfg.Anomaly.WorkerEnabled {
+		log.Println("[AnomalyWorker] Disabled (ANOMALY_WORKER_ENABLED=false)")
+		return
+	}
+
+	w.runLock.Lock()
+	if w.running {
+		w.runLock.Unlock()
+		log.Println("[AnomalyWorker] Already running")
+		return
+	}
+	w.running = true
+	w.runLock.Unlock()
+
+	log.Printf("[AnomalyWorker] Starting with %dms interval\n", w.cfg.Anomaly.IntervalMs)
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.run()
+
+		ticker := time.NewTicker(time.Duration(w.cfg.Anomaly.IntervalMs) * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.stopCh:
+				return
+			case <-ticker.C:
+				w.run()
+			}
+		}
+	}()
+}
+
+func (w *AnomalyWorker) Stop() {
+	w.runLock.Lock()
+	if !w.running {
+		w.runLock.Unlock()
+		return
+	}
+	w.running = false
+	w.runLock.Unlock()
+
+	log.Println("[AnomalyWorker] Stopping...")
+	close(w.stopCh)
+	w.wg.Wait()
+
+	log.Println("[AnomalyWorker] Stopped")
+}
+
+func (w *AnomalyWorker) run() {
+	res, err := w.detector.Run(context.Background())
+	if err != nil {
+		log.Printf("[AnomalyWorker] Detection failed: %v\n", err)
+		return
+	}
+	if res.Warmup {
+		log.Printf("[AnomalyWorker] Built baselines for %d series from %s\n", res.Series, res.From)
+		return
+	}
+	if len(res.Anomalies) > 0 {
+		log.Printf("[AnomalyWorker] Detected %d anomalies\n", len(res.Anomalies))
+	}
+}
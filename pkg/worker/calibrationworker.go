@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"predictive-analysis-engine/pkg/calibration"
+	"predictive-analysis-engine/pkg/config"
+)
+
+// CalibrationWorker periodically refits the per-service scaling model
+// parameters used by scaling simulations.
+type CalibrationWorker struct {
+	calibrator *calibration.Calibrator
+	cfg        *config.Config
+	stopCh     chan struct{}
+	wg         sync.WaitGroup
+	running    bool
+	runLock    sync.Mutex
+}
+
+func NewCalibrationWorker(cfg *config.Config, calibrator *calibration.Calibrator) *CalibrationWorker {
+	return &CalibrationWorker{
+		calibrator: calibrator,
+		cfg:        cfg,
+		stopCh:     make(chan struct{}),
+	}
+}
+
+func (w *CalibrationWorker) Start() {
+	if !w.cfg.Calibration.WorkerEnabled {
+		log.Println("[CalibrationWorker] Disabled (CALIBRATION_WORKER_ENABLED=false)")
+		return
+	}
+
+	w.runLock.Lock()
+	if w.running {
+		w.runLock.Unlock()
+		log.Println("[CalibrationWorker] Already running")
+		return
+	}
+	w.running = true
+	w.runLock.Unlock()
+
+	log.Printf("[CalibrationWorker] Starting with %dms interval\n", w.cfg.Calibration.IntervalMs)
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.run()
+
+		ticker := time.NewTicker(time.Duration(w.cfg.Calibration.IntervalMs) * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.stopCh:
+				return
+			case <-ticker.C:
+				w.run()
+			}
+		}
+	}()
+}
+
+func (w *CalibrationWorker) Stop() {
+	w.runLock.Lock()
+	if !w.running {
+		w.runLock.Unlock()
+		return
+	}
+	w.running = false
+	w.runLock.Unlock()
+
+	log.Println("[CalibrationWorker] Stopping...")
+	close(w.stopCh)
+	w.wg.Wait()
+
+	log.Println("[CalibrationWorker] Stopped")
+}
+
+func (w *CalibrationWorker) run() {
+	fitted, err := w.calibrator.Run(context.Background())
+	if err != nil {
+		log.Printf("[CalibrationWorker] Calibration failed: %v\n", err)
+		return
+	}
+	log.Printf("[CalibrationWorker] Calibrated %d services\n", len(fitted))
+}
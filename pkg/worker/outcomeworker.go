@@ -0,0 +1,160 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/outcome"
+	"predictive-analysis-engine/pkg/storage"
+)
+
+const outcomeBatchSize = 50
+
+// maxSkippedDecisions bounds how many unevaluable decisions the worker
+// remembers. Beyond it the oldest are forgotten and retried once more.
+const maxSkippedDecisions = 500
+
+// PendingDecisionSource lists scaling decisions still awaiting an outcome.
+// It is satisfied by storage.OutcomeStore.
+type PendingDecisionSource interface {
+	PendingScalingDecisions(cutoff time.Time, limit int) ([]storage.DecisionRecord, error)
+}
+
+// OutcomeWorker periodically evaluates scaling decisions whose observation
+// window has closed and records the observed latency against them.
+type OutcomeWorker struct {
+	store     PendingDecisionSource
+	evaluator *outcome.Evaluator
+	cfg       *config.Config
+	stopCh    chan struct{}
+	wg        sync.WaitGroup
+	running   bool
+	runLock   sync.Mutex
+
+	// skipped holds decisions that cannot be evaluated automatically, such
+	// as simulations that were never applied, so they are not retried on
+	// every poll. It holds at most maxSkippedDecisions entries.
+	skipped map[int64]bool
+}
+
+func NewOutcomeWorker(cfg *config.Config, store PendingDecisionSource, evaluator *outcome.Evaluator) *OutcomeWorker {
+	return &OutcomeWorker{
+		store:     store,
+		evaluator: evaluator,
+		cfg:       cfg,
+		stopCh:    make(chan struct{}),
+		skipped:   map[int64]bool{},
+	}
+}
+
+func (w *OutcomeWorker) Start() {
+	if !w.cfg.Outcome.WorkerEnabled {
+		log.Println("[OutcomeWorker] Disabled (OUTCOME_WORKER_ENABLED=false)")
+		return
+	}
+
+	w.runLock.Lock()
+	if w.running {
+		w.runLock.Unlock()
+		log.Println("[OutcomeWorker] Already running")
+		return
+	}
+	w.running = true
+	w.runLock.Unlock()
+
+	log.Printf("[OutcomeWorker] Starting with %dms interval\n", w.cfg.Outcome.PollIntervalMs)
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.poll()
+
+		ticker := time.NewTicker(time.Duration(w.cfg.Outcome.PollIntervalMs) * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.stopCh:
+				return
+			case <-ticker.C:
+				w.poll()
+			}
+		}
+	}()
+}
+
+func (w *OutcomeWorker) Stop() {
+	w.runLock.Lock()
+	if !w.running {
+		w.runLock.Unlock()
+		return
+	}
+	w.running = false
+	w.runLock.Unlock()
+
+	log.Println("[OutcomeWorker] Stopping...")
+	close(w.stopCh)
+	w.wg.Wait()
+
+	log.Println("[OutcomeWorker] Stopped")
+}
+
+func (w *OutcomeWorker) poll() {
+	limit := outcomeBatchSize + len(w.skipped)
+	pending, err := w.store.PendingScalingDecisions(w.evaluator.ClosedBefore(time.Now()), limit)
+	if err != nil {
+		log.Printf("[OutcomeWorker] Failed to list pending decisions: %v\n", err)
+		return
+	}
+	if len(pending) < limit {
+		w.forgetResolved(pending)
+	}
+
+	ctx := context.Background()
+	recorded := 0
+	for i := range pending {
+		rec := &pending[i]
+		if w.skipped[rec.ID] {
+			continue
+		}
+		if _, err := w.evaluator.Evaluate(ctx, rec); err != nil {
+			if !outcome.IsRetryable(err) {
+				w.skip(rec.ID)
+			}
+			log.Printf("[OutcomeWorker] Decision %d not evaluated: %v\n", rec.ID, err)
+			continue
+		}
+		recorded++
+	}
+	if recorded > 0 {
+		log.Printf("[OutcomeWorker] Recorded %d outcomes\n", recorded)
+	}
+}
+
+// forgetResolved drops skipped decisions missing from a complete pending
+// list: they have since been given an outcome or deleted.
+func (w *OutcomeWorker) forgetResolved(pending []storage.DecisionRecord) {
+	still := make(map[int64]bool, len(pending))
+	for _, rec := range pending {
+		still[rec.ID] = true
+	}
+	for id := range w.skipped {
+		if !still[id] {
+			delete(w.skipped, id)
+		}
+	}
+}
+
+func (w *OutcomeWorker) skip(id int64) {
+	if len(w.skipped) >= maxSkippedDecisions {
+		oldest := id
+		for skippedID := range w.skipped {
+			oldest = min(oldest, skippedID)
+		}
+		delete(w.skipped, oldest)
+	}
+	w.skipped[id] = true
+}
@@ -6,14 +6,17 @@ import (
 	"sync"
 	"time"
 
+	"predictive-analysis-engine/pkg/analysis"
 	"predictive-analysis-engine/pkg/clients/graph"
 	"predictive-analysis-engine/pkg/clients/telemetry"
 	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/storage"
 )
 
 type PollWorker struct {
 	graphClient     *graph.Client
 	telemetryClient *telemetry.TelemetryClient
+	cycleWatcher    *analysis.CycleWatcher
 	cfg             *config.Config
 	stopCh          chan struct{}
 	wg              sync.WaitGroup
@@ -21,10 +24,11 @@ type PollWorker struct {
 	runLock         sync.Mutex
 }
 
-func NewPollWorker(cfg *config.Config, gClient *graph.Client, tClient *telemetry.TelemetryClient) *PollWorker {
+func NewPollWorker(cfg *config.Config, gClient *graph.Client, tClient *telemetry.TelemetryClient, cycleWatcher *analysis.CycleWatcher) *PollWorker {
 	return &PollWorker{
 		graphClient:     gClient,
 		telemetryClient: tClient,
+		cycleWatcher:    cycleWatcher,
 		cfg:             cfg,
 		stopCh:          make(chan struct{}),
 	}
@@ -93,6 +97,19 @@ func (w *PollWorker) poll() {
 	if err != nil {
 		log.Printf("[PollWorker] Snapshot fetch failed: %v\n", err)
 	} else if snapshot != nil {
+		if w.cycleWatcher != nil {
+			events, err := w.cycleWatcher.Observe(snapshot)
+			if err != nil {
+				log.Printf("[PollWorker] Cycle detection failed: %v\n", err)
+			}
+			for _, e := range events {
+				if e.Kind == storage.TopologyKindCycleResolved {
+					log.Printf("[PollWorker] Dependency cycle resolved: %s\n", e.Key)
+					continue
+				}
+				log.Printf("[PollWorker] Topology risk: new dependency cycle %s (%.2f rps)\n", e.Key, e.RPS)
+			}
+		}
 
 		for _, svc := range snapshot.Services {
 			hasTraffic := svc.RPS > 0
@@ -156,6 +173,19 @@ func (w *PollWorker) poll() {
 		log.Printf("[PollWorker] Infra fetch failed: %v\n", err)
 	} else {
 
+		// Pod counts are recorded alongside latency so scaling calibration
+		// can find historical pod-count changes.
+		podCounts := make(map[string]int, len(services))
+		for _, svc := range services {
+			podCounts[svc.Namespace+":"+svc.Name] = svc.PodCount
+		}
+		for i := range servicePoints {
+			if n := podCounts[servicePoints[i].Namespace+":"+servicePoints[i].Name]; n > 0 {
+				pc := float64(n)
+				servicePoints[i].PodCount = &pc
+			}
+		}
+
 		type uniqueNode struct {
 			NodePlacement graph.NodePlacement
 			Pods          []graph.PodInfo
@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"predictive-analysis-engine/pkg/config"
+	"predictive-analysis-engine/pkg/retention"
+)
+
+// RetentionWorker periodically prunes and archives decisions past their
+// retention period.
+type RetentionWorker struct {
+	pruner  *retention.Pruner
+	cfg     *config.Config
+	stopCh  chan struct{}
+	wg      sync.WaitGroup
+	running bool
+	runLock sync.Mutex
+}
+
+func NewRetentionWorker(cfg *config.Config, pruner *retention.Pruner) *RetentionWorker {
+	return &RetentionWorker{
+		pruner: pruner,
+		cfg:    cfg,
+		stopCh: make(chan struct{}),
+	}
+}
+
+func (w *RetentionWorker) Start() {
+	if !w.cfg.Retention.WorkerEnabled {
+		log.Println("[RetentionWorker] Disabled (RETENTION_WORKER_ENABLED=false)")
+		return
+	}
+
+	w.runLock.Lock()
+	if w.running {
+		w.runLock.Unlock()
+		log.Println("[RetentionWorker] Already running")
+		return
+	}
+	w.running = true
+	w.runLock.Unlock()
+
+	log.Printf("[RetentionWorker] Starting with %dms interval\n", w.cfg.Retention.IntervalMs)
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.run()
+
+		ticker := time.NewTicker(time.Duration(w.cfg.Retention.IntervalMs) * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.stopCh:
+				return
+			case <-ticker.C:
+				w.run()
+			}
+		}
+	}()
+}
+
+func (w *RetentionWorker) Stop() {
+	w.runLock.Lock()
+	if !w.running {
+		w.runLock.Unlock()
+		return
+	}
+	w.running = false
+	w.runLock.Unlock()
+
+	log.Println("[RetentionWorker] Stopping...")
+	close(w.stopCh)
+	w.wg.Wait()
+
+	log.Println("[RetentionWorker] Stopped")
+}
+
+func (w *RetentionWorker) run() {
+	res, err := w.pruner.Run(context.Background())
+	if err != nil {
+		log.Printf("[RetentionWorker] Pruning failed: %v\n", err)
+		return
+	}
+	if res.Deleted > 0 {
+		log.Printf("[RetentionWorker] Pruned %d decisions (archive: %s)\n", res.Deleted, res.ArchiveFile)
+	}
+}